@@ -0,0 +1,205 @@
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/trace"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/plugin"
+	"github.com/softlayer/softlayer-go/sl"
+
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+)
+
+const (
+	// Plugin config key, in seconds. The cache is off unless this (or --cache-ttl) is more than 0
+	SoftlayerCacheTTL = "SoftlayerCacheTTL"
+	// Overrides where cached responses are kept
+	ENV_SL_CACHE_DIR = "SL_CACHE_DIR"
+)
+
+// Catalog services that rarely change, only read methods of these services get cached.
+var CacheableServices = []string{
+	"SoftLayer_Location",
+	"SoftLayer_Location_Datacenter",
+	"SoftLayer_Product_Item_Category",
+	"SoftLayer_Product_Item_Price",
+	"SoftLayer_Product_Package",
+	"SoftLayer_Product_Package_Preset",
+	"SoftLayer_Product_Package_Server",
+}
+
+// Everything that makes one API response different from another
+type CacheKey struct {
+	Endpoint   string        `json:"endpoint"`
+	Account    string        `json:"account,omitempty"`
+	Service    string        `json:"service"`
+	Method     string        `json:"method"`
+	Id         *int          `json:"id,omitempty"`
+	Mask       string        `json:"mask,omitempty"`
+	Filter     string        `json:"filter,omitempty"`
+	Limit      *int          `json:"limit,omitempty"`
+	Offset     *int          `json:"offset,omitempty"`
+	Parameters []interface{} `json:"parameters,omitempty"`
+}
+
+type cacheEntry struct {
+	Key        CacheKey        `json:"key"`
+	Created    time.Time       `json:"created"`
+	TotalItems int             `json:"totalItems"`
+	Result     json.RawMessage `json:"result"`
+}
+
+// Saves API responses as files in Dir, each one is used for TTL
+type ResponseCache struct {
+	Dir string
+	TTL time.Duration
+	// Used instead of time.Now when set
+	Now func() time.Time
+}
+
+func NewResponseCache(dir string, ttl time.Duration) *ResponseCache {
+	return &ResponseCache{Dir: dir, TTL: ttl}
+}
+
+// Where cached responses are kept. SL_CACHE_DIR, otherwise softlayer-cli in the user cache directory
+func GetCacheDir() string {
+	if os.Getenv(ENV_SL_CACHE_DIR) != "" {
+		return os.Getenv(ENV_SL_CACHE_DIR)
+	}
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		cacheDir = os.TempDir()
+	}
+	return filepath.Join(cacheDir, "softlayer-cli")
+}
+
+// How long responses are cached for, from the plugin config. 0 means the cache is off
+func GetCacheTTL(context plugin.PluginContext) time.Duration {
+	seconds, err := context.PluginConfig().GetIntWithDefault(SoftlayerCacheTTL, 0)
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// True if responses from this service and method can be cached
+func IsCacheable(service string, method string) bool {
+	if !IsReadOnlyMethod(method) || method == "verifyOrder" {
+		return false
+	}
+	for _, cacheable := range CacheableServices {
+		if service == cacheable {
+			return true
+		}
+	}
+	return false
+}
+
+func NewCacheKey(endpoint string, account string, service string, method string, args []interface{}, options *sl.Options) CacheKey {
+	key := CacheKey{Endpoint: endpoint, Account: account, Service: service, Method: method, Parameters: args}
+	if options != nil {
+		key.Id = options.Id
+		key.Mask = options.Mask
+		key.Filter = options.Filter
+		key.Limit = options.Limit
+		key.Offset = options.Offset
+	}
+	return key
+}
+
+func (c *ResponseCache) now() time.Time {
+	if c.Now != nil {
+		return c.Now()
+	}
+	return time.Now()
+}
+
+func (c *ResponseCache) path(key CacheKey) (string, error) {
+	keyBytes, err := json.Marshal(key)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(keyBytes)
+	return filepath.Join(c.Dir, hex.EncodeToString(hash[:])+".json"), nil
+}
+
+// Loads the cached response for key into pResult. Returns false if there isn't one, or it is older than TTL
+func (c *ResponseCache) Get(key CacheKey, options *sl.Options, pResult interface{}) bool {
+	path, err := c.path(key)
+	if err != nil {
+		return false
+	}
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+	var entry cacheEntry
+	err = json.Unmarshal(contents, &entry)
+	if err != nil || c.now().Sub(entry.Created) > c.TTL {
+		return false
+	}
+	err = json.Unmarshal(entry.Result, pResult)
+	if err != nil {
+		return false
+	}
+	if options != nil && entry.TotalItems > 0 {
+		options.SetTotalItems(entry.TotalItems)
+	}
+	subs := map[string]interface{}{"SERVICE": key.Service, "METHOD": key.Method}
+	trace.Logger.Println(T("Using cached response for {{.SERVICE}}::{{.METHOD}}", subs))
+	return true
+}
+
+// Saves the response in pResult for key
+func (c *ResponseCache) Set(key CacheKey, options *sl.Options, pResult interface{}) error {
+	path, err := c.path(key)
+	if err != nil {
+		return err
+	}
+	result, err := json.Marshal(pResult)
+	if err != nil {
+		return err
+	}
+	entry := cacheEntry{Key: key, Created: c.now(), Result: result}
+	if options != nil {
+		entry.TotalItems = options.TotalItems
+	}
+	contents, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(c.Dir, 0700)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, contents, 0600)
+}
+
+// Removes every cached response, and returns how many there were
+func (c *ResponseCache) Clear() (int, error) {
+	files, err := os.ReadDir(c.Dir)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	removed := 0
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".json") {
+			continue
+		}
+		err = os.Remove(filepath.Join(c.Dir, file.Name()))
+		if err != nil {
+			return removed, err
+		}
+		removed++
+	}
+	return removed, nil
+}
@@ -0,0 +1,120 @@
+package client_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+)
+
+var _ = Describe("ResponseCache", func() {
+	var (
+		transport *client.CLIRestTransport
+		sess      *session.Session
+		server    *httptest.Server
+		requests  int
+		cacheDir  string
+		now       time.Time
+	)
+	BeforeEach(func() {
+		requests = 0
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			fmt.Fprintf(w, `[{"id": %d, "keyName": "PACKAGE_%d"}]`, requests, requests)
+		}))
+		cacheDir = GinkgoT().TempDir()
+		now = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+		cache := client.NewResponseCache(cacheDir, time.Hour)
+		cache.Now = func() time.Time { return now }
+		transport = &client.CLIRestTransport{
+			RestTransport: &session.RestTransport{},
+			Cache:         cache,
+		}
+		sess = &session.Session{Endpoint: server.URL, TransportHandler: transport}
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+	It("Returns cached responses until the TTL runs out", func() {
+		options := &sl.Options{Mask: "mask[id,keyName]", Filter: `{"keyName":{"operation":"PACKAGE_1"}}`}
+		var first, second, third []datatypes.Product_Package
+		Expect(transport.DoRequest(sess, "SoftLayer_Product_Package", "getAllObjects", nil, options, &first)).To(Succeed())
+		Expect(transport.DoRequest(sess, "SoftLayer_Product_Package", "getAllObjects", nil, options, &second)).To(Succeed())
+		Expect(requests).To(Equal(1))
+		Expect(*second[0].KeyName).To(Equal("PACKAGE_1"))
+
+		now = now.Add(2 * time.Hour)
+		Expect(transport.DoRequest(sess, "SoftLayer_Product_Package", "getAllObjects", nil, options, &third)).To(Succeed())
+		Expect(requests).To(Equal(2))
+		Expect(*third[0].KeyName).To(Equal("PACKAGE_2"))
+	})
+	It("Uses the id, mask and filter in the key", func() {
+		var result []datatypes.Product_Package
+		Expect(transport.DoRequest(sess, "SoftLayer_Product_Package", "getAllObjects", nil, &sl.Options{Mask: "mask[id]"}, &result)).To(Succeed())
+		Expect(transport.DoRequest(sess, "SoftLayer_Product_Package", "getAllObjects", nil, &sl.Options{Mask: "mask[id,keyName]"}, &result)).To(Succeed())
+		Expect(transport.DoRequest(sess, "SoftLayer_Product_Package", "getAllObjects", nil, &sl.Options{Filter: `{"id":{"operation":1}}`}, &result)).To(Succeed())
+		Expect(transport.DoRequest(sess, "SoftLayer_Product_Package", "getAllObjects", nil, &sl.Options{Id: sl.Int(5)}, &result)).To(Succeed())
+		Expect(requests).To(Equal(4))
+	})
+	It("Doesn't share responses between the users of API key profiles", func() {
+		var result []datatypes.Product_Package
+		transport.Profile = &client.Profile{Name: "dev", Username: "devuser"}
+		Expect(transport.DoRequest(sess, "SoftLayer_Product_Package", "getAllObjects", nil, &sl.Options{}, &result)).To(Succeed())
+		transport.Profile = &client.Profile{Name: "prod", Username: "produser"}
+		Expect(transport.DoRequest(sess, "SoftLayer_Product_Package", "getAllObjects", nil, &sl.Options{}, &result)).To(Succeed())
+		Expect(transport.DoRequest(sess, "SoftLayer_Product_Package", "getAllObjects", nil, &sl.Options{}, &result)).To(Succeed())
+		Expect(requests).To(Equal(2))
+	})
+	It("Only caches catalog services", func() {
+		var result []datatypes.Virtual_Guest
+		Expect(transport.DoRequest(sess, "SoftLayer_Account", "getVirtualGuests", nil, &sl.Options{}, &result)).To(Succeed())
+		Expect(transport.DoRequest(sess, "SoftLayer_Account", "getVirtualGuests", nil, &sl.Options{}, &result)).To(Succeed())
+		Expect(requests).To(Equal(2))
+	})
+	It("Clears the cache", func() {
+		var result []datatypes.Product_Package
+		Expect(transport.DoRequest(sess, "SoftLayer_Product_Package", "getAllObjects", nil, &sl.Options{}, &result)).To(Succeed())
+		Expect(transport.DoRequest(sess, "SoftLayer_Location", "getDatacenters", nil, &sl.Options{}, &result)).To(Succeed())
+		removed, err := transport.Cache.Clear()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(removed).To(Equal(2))
+		files, _ := filepath.Glob(filepath.Join(cacheDir, "*.json"))
+		Expect(files).To(BeEmpty())
+		Expect(transport.DoRequest(sess, "SoftLayer_Product_Package", "getAllObjects", nil, &sl.Options{}, &result)).To(Succeed())
+		Expect(requests).To(Equal(3))
+	})
+	It("Clears a cache that doesn't exist yet", func() {
+		removed, err := client.NewResponseCache(filepath.Join(cacheDir, "missing"), time.Hour).Clear()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(removed).To(Equal(0))
+	})
+	Describe("IsCacheable", func() {
+		It("Caches catalog reads", func() {
+			Expect(client.IsCacheable("SoftLayer_Product_Package", "getItemPrices")).To(BeTrue())
+			Expect(client.IsCacheable("SoftLayer_Location", "getDatacenters")).To(BeTrue())
+		})
+		It("Does not cache anything else", func() {
+			Expect(client.IsCacheable("SoftLayer_Product_Order", "placeOrder")).To(BeFalse())
+			Expect(client.IsCacheable("SoftLayer_Product_Order", "verifyOrder")).To(BeFalse())
+			Expect(client.IsCacheable("SoftLayer_Product_Package", "editObject")).To(BeFalse())
+			Expect(client.IsCacheable("SoftLayer_Account", "getHardware")).To(BeFalse())
+		})
+	})
+	Describe("GetCacheDir", func() {
+		It("Uses SL_CACHE_DIR", func() {
+			os.Setenv(client.ENV_SL_CACHE_DIR, cacheDir)
+			defer os.Unsetenv(client.ENV_SL_CACHE_DIR)
+			Expect(client.GetCacheDir()).To(Equal(cacheDir))
+		})
+	})
+})
@@ -0,0 +1,214 @@
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+)
+
+const (
+	// Every request and response is written to this directory
+	ENV_SL_RECORD_DIR = "SL_RECORD_DIR"
+	// Responses come from the recordings in this directory instead of the API
+	ENV_SL_REPLAY_DIR = "SL_REPLAY_DIR"
+)
+
+// The request half of a recording, recordings are matched on all of these
+type RecordedRequest struct {
+	Service    string        `json:"service"`
+	Method     string        `json:"method"`
+	Id         *int          `json:"id,omitempty"`
+	Mask       string        `json:"mask,omitempty"`
+	Filter     string        `json:"filter,omitempty"`
+	Limit      *int          `json:"limit,omitempty"`
+	Offset     *int          `json:"offset,omitempty"`
+	Parameters []interface{} `json:"parameters,omitempty"`
+}
+
+// One request and what the API sent back. Error is set instead of Response when the request failed.
+type Recording struct {
+	Request    RecordedRequest `json:"request"`
+	Response   json.RawMessage `json:"response,omitempty"`
+	TotalItems int             `json:"totalItems,omitempty"`
+	Error      *sl.Error       `json:"error,omitempty"`
+}
+
+// Secrets in args are redacted like in the journal, on both the recording and the replay side, so recordings
+// can be shared and a request still finds its recording.
+func NewRecordedRequest(service string, method string, args []interface{}, options *sl.Options) RecordedRequest {
+	request := RecordedRequest{Service: service, Method: method, Parameters: RedactParameters(service, method, args)}
+	if options != nil {
+		request.Id = options.Id
+		request.Mask = options.Mask
+		request.Filter = options.Filter
+		request.Limit = options.Limit
+		request.Offset = options.Offset
+	}
+	return request
+}
+
+// Recordings are saved as <dir>/<service>/<method>-<hash>-<count>.json.
+// The same request made more than once gets a new count each time, so responses can be replayed in order.
+func recordingPath(dir string, request RecordedRequest, count int) (string, error) {
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(requestBytes)
+	name := fmt.Sprintf("%s-%s-%d.json", request.Method, hex.EncodeToString(hash[:])[:12], count)
+	return filepath.Join(dir, request.Service, name), nil
+}
+
+// Sends requests with Handler and writes every request and response to Dir, with the secrets redacted
+type RecordingTransport struct {
+	Handler session.TransportHandler
+	Dir     string
+	counts  map[string]int
+	mutex   sync.Mutex
+}
+
+func NewRecordingTransport(handler session.TransportHandler, dir string) *RecordingTransport {
+	return &RecordingTransport{Handler: handler, Dir: dir, counts: map[string]int{}}
+}
+
+func (r *RecordingTransport) DoRequest(sess *session.Session, service string, method string, args []interface{}, options *sl.Options, pResult interface{}) error {
+	err := r.Handler.DoRequest(sess, service, method, args, options, pResult)
+	recording := Recording{Request: NewRecordedRequest(service, method, args, options)}
+	if err != nil {
+		// Wrapped errors can't be read back from JSON, so only their message is kept
+		recorded := sl.Error{Message: err.Error()}
+		slError, ok := err.(sl.Error)
+		if ok {
+			recorded = sl.Error{StatusCode: slError.StatusCode, Exception: slError.Exception, Message: slError.Message}
+			if slError.Wrapped != nil {
+				recorded.Message = slError.Wrapped.Error()
+			}
+		}
+		recording.Error = &recorded
+	} else {
+		response, _ := json.Marshal(pResult)
+		// Responses have secrets too, like the passwords of getCredentials
+		recording.Response, _ = RedactJSON(response)
+		if options != nil {
+			recording.TotalItems = options.TotalItems
+		}
+	}
+	recordErr := r.save(recording)
+	if recordErr != nil {
+		subs := map[string]interface{}{"SERVICE": service, "METHOD": method, "ERROR": recordErr.Error()}
+		fmt.Fprintln(os.Stderr, T("Failed to record {{.SERVICE}}::{{.METHOD}}: {{.ERROR}}", subs))
+	}
+	return err
+}
+
+func (r *RecordingTransport) save(recording Recording) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.counts == nil {
+		r.counts = map[string]int{}
+	}
+	basePath, err := recordingPath(r.Dir, recording.Request, 0)
+	if err != nil {
+		return err
+	}
+	// Skip past recordings left in Dir by an earlier run
+	count := r.counts[basePath] + 1
+	path, _ := recordingPath(r.Dir, recording.Request, count)
+	for r.counts[basePath] == 0 && fileExists(path) {
+		count++
+		path, _ = recordingPath(r.Dir, recording.Request, count)
+	}
+	r.counts[basePath] = count
+
+	contents, err := json.MarshalIndent(recording, "", "    ")
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, contents, 0600)
+}
+
+// Answers requests with the recordings in Dir, without sending anything to the API.
+// Requests made more than once get their recordings in order, the last one is repeated after that.
+type ReplayTransport struct {
+	Dir    string
+	counts map[string]int
+	mutex  sync.Mutex
+}
+
+func NewReplayTransport(dir string) *ReplayTransport {
+	return &ReplayTransport{Dir: dir, counts: map[string]int{}}
+}
+
+func (r *ReplayTransport) DoRequest(sess *session.Session, service string, method string, args []interface{}, options *sl.Options, pResult interface{}) error {
+	request := NewRecordedRequest(service, method, args, options)
+	path, err := r.nextPath(request)
+	if err != nil {
+		return err
+	}
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var recording Recording
+	err = json.Unmarshal(contents, &recording)
+	if err != nil {
+		subs := map[string]interface{}{"FILE": path, "ERROR": err.Error()}
+		return sl.Error{StatusCode: 559, Message: T("Invalid recording {{.FILE}}: {{.ERROR}}", subs)}
+	}
+	if recording.Error != nil {
+		return *recording.Error
+	}
+	if options != nil && recording.TotalItems > 0 {
+		options.SetTotalItems(recording.TotalItems)
+	}
+	if len(recording.Response) == 0 || pResult == nil {
+		return nil
+	}
+	return json.Unmarshal(recording.Response, pResult)
+}
+
+func (r *ReplayTransport) nextPath(request RecordedRequest) (string, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.counts == nil {
+		r.counts = map[string]int{}
+	}
+	basePath, err := recordingPath(r.Dir, request, 0)
+	if err != nil {
+		return "", err
+	}
+	count := r.counts[basePath] + 1
+	path, _ := recordingPath(r.Dir, request, count)
+	if !fileExists(path) {
+		count = r.counts[basePath]
+		path, _ = recordingPath(r.Dir, request, count)
+	}
+	if count == 0 || !fileExists(path) {
+		subs := map[string]interface{}{"SERVICE": request.Service, "METHOD": request.Method, "DIR": r.Dir}
+		return "", sl.Error{
+			StatusCode: 404,
+			Exception:  "SoftLayer_Exception_ObjectNotFound",
+			Message:    T("No recorded response for {{.SERVICE}}::{{.METHOD}} in {{.DIR}}", subs),
+		}
+	}
+	r.counts[basePath] = count
+	return path, nil
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -0,0 +1,161 @@
+package client_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+)
+
+var _ = Describe("Record and replay", func() {
+	var (
+		server    *httptest.Server
+		requests  int
+		recordDir string
+	)
+	BeforeEach(func() {
+		requests = 0
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if strings.Contains(r.URL.Path, "/999") {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `{"error": "Unable to find object with id of '999'.", "code": "SoftLayer_Exception_ObjectNotFound"}`)
+				return
+			}
+			fmt.Fprintf(w, `{"id": 1234, "hostname": "web%d"}`, requests)
+		}))
+		recordDir = GinkgoT().TempDir()
+		recorder := &client.CLIRestTransport{RestTransport: &session.RestTransport{}}
+		recorder.Handler = client.NewRecordingTransport(recorder.RestTransport, recordDir)
+		sess := &session.Session{Endpoint: server.URL, TransportHandler: recorder}
+
+		var guest datatypes.Virtual_Guest
+		options := &sl.Options{Id: sl.Int(1234), Mask: "mask[id,hostname]"}
+		Expect(recorder.DoRequest(sess, "SoftLayer_Virtual_Guest", "getObject", nil, options, &guest)).To(Succeed())
+		Expect(recorder.DoRequest(sess, "SoftLayer_Virtual_Guest", "getObject", nil, options, &guest)).To(Succeed())
+		Expect(recorder.DoRequest(sess, "SoftLayer_Virtual_Guest", "getObject", nil, &sl.Options{Id: sl.Int(999)}, &guest)).NotTo(Succeed())
+		server.Close()
+	})
+	It("Writes a file for every request", func() {
+		files, err := filepath.Glob(filepath.Join(recordDir, "SoftLayer_Virtual_Guest", "getObject-*.json"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(files).To(HaveLen(3))
+	})
+	It("Replays the responses in order without the API", func() {
+		replayer := &client.CLIRestTransport{RestTransport: &session.RestTransport{}, Handler: client.NewReplayTransport(recordDir)}
+		sess := &session.Session{Endpoint: server.URL, TransportHandler: replayer}
+		options := &sl.Options{Id: sl.Int(1234), Mask: "mask[id,hostname]"}
+		hostnames := []string{}
+		for i := 0; i < 3; i++ {
+			var guest datatypes.Virtual_Guest
+			Expect(replayer.DoRequest(sess, "SoftLayer_Virtual_Guest", "getObject", nil, options, &guest)).To(Succeed())
+			hostnames = append(hostnames, *guest.Hostname)
+		}
+		Expect(hostnames).To(Equal([]string{"web1", "web2", "web2"}))
+		Expect(requests).To(Equal(3))
+	})
+	It("Replays errors", func() {
+		replayer := client.NewReplayTransport(recordDir)
+		var guest datatypes.Virtual_Guest
+		err := replayer.DoRequest(&session.Session{}, "SoftLayer_Virtual_Guest", "getObject", nil, &sl.Options{Id: sl.Int(999)}, &guest)
+		Expect(err).To(HaveOccurred())
+		slError, ok := err.(sl.Error)
+		Expect(ok).To(BeTrue())
+		Expect(slError.StatusCode).To(Equal(404))
+		Expect(slError.Exception).To(Equal("SoftLayer_Exception_ObjectNotFound"))
+	})
+	It("Errors when nothing was recorded for a request", func() {
+		replayer := client.NewReplayTransport(recordDir)
+		var guest datatypes.Virtual_Guest
+		err := replayer.DoRequest(&session.Session{}, "SoftLayer_Virtual_Guest", "getObject", nil, &sl.Options{Id: sl.Int(1234), Mask: "mask[id]"}, &guest)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("No recorded response for SoftLayer_Virtual_Guest::getObject"))
+	})
+})
+
+var _ = Describe("Recordings with secrets", func() {
+	var (
+		server    *httptest.Server
+		recordDir string
+		recorder  *client.CLIRestTransport
+		sess      *session.Session
+	)
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if strings.Contains(r.URL.Path, "SoftLayer_Virtual_Guest") {
+				fmt.Fprint(w, `{"id": 1234, "hostname": "web1", "operatingSystem": {"passwords": [{"id": 7, "username": "root", "password": "hunter2"}]},`+
+					`"softwareComponents": [{"passwords": [{"username": "admin", "password": "hunter3", "notes": "db"}]}],`+
+					`"datacenter": {"name": "dal13"}}`)
+				return
+			}
+			if strings.Contains(r.URL.Path, "getCredentials") {
+				fmt.Fprint(w, `[{"id": 5, "username": "admin", "password": "hunter2"}]`)
+				return
+			}
+			fmt.Fprint(w, `{"id": 1234, "username": "bob"}`)
+		}))
+		recordDir = GinkgoT().TempDir()
+		recorder = &client.CLIRestTransport{RestTransport: &session.RestTransport{}}
+		recorder.Handler = client.NewRecordingTransport(recorder.RestTransport, recordDir)
+		sess = &session.Session{Endpoint: server.URL, TransportHandler: recorder}
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+	recorded := func() string {
+		files, err := filepath.Glob(filepath.Join(recordDir, "*", "*.json"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(files).To(HaveLen(1))
+		contents, err := os.ReadFile(files[0])
+		Expect(err).NotTo(HaveOccurred())
+		return string(contents)
+	}
+	It("Redacts the secrets of requests and still replays them", func() {
+		var user datatypes.User_Customer
+		args := []interface{}{&datatypes.User_Customer{Username: sl.String("bob")}, sl.String("hunter2"), sl.String("vpnhunter2")}
+		Expect(recorder.DoRequest(sess, "SoftLayer_User_Customer", "createObject", args, &sl.Options{}, &user)).To(Succeed())
+		Expect(recorded()).To(ContainSubstring(`"username": "bob"`))
+		Expect(recorded()).NotTo(ContainSubstring("hunter2"))
+
+		replayer := client.NewReplayTransport(recordDir)
+		user = datatypes.User_Customer{}
+		Expect(replayer.DoRequest(&session.Session{}, "SoftLayer_User_Customer", "createObject", args, &sl.Options{}, &user)).To(Succeed())
+		Expect(*user.Id).To(Equal(1234))
+	})
+	It("Redacts the secrets of responses", func() {
+		var credentials []datatypes.Network_Storage_Credential
+		Expect(recorder.DoRequest(sess, "SoftLayer_Network_Storage_Allowed_Host", "getCredentials", nil, &sl.Options{Id: sl.Int(5)}, &credentials)).To(Succeed())
+		Expect(*credentials[0].Password).To(Equal("hunter2"))
+		Expect(recorded()).To(ContainSubstring(`"password": "REDACTED"`))
+		Expect(recorded()).NotTo(ContainSubstring("hunter2"))
+	})
+	It("Replays vs detail with the passwords of its response redacted", func() {
+		vsManager := managers.NewVirtualServerManager(sess)
+		guest, err := vsManager.GetInstance(1234, managers.INSTANCE_DETAIL_MASK)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*guest.OperatingSystem.Passwords[0].Password).To(Equal("hunter2"))
+		Expect(recorded()).NotTo(ContainSubstring("hunter2"))
+		Expect(recorded()).NotTo(ContainSubstring("hunter3"))
+
+		replayer := &client.CLIRestTransport{RestTransport: &session.RestTransport{}, Handler: client.NewReplayTransport(recordDir)}
+		vsManager = managers.NewVirtualServerManager(&session.Session{Endpoint: server.URL, TransportHandler: replayer})
+		guest, err = vsManager.GetInstance(1234, managers.INSTANCE_DETAIL_MASK)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*guest.Hostname).To(Equal("web1"))
+		Expect(*guest.Datacenter.Name).To(Equal("dal13"))
+		Expect(guest.OperatingSystem.Passwords).To(HaveLen(1))
+		Expect(*guest.OperatingSystem.Passwords[0].Password).To(Equal(client.REDACTED))
+		Expect(*guest.SoftwareComponents[0].Passwords[0].Notes).To(Equal(client.REDACTED))
+	})
+})
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"strings"
+	"time"
+
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/spf13/cobra"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
+)
+
+// How long the identifiers used for shell completion are reused before they are listed again.
+// Every <TAB> is a new process, so they are kept with the other cached responses in GetCacheDir().
+var CompletionTTL = time.Minute
+
+// Lists completions for an argument, each one is "<IDENTIFIER>\t<DESCRIPTION>"
+type CompletionLister func() ([]string, error)
+
+// A cobra ValidArgsFunction that completes the first argument of a command with the completions from list.
+// kind names the list in the cache, results are cached per endpoint and account.
+// Errors are ignored, there is no good way to show them while the shell is completing.
+func CompleteIdentifiers(sess *session.Session, kind string, list CompletionLister) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return matchingCompletions(getCompletions(sess, kind, list), args, toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// Same as CompleteIdentifiers, for commands that take any number of identifiers.
+// Every argument is completed, without the identifiers that were already given.
+func CompleteEveryIdentifier(sess *session.Session, kind string, list CompletionLister) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return matchingCompletions(getCompletions(sess, kind, list), args, toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// The completions that start with toComplete, and aren't one of args already
+func matchingCompletions(completions []string, args []string, toComplete string) []string {
+	matches := []string{}
+	for _, completion := range completions {
+		identifier, _, _ := strings.Cut(completion, "\t")
+		if strings.HasPrefix(completion, toComplete) && !utils.WordInList(args, identifier) {
+			matches = append(matches, completion)
+		}
+	}
+	return matches
+}
+
+func getCompletions(sess *session.Session, kind string, list CompletionLister) []string {
+	cache := NewResponseCache(GetCacheDir(), CompletionTTL)
+	key := CacheKey{Service: "completion", Method: kind}
+	if sess != nil {
+		key.Endpoint = sess.Endpoint
+		// Profiles with an API key can point at another account than the one logged in to ibmcloud
+		key.Account = sess.UserName
+		transport, ok := GetCLITransport(sess)
+		if ok && key.Account == "" {
+			key.Account = transport.accountId()
+		}
+	}
+	completions := []string{}
+	if cache.Get(key, nil, &completions) {
+		return completions
+	}
+	completions, err := list()
+	if err != nil {
+		return []string{}
+	}
+	_ = cache.Set(key, nil, completions)
+	return completions
+}
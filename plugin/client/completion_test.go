@@ -0,0 +1,83 @@
+package client_test
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/spf13/cobra"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+)
+
+var _ = Describe("CompleteIdentifiers", func() {
+	var (
+		sess    *session.Session
+		calls   int
+		listErr error
+		lister  client.CompletionLister
+	)
+	BeforeEach(func() {
+		os.Setenv(client.ENV_SL_CACHE_DIR, GinkgoT().TempDir())
+		sess = &session.Session{Endpoint: "https://api.softlayer.com/rest/v3.1", UserName: "user"}
+		calls = 0
+		listErr = nil
+		lister = func() ([]string, error) {
+			calls++
+			return []string{"111\tweb1.example.com", "222\tdb1.example.com"}, listErr
+		}
+	})
+	AfterEach(func() {
+		os.Unsetenv(client.ENV_SL_CACHE_DIR)
+		client.CompletionTTL = time.Minute
+	})
+	It("Completes matching identifiers", func() {
+		complete := client.CompleteIdentifiers(sess, "virtual_guest", lister)
+		completions, directive := complete(nil, []string{}, "2")
+		Expect(completions).To(Equal([]string{"222\tdb1.example.com"}))
+		Expect(directive).To(Equal(cobra.ShellCompDirectiveNoFileComp))
+	})
+	It("Caches the identifiers for each account", func() {
+		complete := client.CompleteIdentifiers(sess, "virtual_guest", lister)
+		complete(nil, []string{}, "")
+		completions, _ := complete(nil, []string{}, "")
+		Expect(completions).To(HaveLen(2))
+		Expect(calls).To(Equal(1))
+
+		otherSess := &session.Session{Endpoint: sess.Endpoint, UserName: "other"}
+		client.CompleteIdentifiers(otherSess, "virtual_guest", lister)(nil, []string{}, "")
+		Expect(calls).To(Equal(2))
+	})
+	It("Lists again once the TTL runs out", func() {
+		client.CompletionTTL = 0
+		complete := client.CompleteIdentifiers(sess, "virtual_guest", lister)
+		complete(nil, []string{}, "")
+		time.Sleep(time.Millisecond)
+		complete(nil, []string{}, "")
+		Expect(calls).To(Equal(2))
+	})
+	It("Only completes the first argument", func() {
+		complete := client.CompleteIdentifiers(sess, "virtual_guest", lister)
+		completions, _ := complete(nil, []string{"111"}, "")
+		Expect(completions).To(BeEmpty())
+		Expect(calls).To(Equal(0))
+	})
+	It("Completes every argument of commands that take more than one identifier", func() {
+		complete := client.CompleteEveryIdentifier(sess, "virtual_guest", lister)
+		completions, directive := complete(nil, []string{"111"}, "")
+		Expect(completions).To(Equal([]string{"222\tdb1.example.com"}))
+		Expect(directive).To(Equal(cobra.ShellCompDirectiveNoFileComp))
+		completions, _ = complete(nil, []string{"111", "222"}, "")
+		Expect(completions).To(BeEmpty())
+	})
+	It("Completes nothing when the list fails", func() {
+		listErr = errors.New("SoftLayer_Exception_Public: Access Denied")
+		complete := client.CompleteIdentifiers(sess, "virtual_guest", lister)
+		completions, directive := complete(nil, []string{}, "")
+		Expect(completions).To(BeEmpty())
+		Expect(directive).To(Equal(cobra.ShellCompDirectiveNoFileComp))
+	})
+})
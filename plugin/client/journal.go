@@ -0,0 +1,142 @@
+package client
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/plugin"
+)
+
+const (
+	// Plugin config key, the journal is only written when this is true (or ENV_SL_JOURNAL_FILE is set)
+	SoftlayerJournal = "SoftlayerJournal"
+	// Overrides where the journal is written, and turns it on
+	ENV_SL_JOURNAL_FILE = "SL_JOURNAL_FILE"
+)
+
+// One API request that could have changed something, a line of the journal
+type JournalEntry struct {
+	Time        time.Time     `json:"time"`
+	User        string        `json:"user,omitempty"`
+	Account     string        `json:"account,omitempty"`
+	Workstation string        `json:"workstation,omitempty"`
+	CommandLine string        `json:"commandLine,omitempty"`
+	Service     string        `json:"service"`
+	Method      string        `json:"method"`
+	Id          *int          `json:"id,omitempty"`
+	Parameters  []interface{} `json:"parameters"`
+	Success     bool          `json:"success"`
+	Error       string        `json:"error,omitempty"`
+}
+
+// Appends JournalEntries to File, one JSON object per line
+type Journal struct {
+	File string
+	// Who and where the requests come from, copied into every entry
+	User        string
+	Account     string
+	Workstation string
+	// The command that is running, set once the flags are known. See RedactCommandLine
+	CommandLine string
+	// Used instead of time.Now when set
+	Now func() time.Time
+
+	mutex sync.Mutex
+}
+
+func NewJournal(file string) *Journal {
+	workstation, _ := os.Hostname()
+	return &Journal{File: file, Workstation: workstation}
+}
+
+// Where the journal is written. SL_JOURNAL_FILE, otherwise journal.jsonl in softlayer-cli in the user config directory
+func GetJournalFile() string {
+	if os.Getenv(ENV_SL_JOURNAL_FILE) != "" {
+		return os.Getenv(ENV_SL_JOURNAL_FILE)
+	}
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		configDir = os.TempDir()
+	}
+	return filepath.Join(configDir, "softlayer-cli", "journal.jsonl")
+}
+
+// True when the SoftlayerJournal plugin config is set, or SL_JOURNAL_FILE is
+func JournalEnabled(context plugin.PluginContext) bool {
+	if os.Getenv(ENV_SL_JOURNAL_FILE) != "" {
+		return true
+	}
+	enabled, err := context.PluginConfig().GetBoolWithDefault(SoftlayerJournal, false)
+	return err == nil && enabled
+}
+
+// Appends an entry for a request. Secrets in args are redacted, and requestErr is the outcome.
+func (j *Journal) Record(service string, method string, id *int, args []interface{}, requestErr error) error {
+	entry := JournalEntry{
+		Time:        j.now(),
+		User:        j.User,
+		Account:     j.Account,
+		Workstation: j.Workstation,
+		CommandLine: j.CommandLine,
+		Service:     service,
+		Method:      method,
+		Id:          id,
+		Parameters:  RedactParameters(service, method, args),
+		Success:     requestErr == nil,
+	}
+	if requestErr != nil {
+		entry.Error = requestErr.Error()
+	}
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	err = os.MkdirAll(filepath.Dir(j.File), 0700)
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(j.File, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = file.Write(append(line, '\n'))
+	return err
+}
+
+// Every entry of the journal in file, oldest first. A journal that doesn't exist yet has no entries.
+// Lines that aren't entries (like a line cut short by a full disk) are skipped.
+func ReadJournal(file string) ([]JournalEntry, error) {
+	entries := []JournalEntry{}
+	journalFile, err := os.Open(file) // #nosec
+	if os.IsNotExist(err) {
+		return entries, nil
+	}
+	if err != nil {
+		return entries, err
+	}
+	defer journalFile.Close()
+	scanner := bufio.NewScanner(journalFile)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		entry := JournalEntry{}
+		if json.Unmarshal(scanner.Bytes(), &entry) == nil {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, scanner.Err()
+}
+
+func (j *Journal) now() time.Time {
+	if j.Now != nil {
+		return j.Now()
+	}
+	return time.Now()
+}
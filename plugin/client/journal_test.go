@@ -0,0 +1,133 @@
+package client_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+)
+
+var _ = Describe("Journal", func() {
+	var (
+		journal *client.Journal
+		file    string
+		now     time.Time
+	)
+	BeforeEach(func() {
+		file = filepath.Join(GinkgoT().TempDir(), "softlayer-cli", "journal.jsonl")
+		now = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+		journal = client.NewJournal(file)
+		journal.User = "user@example.com"
+		journal.Account = "123456"
+		journal.CommandLine = "sl vs cancel 1234"
+		journal.Now = func() time.Time { return now }
+	})
+
+	Describe("Record and ReadJournal", func() {
+		It("Appends one entry per request", func() {
+			id := 1234
+			Expect(journal.Record("SoftLayer_Virtual_Guest", "deleteObject", &id, nil, nil)).To(Succeed())
+			Expect(journal.Record("SoftLayer_Virtual_Guest", "setTags", &id, []interface{}{"a,b"}, errors.New("SoftLayer_Exception_Public: nope (HTTP 500)"))).To(Succeed())
+			entries, err := client.ReadJournal(file)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(2))
+			Expect(entries[0].Time).To(Equal(now))
+			Expect(entries[0].User).To(Equal("user@example.com"))
+			Expect(entries[0].Account).To(Equal("123456"))
+			Expect(entries[0].CommandLine).To(Equal("sl vs cancel 1234"))
+			Expect(entries[0].Service).To(Equal("SoftLayer_Virtual_Guest"))
+			Expect(entries[0].Method).To(Equal("deleteObject"))
+			Expect(*entries[0].Id).To(Equal(1234))
+			Expect(entries[0].Parameters).To(BeEmpty())
+			Expect(entries[0].Success).To(BeTrue())
+			Expect(entries[1].Parameters).To(Equal([]interface{}{"a,b"}))
+			Expect(entries[1].Success).To(BeFalse())
+			Expect(entries[1].Error).To(ContainSubstring("nope"))
+		})
+		It("Only lets the user read the journal", func() {
+			Expect(journal.Record("SoftLayer_Virtual_Guest", "deleteObject", nil, nil, nil)).To(Succeed())
+			info, err := os.Stat(file)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		})
+		It("Has no entries before the journal is written", func() {
+			entries, err := client.ReadJournal(file)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+		})
+		It("Skips lines that aren't entries", func() {
+			Expect(journal.Record("SoftLayer_Virtual_Guest", "deleteObject", nil, nil, nil)).To(Succeed())
+			journalFile, err := os.OpenFile(file, os.O_APPEND|os.O_WRONLY, 0600)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = journalFile.WriteString("{\"time\": \"2024-01\n")
+			Expect(err).NotTo(HaveOccurred())
+			journalFile.Close()
+			Expect(journal.Record("SoftLayer_Virtual_Guest", "powerOn", nil, nil, nil)).To(Succeed())
+			entries, err := client.ReadJournal(file)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(2))
+			Expect(entries[1].Method).To(Equal("powerOn"))
+		})
+	})
+
+	Describe("CLIRestTransport with a Journal", func() {
+		var (
+			transport *client.CLIRestTransport
+			sess      *session.Session
+			server    *httptest.Server
+		)
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `true`)
+			}))
+			transport = &client.CLIRestTransport{
+				RestTransport: &session.RestTransport{},
+				Journal:       journal,
+			}
+			sess = &session.Session{Endpoint: server.URL, TransportHandler: transport}
+		})
+		AfterEach(func() {
+			server.Close()
+		})
+		It("Writes requests that change something", func() {
+			var result bool
+			id := 1234
+			err := transport.DoRequest(sess, "SoftLayer_Virtual_Guest", "powerOff", nil, &sl.Options{Id: &id}, &result)
+			Expect(err).NotTo(HaveOccurred())
+			entries, err := client.ReadJournal(file)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].Method).To(Equal("powerOff"))
+			Expect(*entries[0].Id).To(Equal(1234))
+			Expect(entries[0].Success).To(BeTrue())
+		})
+		It("Doesn't write read requests", func() {
+			var result datatypes.Virtual_Guest
+			_ = transport.DoRequest(sess, "SoftLayer_Virtual_Guest", "getObject", nil, &sl.Options{}, &result)
+			entries, err := client.ReadJournal(file)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+		})
+		It("Doesn't write requests blocked by --dry-run", func() {
+			transport.DryRun = true
+			transport.DryRunOutput = GinkgoWriter
+			var result bool
+			err := transport.DoRequest(sess, "SoftLayer_Virtual_Guest", "powerOff", nil, &sl.Options{}, &result)
+			Expect(err).To(HaveOccurred())
+			entries, err := client.ReadJournal(file)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+		})
+	})
+})
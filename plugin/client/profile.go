@@ -0,0 +1,163 @@
+package client
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/plugin"
+	"github.com/softlayer/softlayer-go/session"
+
+	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+)
+
+const (
+	// Plugin config keys, all the profiles and the one `sl config profile-use` picked
+	SoftlayerProfiles      = "SoftlayerProfiles"
+	SoftlayerActiveProfile = "SoftlayerActiveProfile"
+	// Picks the profile for a single command, --profile does the same thing
+	ENV_SL_PROFILE = "SL_PROFILE"
+)
+
+// Settings for one account. Empty fields fall back to the normal plugin settings.
+type Profile struct {
+	Name       string `json:"name"`
+	Endpoint   string `json:"endpoint,omitempty"`
+	Datacenter string `json:"datacenter,omitempty"`
+	Output     string `json:"output,omitempty"`
+	// Classic infrastructure API key authentication, used instead of the IAM token when Username is set.
+	// The key itself is never saved, it is read from the APIKeyEnv environment variable for every command.
+	Username  string `json:"username,omitempty"`
+	APIKeyEnv string `json:"apiKeyEnv,omitempty"`
+}
+
+// The environment variable the API key of a profile is read from when it doesn't name one
+const ENV_SL_API_KEY = "SL_API_KEY"
+
+func (p Profile) HasAPIKey() bool {
+	return p.Username != ""
+}
+
+// The environment variable with the API key of the profile
+func (p Profile) GetAPIKeyEnv() string {
+	if p.APIKeyEnv != "" {
+		return p.APIKeyEnv
+	}
+	return ENV_SL_API_KEY
+}
+
+// Points sess at the credentials of the profile. Errors when the profile uses an API key that isn't set.
+func (p Profile) Apply(sess *session.Session) error {
+	if !p.HasAPIKey() {
+		return nil
+	}
+	apiKey := os.Getenv(p.GetAPIKeyEnv())
+	if apiKey == "" {
+		subs := map[string]interface{}{"NAME": p.Name, "ENV": p.GetAPIKeyEnv()}
+		return slErr.New(T("Profile {{.NAME}} uses the API key in the {{.ENV}} environment variable, which is not set.", subs))
+	}
+	sess.UserName = p.Username
+	sess.APIKey = apiKey
+	sess.IAMToken = ""
+	return nil
+}
+
+// Every saved profile, by name
+func GetProfiles(config plugin.PluginConfig) (map[string]Profile, error) {
+	profiles := map[string]Profile{}
+	saved := config.Get(SoftlayerProfiles)
+	if saved == nil {
+		return profiles, nil
+	}
+	// The plugin config hands back generic maps, going through JSON turns them back into profiles
+	savedBytes, err := json.Marshal(saved)
+	if err != nil {
+		return profiles, err
+	}
+	err = json.Unmarshal(savedBytes, &profiles)
+	return profiles, err
+}
+
+// Every saved profile, sorted by name
+func GetProfileList(config plugin.PluginConfig) ([]Profile, error) {
+	profiles, err := GetProfiles(config)
+	if err != nil {
+		return nil, err
+	}
+	profileList := []Profile{}
+	for _, profile := range profiles {
+		profileList = append(profileList, profile)
+	}
+	sort.Slice(profileList, func(i, j int) bool {
+		return profileList[i].Name < profileList[j].Name
+	})
+	return profileList, nil
+}
+
+func GetProfile(config plugin.PluginConfig, name string) (Profile, error) {
+	profiles, err := GetProfiles(config)
+	if err != nil {
+		return Profile{}, err
+	}
+	profile, ok := profiles[name]
+	if !ok {
+		return Profile{}, slErr.New(T("Profile {{.NAME}} does not exist.", map[string]interface{}{"NAME": name}))
+	}
+	return profile, nil
+}
+
+// Adds the profile, or replaces the one with the same name
+func SaveProfile(config plugin.PluginConfig, profile Profile) error {
+	profiles, err := GetProfiles(config)
+	if err != nil {
+		return err
+	}
+	profiles[profile.Name] = profile
+	return config.Set(SoftlayerProfiles, profiles)
+}
+
+func GetActiveProfile(config plugin.PluginConfig) string {
+	active, err := config.GetStringWithDefault(SoftlayerActiveProfile, "")
+	if err != nil {
+		return ""
+	}
+	return active
+}
+
+// An empty name clears the active profile, commands go back to the normal plugin settings
+func SetActiveProfile(config plugin.PluginConfig, name string) error {
+	if name == "" {
+		return config.Set(SoftlayerActiveProfile, "")
+	}
+	_, err := GetProfile(config, name)
+	if err != nil {
+		return err
+	}
+	return config.Set(SoftlayerActiveProfile, name)
+}
+
+// The profile to use: flagValue (from --profile) first, then SL_PROFILE, then the active profile.
+// Returns nil when no profile is picked.
+func GetSelectedProfile(context plugin.PluginContext, flagValue string) (*Profile, error) {
+	name := flagValue
+	if name == "" {
+		name = os.Getenv(ENV_SL_PROFILE)
+	}
+	explicit := name != ""
+	if !explicit {
+		name = GetActiveProfile(context.PluginConfig())
+	}
+	if name == "" {
+		return nil, nil
+	}
+	profile, err := GetProfile(context.PluginConfig(), name)
+	if err != nil {
+		// A profile that was picked earlier and then removed from the config shouldn't break every command
+		if !explicit {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &profile, nil
+}
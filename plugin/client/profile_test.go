@@ -0,0 +1,157 @@
+package client_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/plugin/pluginfakes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/sl"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
+)
+
+var _ = Describe("Profiles", func() {
+	var (
+		fakeContext *pluginfakes.FakePluginContext
+		fakeConfig  *testhelpers.FakePluginConfig
+		stored      map[string]interface{}
+	)
+	BeforeEach(func() {
+		stored = map[string]interface{}{}
+		fakeConfig = new(testhelpers.FakePluginConfig)
+		fakeConfig.GetStub = func(key string) interface{} {
+			return stored[key]
+		}
+		fakeConfig.SetStub = func(key string, value interface{}) error {
+			stored[key] = value
+			return nil
+		}
+		fakeConfig.GetStringWithDefaultStub = func(key string, defaultVal string) (string, error) {
+			if value, ok := stored[key].(string); ok {
+				return value, nil
+			}
+			return defaultVal, nil
+		}
+		fakeContext = new(pluginfakes.FakePluginContext)
+		fakeContext.PluginConfigReturns(fakeConfig)
+		fakeContext.IAMTokenReturns("Bearer iam")
+		os.Unsetenv(client.ENV_SL_API_ENDPOINT)
+
+		Expect(client.SaveProfile(fakeConfig, client.Profile{Name: "prod", Endpoint: "https://api.service.softlayer.com/rest/v3.1", Datacenter: "dal13"})).To(Succeed())
+		Expect(client.SaveProfile(fakeConfig, client.Profile{Name: "dev", Username: "devuser", APIKeyEnv: "DEV_API_KEY", Output: "json"})).To(Succeed())
+	})
+	AfterEach(func() {
+		os.Unsetenv(client.ENV_SL_PROFILE)
+		os.Unsetenv("DEV_API_KEY")
+	})
+	It("Saves and lists profiles", func() {
+		profiles, err := client.GetProfileList(fakeConfig)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(profiles).To(HaveLen(2))
+		Expect(profiles[0].Name).To(Equal("dev"))
+		Expect(profiles[0].APIKeyEnv).To(Equal("DEV_API_KEY"))
+		Expect(profiles[1].Name).To(Equal("prod"))
+		Expect(profiles[1].Datacenter).To(Equal("dal13"))
+	})
+	It("Errors on a missing profile", func() {
+		_, err := client.GetProfile(fakeConfig, "nope")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("Profile nope does not exist."))
+		Expect(client.SetActiveProfile(fakeConfig, "nope")).NotTo(Succeed())
+	})
+	It("Never saves an API key", func() {
+		Expect(fmt.Sprint(stored[client.SoftlayerProfiles])).NotTo(ContainSubstring("secret"))
+		profile, _ := client.GetProfile(fakeConfig, "prod")
+		Expect(profile.GetAPIKeyEnv()).To(Equal(client.ENV_SL_API_KEY))
+	})
+	Describe("GetSelectedProfile", func() {
+		It("Has no profile by default", func() {
+			profile, err := client.GetSelectedProfile(fakeContext, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile).To(BeNil())
+		})
+		It("Uses the active profile", func() {
+			Expect(client.SetActiveProfile(fakeConfig, "prod")).To(Succeed())
+			profile, err := client.GetSelectedProfile(fakeContext, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.Name).To(Equal("prod"))
+		})
+		It("Prefers SL_PROFILE over the active profile, and --profile over both", func() {
+			Expect(client.SetActiveProfile(fakeConfig, "prod")).To(Succeed())
+			os.Setenv(client.ENV_SL_PROFILE, "dev")
+			profile, err := client.GetSelectedProfile(fakeContext, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.Name).To(Equal("dev"))
+			profile, err = client.GetSelectedProfile(fakeContext, "prod")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.Name).To(Equal("prod"))
+		})
+		It("Clears the active profile", func() {
+			Expect(client.SetActiveProfile(fakeConfig, "dev")).To(Succeed())
+			Expect(client.SetActiveProfile(fakeConfig, "")).To(Succeed())
+			Expect(client.GetActiveProfile(fakeConfig)).To(Equal(""))
+			profile, err := client.GetSelectedProfile(fakeContext, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile).To(BeNil())
+		})
+		It("Errors when the picked profile is missing", func() {
+			_, err := client.GetSelectedProfile(fakeContext, "nope")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+	Describe("NewSoftlayerClientSessionWithProfile", func() {
+		It("Uses the endpoint of the profile", func() {
+			sess, err := client.NewSoftlayerClientSessionWithProfile(fakeContext, "prod")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sess.Endpoint).To(Equal("https://api.service.softlayer.com/rest/v3.1"))
+			Expect(sess.IAMToken).To(Equal("Bearer iam"))
+			transport, ok := client.GetCLITransport(sess)
+			Expect(ok).To(BeTrue())
+			Expect(transport.Profile.Datacenter).To(Equal("dal13"))
+		})
+		It("Uses API key authentication", func() {
+			os.Setenv("DEV_API_KEY", "secret")
+			sess, err := client.NewSoftlayerClientSessionWithProfile(fakeContext, "dev")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sess.UserName).To(Equal("devuser"))
+			Expect(sess.APIKey).To(Equal("secret"))
+			Expect(sess.IAMToken).To(Equal(""))
+			Expect(sess.Endpoint).To(Equal(client.SoftlayerAPIEndpointPublicDefault))
+		})
+		It("Errors on the first API call when the API key isn't in the environment", func() {
+			sess, err := client.NewSoftlayerClientSessionWithProfile(fakeContext, "dev")
+			Expect(err).NotTo(HaveOccurred())
+			transport, _ := client.GetCLITransport(sess)
+			err = transport.DoRequest(sess, "SoftLayer_Account", "getObject", nil, &sl.Options{}, &datatypes.Account{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("Profile dev uses the API key in the DEV_API_KEY environment variable, which is not set."))
+		})
+		It("Takes the journal identity from the profile", func() {
+			os.Setenv("DEV_API_KEY", "secret")
+			os.Setenv(client.ENV_SL_JOURNAL_FILE, filepath.Join(GinkgoT().TempDir(), "journal.jsonl"))
+			defer os.Unsetenv(client.ENV_SL_JOURNAL_FILE)
+			fakeContext.UserEmailReturns("someone@example.com")
+			fakeContext.IMSAccountIDReturns("111")
+			sess, err := client.NewSoftlayerClientSessionWithProfile(fakeContext, "dev")
+			Expect(err).NotTo(HaveOccurred())
+			transport, _ := client.GetCLITransport(sess)
+			Expect(transport.Journal.User).To(Equal("devuser"))
+			Expect(transport.Journal.Account).To(Equal(""))
+
+			sess, err = client.NewSoftlayerClientSessionWithProfile(fakeContext, "prod")
+			Expect(err).NotTo(HaveOccurred())
+			transport, _ = client.GetCLITransport(sess)
+			Expect(transport.Journal.User).To(Equal("someone@example.com"))
+			Expect(transport.Journal.Account).To(Equal("111"))
+		})
+		It("Errors on a missing profile", func() {
+			_, err := client.NewSoftlayerClientSessionWithProfile(fakeContext, "nope")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/plugin"
+)
+
+const (
+	// Plugin config key
+	SoftlayerMaxRPS = "SoftlayerMaxRPS"
+)
+
+// Most API requests per second, from the plugin config. 0 means there is no limit
+func GetMaxRPS(context plugin.PluginContext) float64 {
+	maxRPS, err := context.PluginConfig().GetFloatWithDefault(SoftlayerMaxRPS, 0)
+	if err != nil || maxRPS < 0 {
+		return 0
+	}
+	return maxRPS
+}
+
+// A token bucket that holds a single token, so requests are at least 1/rate seconds apart.
+// The session transport is shared by every goroutine of a command, so one RateLimiter covers all of them.
+type RateLimiter struct {
+	// Requests per second
+	Rate float64
+	// Used instead of time.Now and time.Sleep when set
+	Now   func() time.Time
+	Sleep func(time.Duration)
+
+	mutex sync.Mutex
+	// When the next request may be sent
+	next time.Time
+}
+
+func NewRateLimiter(rate float64) *RateLimiter {
+	return &RateLimiter{Rate: rate}
+}
+
+// Blocks until a request may be sent. Every caller reserves its own turn, so waiting goroutines go in the order they came in.
+func (l *RateLimiter) Wait() {
+	if l == nil || l.Rate <= 0 {
+		return
+	}
+	interval := time.Duration(float64(time.Second) / l.Rate)
+	l.mutex.Lock()
+	now := l.now()
+	turn := l.next
+	if turn.Before(now) {
+		turn = now
+	}
+	l.next = turn.Add(interval)
+	l.mutex.Unlock()
+	if wait := turn.Sub(now); wait > 0 {
+		l.sleep(wait)
+	}
+}
+
+func (l *RateLimiter) now() time.Time {
+	if l.Now != nil {
+		return l.Now()
+	}
+	return time.Now()
+}
+
+func (l *RateLimiter) sleep(wait time.Duration) {
+	if l.Sleep != nil {
+		l.Sleep(wait)
+		return
+	}
+	time.Sleep(wait)
+}
@@ -0,0 +1,101 @@
+package client_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+)
+
+var _ = Describe("RateLimiter", func() {
+	var (
+		limiter *client.RateLimiter
+		now     time.Time
+		mutex   sync.Mutex
+		waits   []time.Duration
+	)
+	BeforeEach(func() {
+		now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		waits = []time.Duration{}
+		limiter = client.NewRateLimiter(2)
+		limiter.Now = func() time.Time { return now }
+		limiter.Sleep = func(wait time.Duration) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			waits = append(waits, wait)
+		}
+	})
+	It("Spaces requests 1/rate seconds apart", func() {
+		limiter.Wait()
+		limiter.Wait()
+		limiter.Wait()
+		Expect(waits).To(Equal([]time.Duration{500 * time.Millisecond, time.Second}))
+	})
+	It("Doesn't wait once enough time has gone by", func() {
+		limiter.Wait()
+		now = now.Add(time.Second)
+		limiter.Wait()
+		Expect(waits).To(BeEmpty())
+	})
+	It("Gives every goroutine its own turn", func() {
+		var waitGroup sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			waitGroup.Add(1)
+			go func() {
+				defer waitGroup.Done()
+				limiter.Wait()
+			}()
+		}
+		waitGroup.Wait()
+		sort.Slice(waits, func(i, j int) bool { return waits[i] < waits[j] })
+		Expect(waits).To(HaveLen(9))
+		for i, wait := range waits {
+			Expect(wait).To(Equal(time.Duration(i+1) * 500 * time.Millisecond))
+		}
+	})
+	It("Does nothing without a rate", func() {
+		var noLimit *client.RateLimiter
+		noLimit.Wait()
+		limiter.Rate = 0
+		limiter.Wait()
+		limiter.Wait()
+		Expect(waits).To(BeEmpty())
+	})
+	It("Limits the requests of a CLIRestTransport, retries included", func() {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if requests == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprint(w, `{"error": "Service Unavailable", "code": "SoftLayer_Exception_Public"}`)
+				return
+			}
+			fmt.Fprint(w, `{"id": 1234}`)
+		}))
+		defer server.Close()
+		transport := &client.CLIRestTransport{
+			RestTransport: &session.RestTransport{},
+			Retries:       1,
+			Sleep:         func(time.Duration) {},
+			RateLimiter:   limiter,
+		}
+		sess := &session.Session{Endpoint: server.URL, TransportHandler: transport}
+		var result datatypes.Virtual_Guest
+		err := transport.DoRequest(sess, "SoftLayer_Virtual_Guest", "getObject", nil, &sl.Options{}, &result)
+		Expect(err).NotTo(HaveOccurred())
+		err = transport.DoRequest(sess, "SoftLayer_Virtual_Guest", "getObject", nil, &sl.Options{}, &result)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requests).To(Equal(3))
+		Expect(waits).To(Equal([]time.Duration{500 * time.Millisecond, time.Second}))
+	})
+})
@@ -0,0 +1,175 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// What secrets are replaced with in the journal and in recordings
+const REDACTED = "REDACTED"
+
+// Fields and flags with one of these in their name (in lower case) are redacted
+var SecretNames = []string{"password", "passwd", "secret", "token", "apikey", "authenticationkey", "privatekey", "passphrase", "wrappeddek"}
+
+// Positional parameters that are secrets, by service::method and index. API methods don't name their
+// parameters, so a password passed as a plain string can't be found by its name.
+var SecretParameters = map[string][]int{
+	// template, password, vpnPassword
+	"SoftLayer_User_Customer::createObject": {1, 2},
+	// template, content, attachmentId, rootPassword, ...
+	"SoftLayer_Ticket::createStandardTicket": {3},
+}
+
+// Methods whose plain string parameters are all redacted. Their parameters are templates, anything passed
+// as a bare string next to one is a value of the object, like a password.
+var SecretStringMethods = []string{"createObject", "createObjects", "editObject", "editObjects"}
+
+// The parameters of a request as plain JSON values, with the values of secret fields replaced.
+// Every parameter of a method with a secret name (like updatePassword) is replaced, and so are the
+// parameters in SecretParameters and the strings of the methods in SecretStringMethods.
+func RedactParameters(service string, method string, args []interface{}) []interface{} {
+	redacted := []interface{}{}
+	for i, arg := range args {
+		if isSecretName(method) || isSecretParameter(service, method, i) {
+			redacted = append(redacted, REDACTED)
+			continue
+		}
+		argJSON, err := json.Marshal(arg)
+		var value interface{}
+		if err != nil || json.Unmarshal(argJSON, &value) != nil {
+			redacted = append(redacted, REDACTED)
+			continue
+		}
+		if _, isString := value.(string); isString && isSecretStringMethod(method) {
+			redacted = append(redacted, REDACTED)
+			continue
+		}
+		redacted = append(redacted, redactValue(value))
+	}
+	return redacted
+}
+
+// args of a command line with the values of secret flags replaced, like --password=REDACTED.
+// shorthands maps the shorthand of a flag to its name, so -p is redacted when it is --password.
+func RedactCommandLine(args []string, shorthands map[string]string) string {
+	redacted := make([]string, len(args))
+	// True when the arg before this one was a secret flag without its value
+	valueIsSecret := false
+	for i, arg := range args {
+		redacted[i] = arg
+		if valueIsSecret {
+			redacted[i] = REDACTED
+			valueIsSecret = false
+			continue
+		}
+		if arg == "--" || !strings.HasPrefix(arg, "-") {
+			continue
+		}
+		flag, _, hasValue := strings.Cut(arg, "=")
+		name := strings.TrimLeft(flag, "-")
+		if !strings.HasPrefix(arg, "--") && len(name) > 0 {
+			// -pSECRET is -p with the value SECRET
+			hasValue = hasValue || len(name) > 1
+			flag = arg[:2]
+			name = shorthands[name[:1]]
+		}
+		if !isSecretName(name) {
+			continue
+		}
+		if hasValue {
+			redacted[i] = flag + "=" + REDACTED
+		} else {
+			valueIsSecret = true
+		}
+	}
+	return strings.Join(redacted, " ")
+}
+
+// A JSON document with the values of secret fields replaced, like the response of getCredentials
+func RedactJSON(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	// Keeps large ids as they are
+	decoder.UseNumber()
+	var value interface{}
+	err := decoder.Decode(&value)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(redactValue(value))
+}
+
+func redactValue(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, child := range typed {
+			if isSecretName(key) {
+				typed[key] = redactSecret(child)
+			} else {
+				typed[key] = redactValue(child)
+			}
+		}
+	case []interface{}:
+		for i, child := range typed {
+			typed[i] = redactValue(child)
+		}
+	}
+	return value
+}
+
+// The value of a secret field with its JSON type kept, so a recording still unmarshals into the datatype of the field,
+// like the []Software_Component_Password of passwords. Strings are replaced, dates become the zero time, numbers 0
+// and booleans false. Arrays and objects keep their shape with all of their values replaced.
+func redactSecret(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case string:
+		if _, err := time.Parse(time.RFC3339, typed); err == nil {
+			return time.Time{}.Format(time.RFC3339)
+		}
+		return REDACTED
+	case json.Number:
+		return json.Number("0")
+	case float64:
+		return 0
+	case bool:
+		return false
+	case map[string]interface{}:
+		for key, child := range typed {
+			typed[key] = redactSecret(child)
+		}
+	case []interface{}:
+		for i, child := range typed {
+			typed[i] = redactSecret(child)
+		}
+	}
+	return value
+}
+
+func isSecretName(name string) bool {
+	name = strings.ToLower(strings.NewReplacer("-", "", "_", "").Replace(name))
+	for _, secret := range SecretNames {
+		if strings.Contains(name, secret) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSecretParameter(service string, method string, index int) bool {
+	for _, secretIndex := range SecretParameters[service+"::"+method] {
+		if index == secretIndex {
+			return true
+		}
+	}
+	return false
+}
+
+func isSecretStringMethod(method string) bool {
+	for _, secretMethod := range SecretStringMethods {
+		if method == secretMethod {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+package client_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/sl"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+)
+
+var _ = Describe("Redact", func() {
+	Describe("RedactParameters", func() {
+		It("Redacts secret fields at any depth", func() {
+			template := datatypes.Virtual_Guest{
+				Hostname: sl.String("web1"),
+				SupplementalCreateObjectOptions: &datatypes.Virtual_Guest_SupplementalCreateObjectOptions{
+					BootMode: sl.String("HVM"),
+				},
+			}
+			args := []interface{}{
+				template,
+				map[string]interface{}{"username": "admin", "password": "hunter2", "users": []interface{}{map[string]interface{}{"apiKey": "abc"}}},
+			}
+			redacted := client.RedactParameters("SoftLayer_Virtual_Guest", "createObject", args)
+			Expect(redacted[0]).To(HaveKeyWithValue("hostname", "web1"))
+			Expect(redacted[1]).To(HaveKeyWithValue("username", "admin"))
+			Expect(redacted[1]).To(HaveKeyWithValue("password", client.REDACTED))
+			Expect(fmt.Sprint(redacted[1])).NotTo(ContainSubstring("abc"))
+		})
+		It("Redacts every parameter of a secret method", func() {
+			redacted := client.RedactParameters("SoftLayer_User_Customer", "updatePassword", []interface{}{"hunter2"})
+			Expect(redacted).To(Equal([]interface{}{client.REDACTED}))
+		})
+		It("Redacts the password parameters of a new user", func() {
+			template := datatypes.User_Customer{Username: sl.String("bob")}
+			args := []interface{}{&template, sl.String("hunter2"), sl.String("vpnhunter2")}
+			redacted := client.RedactParameters("SoftLayer_User_Customer", "createObject", args)
+			Expect(redacted).To(HaveLen(3))
+			Expect(redacted[0]).To(HaveKeyWithValue("username", "bob"))
+			Expect(redacted[1]).To(Equal(client.REDACTED))
+			Expect(redacted[2]).To(Equal(client.REDACTED))
+		})
+		It("Redacts the known secret parameters of other methods", func() {
+			args := []interface{}{map[string]interface{}{"title": "help"}, "the content", nil, "rootpw"}
+			redacted := client.RedactParameters("SoftLayer_Ticket", "createStandardTicket", args)
+			Expect(redacted[1]).To(Equal("the content"))
+			Expect(redacted[3]).To(Equal(client.REDACTED))
+		})
+		It("Redacts the strings of create and edit methods", func() {
+			args := []interface{}{map[string]interface{}{"hostname": "web1"}, "unknown", 12}
+			redacted := client.RedactParameters("SoftLayer_Some_Service", "editObject", args)
+			Expect(redacted[0]).To(HaveKeyWithValue("hostname", "web1"))
+			Expect(redacted[1]).To(Equal(client.REDACTED))
+			Expect(redacted[2]).To(BeNumerically("==", 12))
+		})
+		It("Keeps the strings of other methods", func() {
+			redacted := client.RedactParameters("SoftLayer_Virtual_Guest", "setTags", []interface{}{"web,db"})
+			Expect(redacted).To(Equal([]interface{}{"web,db"}))
+		})
+	})
+
+	Describe("RedactCommandLine", func() {
+		It("Redacts the values of secret flags", func() {
+			args := []string{"sl", "user", "create", "bob", "--password", "hunter2", "--api-key=abc", "--email", "bob@example.com"}
+			Expect(client.RedactCommandLine(args, nil)).To(Equal("sl user create bob --password REDACTED --api-key=REDACTED --email bob@example.com"))
+		})
+		It("Redacts the values of secret shorthand flags", func() {
+			shorthands := map[string]string{"p": "password", "P": "password", "f": "force"}
+			args := []string{"sl", "block", "access-password", "123", "-p", "hunter2", "-f"}
+			Expect(client.RedactCommandLine(args, shorthands)).To(Equal("sl block access-password 123 -p REDACTED -f"))
+			args = []string{"sl", "hw", "create-credential", "123", "-Phunter2", "-p=hunter2", "-f", "abc"}
+			Expect(client.RedactCommandLine(args, shorthands)).To(Equal("sl hw create-credential 123 -P=REDACTED -p=REDACTED -f abc"))
+		})
+	})
+
+	Describe("RedactJSON", func() {
+		It("Redacts secret fields and keeps the rest as it is", func() {
+			response := `[{"id": 1234567890123456789, "username": "admin", "password": "hunter2", "authenticationKeys": [{"authenticationKey": "abc"}]}]`
+			redacted, err := client.RedactJSON([]byte(response))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(redacted)).To(ContainSubstring(`"id":1234567890123456789`))
+			Expect(string(redacted)).To(ContainSubstring(`"username":"admin"`))
+			Expect(string(redacted)).To(ContainSubstring(`"password":"REDACTED"`))
+			Expect(string(redacted)).NotTo(ContainSubstring("hunter2"))
+			Expect(string(redacted)).NotTo(ContainSubstring("abc"))
+		})
+		It("Keeps the JSON type of secret fields", func() {
+			response := `{"passwordCount": 2, "passwordExpireDate": "2024-01-01T00:00:00-06:00", "secondaryPasswordTimeoutDays": 30,` +
+				`"passwords": [{"id": 7, "username": "root", "password": "hunter2"}], "apiKey": null}`
+			redacted, err := client.RedactJSON([]byte(response))
+			Expect(err).NotTo(HaveOccurred())
+			var user datatypes.User_Customer
+			Expect(json.Unmarshal(redacted, &user)).To(Succeed())
+			Expect(user.PasswordExpireDate.IsZero()).To(BeTrue())
+			Expect(*user.SecondaryPasswordTimeoutDays).To(Equal(0))
+			var component datatypes.Software_Component
+			Expect(json.Unmarshal(redacted, &component)).To(Succeed())
+			Expect(*component.PasswordCount).To(Equal(uint(0)))
+			Expect(component.Passwords).To(HaveLen(1))
+			Expect(*component.Passwords[0].Id).To(Equal(0))
+			Expect(*component.Passwords[0].Password).To(Equal(client.REDACTED))
+			Expect(string(redacted)).NotTo(ContainSubstring("hunter2"))
+			Expect(string(redacted)).NotTo(ContainSubstring("root"))
+		})
+		It("Errors on invalid JSON", func() {
+			_, err := client.RedactJSON([]byte("{"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
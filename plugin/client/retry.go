@@ -0,0 +1,118 @@
+package client
+
+import (
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"syscall"
+	"time"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/trace"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/plugin"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+)
+
+const (
+	// Plugin config keys
+	SoftlayerRetries   = "SoftlayerRetries"
+	SoftlayerRetryWait = "SoftlayerRetryWait"
+
+	DefaultRetries   = 3
+	DefaultRetryWait = 1 * time.Second
+	MaxRetryWait     = 60 * time.Second
+)
+
+// HTTP status codes worth trying again. 599 is what softlayer-go uses for client side timeouts.
+var retryableStatusCodes = []int{408, 429, 502, 503, 504, 599}
+
+// Number of times a failed read request is retried, from the plugin config
+func GetRetries(context plugin.PluginContext) int {
+	retries, err := context.PluginConfig().GetIntWithDefault(SoftlayerRetries, DefaultRetries)
+	if err != nil || retries < 0 {
+		return DefaultRetries
+	}
+	return retries
+}
+
+// How long to wait before the first retry, from the plugin config (in seconds)
+func GetRetryWait(context plugin.PluginContext) time.Duration {
+	seconds, err := context.PluginConfig().GetIntWithDefault(SoftlayerRetryWait, 0)
+	if err != nil || seconds <= 0 {
+		return DefaultRetryWait
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// True for rate limiting, gateway errors, timeouts and connection resets. These usually work on a second try.
+func IsRetryableError(err error) bool {
+	var slError sl.Error
+	if !errors.As(err, &slError) {
+		// The XML-RPC transport returns network errors as they are
+		return isNetworkError(err)
+	}
+	if slError.Exception == "SoftLayer_Exception_WebService_RateLimitExceeded" {
+		return true
+	}
+	for _, code := range retryableStatusCodes {
+		if slError.StatusCode == code {
+			return true
+		}
+	}
+	return isNetworkError(slError.Wrapped)
+}
+
+// Connection resets, dropped connections and timeouts
+func isNetworkError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var netError net.Error
+	if errors.As(err, &netError) && netError.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// Exponential backoff with jitter, attempt starts at 1.
+// Waits between half and all of RetryWait * 2^(attempt-1), never more than MaxRetryWait
+func (r *CLIRestTransport) retryWait(attempt int) time.Duration {
+	wait := r.RetryWait
+	if wait <= 0 {
+		wait = DefaultRetryWait
+	}
+	for i := 1; i < attempt && wait < MaxRetryWait; i++ {
+		wait = wait * 2
+	}
+	if wait > MaxRetryWait {
+		wait = MaxRetryWait
+	}
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// Sends the request, retrying read methods that fail with a retryable error
+func (r *CLIRestTransport) doRequestWithRetries(sess *session.Session, service string, method string, args []interface{}, options *sl.Options, pResult interface{}) error {
+	err := r.send(sess, service, method, args, options, pResult)
+	if !IsReadOnlyMethod(method) {
+		return err
+	}
+	for attempt := 1; attempt <= r.Retries && IsRetryableError(err); attempt++ {
+		wait := r.retryWait(attempt)
+		subs := map[string]interface{}{
+			"SERVICE": service, "METHOD": method, "WAIT": wait.String(),
+			"ATTEMPT": attempt, "RETRIES": r.Retries, "ERROR": err.Error(),
+		}
+		trace.Logger.Println(T("Retrying {{.SERVICE}}::{{.METHOD}} in {{.WAIT}}, attempt {{.ATTEMPT}} of {{.RETRIES}}: {{.ERROR}}", subs))
+		if r.Sleep != nil {
+			r.Sleep(wait)
+		} else {
+			time.Sleep(wait)
+		}
+		err = r.send(sess, service, method, args, options, pResult)
+	}
+	return err
+}
@@ -0,0 +1,99 @@
+package client_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+)
+
+var _ = Describe("CLIRestTransport retries", func() {
+	var (
+		transport *client.CLIRestTransport
+		sess      *session.Session
+		server    *httptest.Server
+		requests  int
+		failures  int
+		waits     []time.Duration
+	)
+	BeforeEach(func() {
+		requests = 0
+		failures = 2
+		waits = []time.Duration{}
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if requests <= failures {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprint(w, `{"error": "Service Unavailable", "code": "SoftLayer_Exception_Public"}`)
+				return
+			}
+			fmt.Fprint(w, `{"id": 1234, "hostname": "web1"}`)
+		}))
+		transport = &client.CLIRestTransport{
+			RestTransport: &session.RestTransport{},
+			Retries:       3,
+			RetryWait:     time.Second,
+			Sleep:         func(wait time.Duration) { waits = append(waits, wait) },
+		}
+		sess = &session.Session{Endpoint: server.URL, TransportHandler: transport}
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+	It("Retries read requests with backoff", func() {
+		var result datatypes.Virtual_Guest
+		err := transport.DoRequest(sess, "SoftLayer_Virtual_Guest", "getObject", nil, &sl.Options{Id: sl.Int(1234)}, &result)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*result.Hostname).To(Equal("web1"))
+		Expect(requests).To(Equal(3))
+		Expect(waits).To(HaveLen(2))
+		Expect(waits[0]).To(BeNumerically(">=", 500*time.Millisecond))
+		Expect(waits[0]).To(BeNumerically("<=", time.Second))
+		Expect(waits[1]).To(BeNumerically(">=", time.Second))
+		Expect(waits[1]).To(BeNumerically("<=", 2*time.Second))
+	})
+	It("Gives up after the configured retries", func() {
+		failures = 10
+		var result datatypes.Virtual_Guest
+		err := transport.DoRequest(sess, "SoftLayer_Virtual_Guest", "getObject", nil, &sl.Options{}, &result)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Service Unavailable"))
+		Expect(requests).To(Equal(4))
+		Expect(waits).To(HaveLen(3))
+	})
+	It("Does not retry methods that make changes", func() {
+		var result bool
+		err := transport.DoRequest(sess, "SoftLayer_Virtual_Guest", "deleteObject", nil, &sl.Options{}, &result)
+		Expect(err).To(HaveOccurred())
+		Expect(requests).To(Equal(1))
+		Expect(waits).To(BeEmpty())
+	})
+	It("Does not retry when retries are turned off", func() {
+		transport.Retries = 0
+		var result datatypes.Virtual_Guest
+		err := transport.DoRequest(sess, "SoftLayer_Virtual_Guest", "getObject", nil, &sl.Options{}, &result)
+		Expect(err).To(HaveOccurred())
+		Expect(requests).To(Equal(1))
+	})
+	Describe("IsRetryableError", func() {
+		It("Retries transient errors", func() {
+			Expect(client.IsRetryableError(sl.Error{StatusCode: 429})).To(BeTrue())
+			Expect(client.IsRetryableError(sl.Error{StatusCode: 502})).To(BeTrue())
+			Expect(client.IsRetryableError(sl.Error{StatusCode: 504})).To(BeTrue())
+			Expect(client.IsRetryableError(sl.Error{StatusCode: 500, Exception: "SoftLayer_Exception_WebService_RateLimitExceeded"})).To(BeTrue())
+		})
+		It("Does not retry other errors", func() {
+			Expect(client.IsRetryableError(nil)).To(BeFalse())
+			Expect(client.IsRetryableError(sl.Error{StatusCode: 404, Exception: "SoftLayer_Exception_ObjectNotFound"})).To(BeFalse())
+			Expect(client.IsRetryableError(sl.Error{StatusCode: 500, Exception: "SoftLayer_Exception_Public"})).To(BeFalse())
+		})
+	})
+})
@@ -45,20 +45,71 @@ func GetTimeout(timeout int) time.Duration {
 }
 
 func NewSoftlayerClientSessionFromConfig(context plugin.PluginContext) (*session.Session, error) {
+	return NewSoftlayerClientSessionWithProfile(context, "")
+}
+
+// Same as NewSoftlayerClientSessionFromConfig, with the settings of a profile on top.
+// profileName is the value of --profile, see GetSelectedProfile for how the profile is picked.
+func NewSoftlayerClientSessionWithProfile(context plugin.PluginContext, profileName string) (*session.Session, error) {
+	profile, err := GetSelectedProfile(context, profileName)
+	if err != nil {
+		return nil, err
+	}
 
 	token := context.IAMToken()
 
+	endpoint := GetSLApiEndPoint(context)
+	// ENV_SL_API_ENDPOINT still wins over the profile
+	if profile != nil && profile.Endpoint != "" && os.Getenv(ENV_SL_API_ENDPOINT) == "" {
+		endpoint = profile.Endpoint
+	}
+	transportType := GetTransportType(context, endpoint)
+
 	transportHandler := &CLIRestTransport{
 		Context:       context,
 		RestTransport: &session.RestTransport{},
+		Retries:       GetRetries(context),
+		RetryWait:     GetRetryWait(context),
+		Profile:       profile,
+	}
+	var apiTransport session.TransportHandler = transportHandler.RestTransport
+	if transportType == TransportXMLRPC {
+		apiTransport = NewXmlRpcTransport()
+		transportHandler.Handler = apiTransport
+	}
+	if os.Getenv(ENV_SL_REPLAY_DIR) != "" {
+		transportHandler.Handler = NewReplayTransport(os.Getenv(ENV_SL_REPLAY_DIR))
+	} else if os.Getenv(ENV_SL_RECORD_DIR) != "" {
+		transportHandler.Handler = NewRecordingTransport(apiTransport, os.Getenv(ENV_SL_RECORD_DIR))
+	}
+	if maxRPS := GetMaxRPS(context); maxRPS > 0 {
+		transportHandler.RateLimiter = NewRateLimiter(maxRPS)
+	}
+	if JournalEnabled(context) {
+		transportHandler.Journal = NewJournal(GetJournalFile())
+		transportHandler.Journal.User = context.UserEmail()
+		transportHandler.Journal.Account = context.IMSAccountID()
+		// The ibmcloud login has nothing to do with the API key of a profile, and its account isn't known without asking the API
+		if profile != nil && profile.HasAPIKey() {
+			transportHandler.Journal.User = profile.Username
+			transportHandler.Journal.Account = ""
+		}
+	}
+	cacheTTL := GetCacheTTL(context)
+	if cacheTTL > 0 {
+		transportHandler.Cache = NewResponseCache(GetCacheDir(), cacheTTL)
 	}
 	sess := &session.Session{
-		Endpoint:         GetSLApiEndPoint(context),
+		Endpoint:         EndpointForTransport(endpoint, transportType),
 		Debug:            GetDebug(context.Trace()),
 		Timeout:          GetTimeout(context.HTTPTimeout()),
 		IAMToken:         token,
 		TransportHandler: transportHandler,
 	}
+	if profile != nil {
+		// Only the API calls need the API key, so commands like `sl config profile-use` still run without it
+		transportHandler.ProfileErr = profile.Apply(sess)
+	}
 	sess.AppendUserAgent(metadata.UsageAgentHeader)
 	return sess, nil
 }
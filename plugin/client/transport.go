@@ -1,28 +1,265 @@
 package client
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/trace"
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/plugin"
 	"github.com/softlayer/softlayer-go/session"
 	"github.com/softlayer/softlayer-go/sl"
+
+	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 )
 
+// Methods that don't change anything, so they are still sent to the API during a --dry-run.
+// Anything starting with "get" is also allowed.
+var DryRunReadMethods = []string{
+	"verifyOrder",
+	"generateOrderTemplate",
+	"search",
+	"advancedSearch",
+}
+
+// Wraps the transport that talks to the API (REST unless Handler is set) with IAM token refresh,
+// retries, caching, rate limiting, --dry-run and the journal.
 type CLIRestTransport struct {
 	*session.RestTransport
 	Context plugin.PluginContext
+	// When true, only read methods are sent to the API. Everything else is printed to DryRunOutput instead.
+	DryRun       bool
+	DryRunOutput io.Writer
+	// The requests that were not sent because of DryRun, use BlockedRequests to read it while requests are running
+	DryRunBlocked []DryRunRequest
+	// Guards DryRunBlocked and DryRunOutput, one transport is shared by the workers of bulk and paged commands
+	dryRunMutex sync.Mutex
+	// How many times read requests are retried after a transient failure, and how long to wait before the first retry
+	Retries   int
+	RetryWait time.Duration
+	// Used instead of time.Sleep between retries when set
+	Sleep func(time.Duration)
+	// Catalog responses are saved here when set, see IsCacheable
+	Cache *ResponseCache
+	// Spaces out the requests sent to the API (retries included), nil for no limit
+	RateLimiter *RateLimiter
+	// Sends the requests, like a RecordingTransport or ReplayTransport. RestTransport is used when this is nil
+	Handler session.TransportHandler
+	// The profile the session was made from, nil if there isn't one
+	Profile *Profile
+	// Why Profile couldn't be applied to the session, every request returns it instead of going to the API
+	ProfileErr error
+	// Every request that isn't read only is written here when set
+	Journal *Journal
+}
+
+// What a request blocked by --dry-run would have sent to the API
+type DryRunRequest struct {
+	Service    string        `json:"service"`
+	Method     string        `json:"method"`
+	Id         *int          `json:"id,omitempty"`
+	Mask       string        `json:"mask,omitempty"`
+	Filter     string        `json:"filter,omitempty"`
+	Parameters []interface{} `json:"parameters"`
+}
+
+// The error returned in place of the API result when --dry-run blocks a request
+type DryRunError struct {
+	Service string
+	Method  string
+}
+
+func (err *DryRunError) Error() string {
+	subs := map[string]interface{}{"SERVICE": err.Service, "METHOD": err.Method}
+	return T("Dry run: {{.SERVICE}}::{{.METHOD}} was not sent.", subs)
+}
+
+// True if method only reads data
+func IsReadOnlyMethod(method string) bool {
+	if strings.HasPrefix(method, "get") {
+		return true
+	}
+	for _, readMethod := range DryRunReadMethods {
+		if method == readMethod {
+			return true
+		}
+	}
+	return false
 }
 
 func (r *CLIRestTransport) DoRequest(sess *session.Session, service string, method string, args []interface{}, options *sl.Options, pResult interface{}) error {
-	err := r.RestTransport.DoRequest(sess, service, method, args, options, pResult)
+	if r.ProfileErr != nil {
+		return r.ProfileErr
+	}
+	if r.DryRun && !IsReadOnlyMethod(method) {
+		return r.blockRequest(service, method, args, options)
+	}
+	useCache := r.Cache != nil && IsCacheable(service, method)
+	var cacheKey CacheKey
+	if useCache {
+		cacheKey = NewCacheKey(sess.Endpoint, r.accountId(), service, method, args, options)
+		if r.Cache.Get(cacheKey, options, pResult) {
+			return nil
+		}
+	}
+	err := r.doRequestWithRetries(sess, service, method, args, options, pResult)
 	slError, ok := err.(sl.Error)
 	if ok {
-		if slError.StatusCode == 500 && slError.Exception == "SoftLayer_Exception_Account_Authentication_AccessTokenValidation" {
+		// REST returns this with a 500 status, XML-RPC faults don't always have one, so only the exception is checked
+		if slError.Exception == "SoftLayer_Exception_Account_Authentication_AccessTokenValidation" {
 			newIAMToken, tokenErr := r.Context.RefreshIAMToken()
 			if tokenErr != nil {
 				return tokenErr
 			}
 			sess.IAMToken = newIAMToken
-			err = r.RestTransport.DoRequest(sess, service, method, args, options, pResult)
+			err = r.doRequestWithRetries(sess, service, method, args, options, pResult)
+		}
+	}
+	if err == nil && useCache {
+		cacheErr := r.Cache.Set(cacheKey, options, pResult)
+		if cacheErr != nil {
+			trace.Logger.Println(T("Failed to cache response: "), cacheErr.Error())
+		}
+	}
+	if r.Journal != nil && !IsReadOnlyMethod(method) {
+		var id *int
+		if options != nil {
+			id = options.Id
+		}
+		journalErr := r.Journal.Record(service, method, id, args, err)
+		if journalErr != nil {
+			trace.Logger.Println(T("Failed to write to the journal: "), journalErr.Error())
 		}
 	}
 	return err
 }
+
+// Hands the request to Handler, or the RestTransport if there isn't one, once RateLimiter allows it
+func (r *CLIRestTransport) send(sess *session.Session, service string, method string, args []interface{}, options *sl.Options, pResult interface{}) error {
+	r.RateLimiter.Wait()
+	if r.Handler != nil {
+		return r.Handler.DoRequest(sess, service, method, args, options, pResult)
+	}
+	return r.RestTransport.DoRequest(sess, service, method, args, options, pResult)
+}
+
+// Different accounts can see different prices, so the account is part of the cache key.
+// A profile with an API key isn't the account of the ibmcloud login, its user stands in for the account.
+func (r *CLIRestTransport) accountId() string {
+	if r.Profile != nil && r.Profile.HasAPIKey() {
+		return "user:" + r.Profile.Username
+	}
+	if r.Context == nil {
+		return ""
+	}
+	return r.Context.IMSAccountID()
+}
+
+// Prints the request that would have been sent, and returns a DryRunError so the command stops there.
+func (r *CLIRestTransport) blockRequest(service string, method string, args []interface{}, options *sl.Options) error {
+	request := DryRunRequest{Service: service, Method: method, Parameters: args}
+	if request.Parameters == nil {
+		request.Parameters = []interface{}{}
+	}
+	if options != nil {
+		request.Id = options.Id
+		request.Mask = options.Mask
+		request.Filter = options.Filter
+	}
+	jsonBytes, err := json.MarshalIndent(request, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	r.dryRunMutex.Lock()
+	defer r.dryRunMutex.Unlock()
+	r.DryRunBlocked = append(r.DryRunBlocked, request)
+	output := r.DryRunOutput
+	if output == nil {
+		output = os.Stdout
+	}
+	fmt.Fprintf(output, "%s\n%s\n", T("Dry run, this request was not sent:"), jsonBytes)
+	return &DryRunError{Service: service, Method: method}
+}
+
+// The parts of a CLIRestTransport that the global flags (--dry-run, --retries, --max-rps, ...) change
+type TransportSettings struct {
+	DryRun       bool
+	DryRunOutput io.Writer
+	Retries      int
+	RetryWait    time.Duration
+	Cache        *ResponseCache
+	RateLimiter  *RateLimiter
+}
+
+// The current settings, to put back with RestoreSettings once a command that changed them is done
+func (r *CLIRestTransport) Settings() TransportSettings {
+	r.dryRunMutex.Lock()
+	defer r.dryRunMutex.Unlock()
+	return TransportSettings{
+		DryRun:       r.DryRun,
+		DryRunOutput: r.DryRunOutput,
+		Retries:      r.Retries,
+		RetryWait:    r.RetryWait,
+		Cache:        r.Cache,
+		RateLimiter:  r.RateLimiter,
+	}
+}
+
+// Puts back settings saved with Settings
+func (r *CLIRestTransport) RestoreSettings(settings TransportSettings) {
+	r.dryRunMutex.Lock()
+	defer r.dryRunMutex.Unlock()
+	r.DryRun = settings.DryRun
+	r.DryRunOutput = settings.DryRunOutput
+	r.Retries = settings.Retries
+	r.RetryWait = settings.RetryWait
+	r.Cache = settings.Cache
+	r.RateLimiter = settings.RateLimiter
+}
+
+// A copy of DryRunBlocked that is safe to read while requests are running
+func (r *CLIRestTransport) BlockedRequests() []DryRunRequest {
+	r.dryRunMutex.Lock()
+	defer r.dryRunMutex.Unlock()
+	return append([]DryRunRequest{}, r.DryRunBlocked...)
+}
+
+// True if err is, or wraps, the DryRunError of a request this transport blocked.
+// Most commands turn API errors into an APIError with the message of the error, so an APIError with the
+// exact message of a blocked request counts as well.
+func (r *CLIRestTransport) IsDryRunError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var dryRunErr *DryRunError
+	if errors.As(err, &dryRunErr) {
+		return true
+	}
+	var apiErr *slErr.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	for _, request := range r.BlockedRequests() {
+		blockedErr := &DryRunError{Service: request.Service, Method: request.Method}
+		if strings.TrimSpace(apiErr.APIMessage) == blockedErr.Error() {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the CLIRestTransport of sess, if it has one
+func GetCLITransport(sess *session.Session) (*CLIRestTransport, bool) {
+	if sess == nil {
+		return nil, false
+	}
+	transport, ok := sess.TransportHandler.(*CLIRestTransport)
+	return transport, ok
+}
@@ -0,0 +1,144 @@
+package client_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+)
+
+var _ = Describe("CLIRestTransport", func() {
+	var (
+		transport *client.CLIRestTransport
+		sess      *session.Session
+		output    *bytes.Buffer
+	)
+	BeforeEach(func() {
+		output = new(bytes.Buffer)
+		transport = &client.CLIRestTransport{
+			RestTransport: &session.RestTransport{},
+			DryRun:        true,
+			DryRunOutput:  output,
+		}
+		// Nothing listens here, so any request that isn't blocked would fail
+		sess = &session.Session{Endpoint: "http://127.0.0.1:1/rest/v3.1", TransportHandler: transport}
+	})
+	Describe("IsReadOnlyMethod", func() {
+		It("Allows read methods", func() {
+			Expect(client.IsReadOnlyMethod("getObject")).To(BeTrue())
+			Expect(client.IsReadOnlyMethod("getVirtualGuests")).To(BeTrue())
+			Expect(client.IsReadOnlyMethod("verifyOrder")).To(BeTrue())
+			Expect(client.IsReadOnlyMethod("generateOrderTemplate")).To(BeTrue())
+		})
+		It("Blocks methods that make changes", func() {
+			Expect(client.IsReadOnlyMethod("createObject")).To(BeFalse())
+			Expect(client.IsReadOnlyMethod("editObject")).To(BeFalse())
+			Expect(client.IsReadOnlyMethod("deleteObject")).To(BeFalse())
+			Expect(client.IsReadOnlyMethod("placeOrder")).To(BeFalse())
+			Expect(client.IsReadOnlyMethod("cancelItem")).To(BeFalse())
+		})
+	})
+	Describe("DoRequest with DryRun", func() {
+		It("Prints blocked requests instead of sending them", func() {
+			options := &sl.Options{Id: sl.Int(1234), Mask: "mask[id,hostname]"}
+			args := []interface{}{datatypes.Virtual_Guest{Hostname: sl.String("web1")}}
+			var result bool
+			err := transport.DoRequest(sess, "SoftLayer_Virtual_Guest", "editObject", args, options, &result)
+			Expect(err).To(HaveOccurred())
+			Expect(err).To(BeAssignableToTypeOf(&client.DryRunError{}))
+			Expect(err.Error()).To(Equal("Dry run: SoftLayer_Virtual_Guest::editObject was not sent."))
+			Expect(output.String()).To(ContainSubstring(`"service": "SoftLayer_Virtual_Guest"`))
+			Expect(output.String()).To(ContainSubstring(`"method": "editObject"`))
+			Expect(output.String()).To(ContainSubstring(`"id": 1234`))
+			Expect(output.String()).To(ContainSubstring(`"mask": "mask[id,hostname]"`))
+			Expect(output.String()).To(ContainSubstring(`"hostname": "web1"`))
+			Expect(transport.DryRunBlocked).To(HaveLen(1))
+			Expect(transport.DryRunBlocked[0].Method).To(Equal("editObject"))
+		})
+		It("Handles requests without options or parameters", func() {
+			err := transport.DoRequest(sess, "SoftLayer_Virtual_Guest", "deleteObject", nil, nil, nil)
+			Expect(err).To(BeAssignableToTypeOf(&client.DryRunError{}))
+			Expect(output.String()).To(ContainSubstring(`"parameters": []`))
+			Expect(output.String()).NotTo(ContainSubstring(`"id"`))
+		})
+		It("Sends read requests", func() {
+			var result datatypes.Virtual_Guest
+			err := transport.DoRequest(sess, "SoftLayer_Virtual_Guest", "getObject", nil, &sl.Options{Id: sl.Int(1234)}, &result)
+			Expect(err).To(HaveOccurred())
+			Expect(err).NotTo(BeAssignableToTypeOf(&client.DryRunError{}))
+			Expect(output.String()).To(Equal(""))
+			Expect(transport.DryRunBlocked).To(BeEmpty())
+		})
+	})
+	Describe("DoRequest with DryRun from several goroutines", func() {
+		It("Records every blocked request", func() {
+			var wg sync.WaitGroup
+			for i := 0; i < 20; i++ {
+				wg.Add(1)
+				go func(id int) {
+					defer wg.Done()
+					defer GinkgoRecover()
+					err := transport.DoRequest(sess, "SoftLayer_Virtual_Guest", "rebootSoft", nil, &sl.Options{Id: sl.Int(id)}, nil)
+					Expect(err).To(BeAssignableToTypeOf(&client.DryRunError{}))
+				}(i)
+			}
+			wg.Wait()
+			Expect(transport.BlockedRequests()).To(HaveLen(20))
+			Expect(bytes.Count(output.Bytes(), []byte(`"method": "rebootSoft"`))).To(Equal(20))
+		})
+	})
+	Describe("IsDryRunError", func() {
+		var blockedErr error
+		BeforeEach(func() {
+			blockedErr = transport.DoRequest(sess, "SoftLayer_Virtual_Guest", "deleteObject", nil, nil, nil)
+		})
+		It("Is true for the DryRunError and errors that wrap it", func() {
+			Expect(transport.IsDryRunError(blockedErr)).To(BeTrue())
+			Expect(transport.IsDryRunError(fmt.Errorf("Failed to cancel: %w", blockedErr))).To(BeTrue())
+		})
+		It("Is true for an APIError with the message of a blocked request", func() {
+			Expect(transport.IsDryRunError(slErr.NewAPIError("Failed to cancel.\n", blockedErr.Error(), 2))).To(BeTrue())
+		})
+		It("Is false for any other error", func() {
+			Expect(transport.IsDryRunError(nil)).To(BeFalse())
+			Expect(transport.IsDryRunError(errors.New("Internal Server Error"))).To(BeFalse())
+			Expect(transport.IsDryRunError(slErr.NewAPIError("Failed to get the server.\n", "Internal Server Error", 2))).To(BeFalse())
+			notBlocked := &client.DryRunError{Service: "SoftLayer_Hardware_Server", Method: "editObject"}
+			Expect(transport.IsDryRunError(slErr.NewAPIError("Failed to edit.\n", notBlocked.Error(), 2))).To(BeFalse())
+		})
+	})
+	Describe("Settings", func() {
+		It("Puts back what the global flags changed", func() {
+			settings := transport.Settings()
+			transport.DryRun = false
+			transport.DryRunOutput = nil
+			transport.Retries = 5
+			transport.RateLimiter = client.NewRateLimiter(2)
+			transport.RestoreSettings(settings)
+			Expect(transport.DryRun).To(BeTrue())
+			Expect(transport.DryRunOutput).To(Equal(output))
+			Expect(transport.Retries).To(Equal(0))
+			Expect(transport.RateLimiter).To(BeNil())
+		})
+	})
+	Describe("GetCLITransport", func() {
+		It("Finds the transport", func() {
+			found, ok := client.GetCLITransport(sess)
+			Expect(ok).To(BeTrue())
+			Expect(found).To(Equal(transport))
+		})
+		It("Handles a nil session", func() {
+			_, ok := client.GetCLITransport(nil)
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,117 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"strings"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/plugin"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+const (
+	// Plugin config key and environment variable that pick the API transport, TransportREST or TransportXMLRPC
+	SoftlayerTransport = "SoftlayerTransport"
+	ENV_SL_TRANSPORT   = "SL_TRANSPORT"
+
+	TransportREST   = "rest"
+	TransportXMLRPC = "xmlrpc"
+)
+
+// Which transport to use. SL_TRANSPORT, then the plugin config, then whatever the endpoint is for.
+func GetTransportType(context plugin.PluginContext, endpoint string) string {
+	transportType := strings.ToLower(os.Getenv(ENV_SL_TRANSPORT))
+	if transportType == "" {
+		transportType, _ = context.PluginConfig().GetStringWithDefault(SoftlayerTransport, "")
+		transportType = strings.ToLower(transportType)
+	}
+	if transportType == TransportREST || transportType == TransportXMLRPC {
+		return transportType
+	}
+	if strings.Contains(endpoint, "/xmlrpc/") {
+		return TransportXMLRPC
+	}
+	return TransportREST
+}
+
+// The REST and XML-RPC APIs live at different paths, this points endpoint at the one transportType uses
+func EndpointForTransport(endpoint string, transportType string) string {
+	if transportType == TransportXMLRPC {
+		return strings.Replace(endpoint, "/rest/", "/xmlrpc/", 1)
+	}
+	return strings.Replace(endpoint, "/xmlrpc/", "/rest/", 1)
+}
+
+// softlayer-go's XmlRpcTransport only authenticates with API keys, this sends the IAM token of the session as well.
+type XmlRpcTransport struct {
+	*session.XmlRpcTransport
+}
+
+func NewXmlRpcTransport() *XmlRpcTransport {
+	return &XmlRpcTransport{XmlRpcTransport: &session.XmlRpcTransport{}}
+}
+
+func (x *XmlRpcTransport) DoRequest(sess *session.Session, service string, method string, args []interface{}, options *sl.Options, pResult interface{}) error {
+	if options == nil {
+		options = &sl.Options{}
+	}
+	if sess.IAMToken == "" {
+		return x.XmlRpcTransport.DoRequest(sess, service, method, args, options, pResult)
+	}
+	// A copy of the session and its client, so the token is read again for every request and a refreshed token gets used
+	iamSession := *sess
+	iamClient := http.Client{}
+	if sess.HTTPClient != nil {
+		iamClient = *sess.HTTPClient
+	}
+	iamClient.Transport = &iamRoundTripper{token: sess.IAMToken, base: xmlRpcRoundTripper(sess)}
+	iamSession.HTTPClient = &iamClient
+	return x.XmlRpcTransport.DoRequest(&iamSession, service, method, args, options, pResult)
+}
+
+// The round tripper softlayer-go would have used for sess: the one of its HTTPClient, otherwise the default one,
+// which logs every request and response when the session is in debug mode (--trace or IBMCLOUD_TRACE)
+func xmlRpcRoundTripper(sess *session.Session) http.RoundTripper {
+	if sess.HTTPClient != nil && sess.HTTPClient.Transport != nil {
+		return sess.HTTPClient.Transport
+	}
+	if sess.Debug {
+		return &debugRoundTripper{base: http.DefaultTransport}
+	}
+	return http.DefaultTransport
+}
+
+type iamRoundTripper struct {
+	token string
+	base  http.RoundTripper
+}
+
+func (t *iamRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	request = request.Clone(request.Context())
+	request.Header.Set("Authorization", t.token)
+	return t.base.RoundTrip(request)
+}
+
+// Logs requests and responses like the debug round tripper of softlayer-go, which isn't exported
+type debugRoundTripper struct {
+	base http.RoundTripper
+}
+
+func (t *debugRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	session.Logger.Println("->>>Request:")
+	dumpedRequest, _ := httputil.DumpRequestOut(request, true)
+	session.Logger.Println(string(dumpedRequest))
+
+	response, err := t.base.RoundTrip(request)
+	if err != nil {
+		session.Logger.Println("Error:", err)
+		return response, err
+	}
+
+	session.Logger.Println("\n\n<<<-Response:")
+	dumpedResponse, _ := httputil.DumpResponse(response, true)
+	session.Logger.Println(string(dumpedResponse))
+	return response, err
+}
@@ -0,0 +1,150 @@
+package client_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/plugin/pluginfakes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
+)
+
+const xmlRpcTokenFault = `<?xml version="1.0"?><methodResponse><fault><value><struct>
+<member><name>faultCode</name><value><string>SoftLayer_Exception_Account_Authentication_AccessTokenValidation</string></value></member>
+<member><name>faultString</name><value><string>The token has expired.</string></value></member>
+</struct></value></fault></methodResponse>`
+
+const xmlRpcGuest = `<?xml version="1.0"?><methodResponse><params><param><value><struct>
+<member><name>id</name><value><int>1234</int></value></member>
+<member><name>hostname</name><value><string>web1</string></value></member>
+</struct></value></param></params></methodResponse>`
+
+var _ = Describe("XML-RPC transport", func() {
+	var (
+		fakeContext *pluginfakes.FakePluginContext
+		fakeConfig  *testhelpers.FakePluginConfig
+	)
+	BeforeEach(func() {
+		fakeContext = new(pluginfakes.FakePluginContext)
+		fakeConfig = new(testhelpers.FakePluginConfig)
+		fakeConfig.GetStringWithDefaultReturns("", nil)
+		fakeContext.PluginConfigReturns(fakeConfig)
+	})
+	Describe("GetTransportType", func() {
+		AfterEach(func() {
+			os.Unsetenv(client.ENV_SL_TRANSPORT)
+		})
+		It("Defaults to REST", func() {
+			Expect(client.GetTransportType(fakeContext, client.SoftlayerAPIEndpointPublicDefault)).To(Equal(client.TransportREST))
+		})
+		It("Uses SL_TRANSPORT", func() {
+			os.Setenv(client.ENV_SL_TRANSPORT, "XMLRPC")
+			Expect(client.GetTransportType(fakeContext, client.SoftlayerAPIEndpointPublicDefault)).To(Equal(client.TransportXMLRPC))
+		})
+		It("Uses the plugin config", func() {
+			fakeConfig.GetStringWithDefaultReturns("xmlrpc", nil)
+			Expect(client.GetTransportType(fakeContext, client.SoftlayerAPIEndpointPublicDefault)).To(Equal(client.TransportXMLRPC))
+			Expect(fakeConfig.GetStringWithDefaultArgsForCall(0)).To(Equal(client.SoftlayerTransport))
+		})
+		It("Uses XML-RPC for an xmlrpc endpoint", func() {
+			Expect(client.GetTransportType(fakeContext, "https://api.softlayer.com/xmlrpc/v3.1")).To(Equal(client.TransportXMLRPC))
+		})
+	})
+	Describe("EndpointForTransport", func() {
+		It("Switches between the REST and XML-RPC endpoints", func() {
+			Expect(client.EndpointForTransport("https://api.softlayer.com/rest/v3.1", client.TransportXMLRPC)).To(Equal("https://api.softlayer.com/xmlrpc/v3.1"))
+			Expect(client.EndpointForTransport("https://api.softlayer.com/xmlrpc/v3.1", client.TransportREST)).To(Equal("https://api.softlayer.com/rest/v3.1"))
+			Expect(client.EndpointForTransport("https://api.softlayer.com/rest/v3.1", client.TransportREST)).To(Equal("https://api.softlayer.com/rest/v3.1"))
+		})
+	})
+	Describe("DoRequest", func() {
+		var (
+			server         *httptest.Server
+			authorizations []string
+			bodies         []string
+		)
+		BeforeEach(func() {
+			authorizations = []string{}
+			bodies = []string{}
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				bodies = append(bodies, string(body))
+				authorizations = append(authorizations, r.Header.Get("Authorization"))
+				w.Header().Set("Content-Type", "text/xml")
+				if r.Header.Get("Authorization") == "Bearer expired" {
+					fmt.Fprint(w, xmlRpcTokenFault)
+					return
+				}
+				fmt.Fprint(w, xmlRpcGuest)
+			}))
+		})
+		AfterEach(func() {
+			server.Close()
+		})
+		It("Sends the IAM token and refreshes it when it expires", func() {
+			fakeContext.RefreshIAMTokenReturns("Bearer fresh", nil)
+			transport := &client.CLIRestTransport{
+				RestTransport: &session.RestTransport{},
+				Context:       fakeContext,
+				Handler:       client.NewXmlRpcTransport(),
+			}
+			sess := &session.Session{Endpoint: server.URL + "/xmlrpc/v3.1", IAMToken: "Bearer expired", TransportHandler: transport}
+			var guest datatypes.Virtual_Guest
+			err := transport.DoRequest(sess, "SoftLayer_Virtual_Guest", "getObject", nil, &sl.Options{Id: sl.Int(1234)}, &guest)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*guest.Hostname).To(Equal("web1"))
+			Expect(authorizations).To(Equal([]string{"Bearer expired", "Bearer fresh"}))
+			Expect(fakeContext.RefreshIAMTokenCallCount()).To(Equal(1))
+			Expect(sess.IAMToken).To(Equal("Bearer fresh"))
+			Expect(strings.Contains(bodies[1], "<methodName>getObject</methodName>")).To(BeTrue())
+		})
+		It("Still logs the requests with the IAM token in debug mode", func() {
+			var logs bytes.Buffer
+			logger := session.Logger
+			session.Logger = log.New(&logs, "", 0)
+			defer func() { session.Logger = logger }()
+			transport := client.NewXmlRpcTransport()
+			sess := &session.Session{Endpoint: server.URL + "/xmlrpc/v3.1", IAMToken: "Bearer fresh", Debug: true}
+			var guest datatypes.Virtual_Guest
+			err := transport.DoRequest(sess, "SoftLayer_Virtual_Guest", "getObject", nil, &sl.Options{Id: sl.Int(1234)}, &guest)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(authorizations).To(Equal([]string{"Bearer fresh"}))
+			Expect(logs.String()).To(ContainSubstring("->>>Request:"))
+			Expect(logs.String()).To(ContainSubstring("<methodName>getObject</methodName>"))
+			Expect(logs.String()).To(ContainSubstring("<<<-Response:"))
+			Expect(sess.HTTPClient).To(BeNil())
+		})
+		It("Sends the IAM token through the client of the session", func() {
+			sent := 0
+			base := roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+				sent++
+				return http.DefaultTransport.RoundTrip(request)
+			})
+			transport := client.NewXmlRpcTransport()
+			sess := &session.Session{Endpoint: server.URL + "/xmlrpc/v3.1", IAMToken: "Bearer fresh", HTTPClient: &http.Client{Transport: base}}
+			var guest datatypes.Virtual_Guest
+			err := transport.DoRequest(sess, "SoftLayer_Virtual_Guest", "getObject", nil, &sl.Options{Id: sl.Int(1234)}, &guest)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sent).To(Equal(1))
+			Expect(authorizations).To(Equal([]string{"Bearer fresh"}))
+		})
+	})
+})
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
@@ -0,0 +1,203 @@
+package cmdutils
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
+)
+
+// How many servers a bulk command works on at the same time
+var BulkWorkers = 5
+
+// Which servers a power or lifecycle command works on, besides its IDENTIFIER arguments.
+// Identifiers (arguments and --from-file) and filters (--tag, --datacenter and --hostname-pattern) can't be mixed.
+type ServerSelector struct {
+	Tags            []string
+	Datacenter      string
+	HostnamePattern string
+	FromFile        string
+}
+
+// Adds --tag, --datacenter, --hostname-pattern and --from-file to a command, the values end up in selector.
+func AddServerSelectorFlags(cmd *cobra.Command, selector *ServerSelector) {
+	cmd.Flags().StringSliceVar(&selector.Tags, "tag", []string{}, T("Select the servers with this tag (multiple occurrence permitted)"))
+	cmd.Flags().StringVar(&selector.Datacenter, "datacenter", "", T("Select the servers in this datacenter"))
+	cmd.Flags().StringVar(&selector.HostnamePattern, "hostname-pattern", "", T("Select the servers with a matching hostname, like web* or *db*"))
+	cmd.Flags().StringVar(&selector.FromFile, "from-file", "", T("Read server identifiers from this file, one per line"))
+}
+
+// True when --tag, --datacenter or --hostname-pattern were given
+func (s ServerSelector) HasFilters() bool {
+	return len(s.Tags) > 0 || s.Datacenter != "" || s.HostnamePattern != ""
+}
+
+// True when the command works on anything but exactly one IDENTIFIER argument
+func (s ServerSelector) IsBulk(args []string) bool {
+	return len(args) != 1 || s.HasFilters() || s.FromFile != ""
+}
+
+// The identifiers from args and --from-file. Blank lines and lines starting with # are skipped,
+// and only the first word of a line is used, so the output of a list command can be cut down to a file.
+func (s ServerSelector) Identifiers(args []string) ([]string, error) {
+	identifiers := append([]string{}, args...)
+	if s.FromFile == "" {
+		return identifiers, nil
+	}
+	file, err := os.Open(s.FromFile) // #nosec
+	if err != nil {
+		return nil, errors.NewInvalidUsageError(T("Failed to read {{.FILE}}: {{.ERROR}}", map[string]interface{}{"FILE": s.FromFile, "ERROR": err.Error()}))
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		identifiers = append(identifiers, fields[0])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.NewInvalidUsageError(T("Failed to read {{.FILE}}: {{.ERROR}}", map[string]interface{}{"FILE": s.FromFile, "ERROR": err.Error()}))
+	}
+	return identifiers, nil
+}
+
+// cobra.PositionalArgs for commands with a ServerSelector, they take any number of identifiers instead of just one
+func ServerSelectorArgs(selector *ServerSelector) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		identifiers := len(args) > 0 || selector.FromFile != ""
+		if !identifiers && !selector.HasFilters() {
+			return fmt.Errorf("%s%s", T("Incorrect Usage: "),
+				T("This command requires one argument or more, or one of --tag, --datacenter, --hostname-pattern and --from-file."))
+		}
+		if identifiers && selector.HasFilters() {
+			return errors.NewInvalidUsageError(T("Identifiers and --from-file can't be used with --tag, --datacenter or --hostname-pattern."))
+		}
+		return nil
+	}
+}
+
+// A server a bulk command works on
+type BulkTarget struct {
+	Id   int
+	Name string
+}
+
+// What happened to one server of a bulk command
+type BulkResult struct {
+	Id      int    `json:"id"`
+	Name    string `json:"name,omitempty"`
+	Success bool   `json:"success"`
+	// True when --dry-run kept the action from being sent, Success is false and Error is empty then
+	DryRun bool   `json:"dryRun,omitempty"`
+	Error  string `json:"error,omitempty"`
+	err    error
+}
+
+// Runs action on every target, BulkWorkers at a time, and returns the results in the order of targets.
+// A failure doesn't stop the other targets.
+func RunBulkAction(targets []BulkTarget, action func(id int) error) []BulkResult {
+	results := make([]BulkResult, len(targets))
+	var waitGroup sync.WaitGroup
+	indexes := make(chan int)
+	for w := 0; w < min(BulkWorkers, len(targets)); w++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for i := range indexes {
+				err := action(targets[i].Id)
+				results[i] = BulkResult{Id: targets[i].Id, Name: targets[i].Name, Success: err == nil, err: err}
+				if err != nil {
+					results[i].Error = err.Error()
+				}
+			}
+		}()
+	}
+	for i := range targets {
+		indexes <- i
+	}
+	close(indexes)
+	waitGroup.Wait()
+	return results
+}
+
+// Asks once whether question should be done to every target (unless force is set), runs action on them
+// and prints a summary of what happened to each one. Returns an error when any of them failed,
+// the ones --dry-run blocked don't count as failed.
+func RunBulk(slcmd *metadata.SoftlayerCommand, targets []BulkTarget, force bool, question string, action func(id int) error) error {
+	if len(targets) == 0 {
+		return errors.New(T("No servers were selected."))
+	}
+	outputJSON := slcmd.GetOutputFlag() == metadata.OutputJSON
+	if !force {
+		// Only the results are printed with --output=JSON, so the output stays valid JSON
+		if !outputJSON {
+			table := slcmd.UI.Table([]string{T("ID"), T("Name")})
+			for _, target := range targets {
+				table.Add(fmt.Sprintf("%d", target.Id), bulkValue(target.Name))
+			}
+			table.Print()
+		}
+		confirm, err := slcmd.UI.Confirm(question)
+		if err != nil {
+			return err
+		}
+		if !confirm {
+			slcmd.UI.Print(T("Aborted."))
+			return nil
+		}
+	}
+
+	results := RunBulkAction(targets, action)
+	transport, hasTransport := client.GetCLITransport(slcmd.Session)
+	failed := 0
+	for i, result := range results {
+		if hasTransport && transport.DryRun && transport.IsDryRunError(result.err) {
+			results[i].DryRun = true
+			results[i].Error = ""
+			continue
+		}
+		if !result.Success {
+			failed++
+		}
+	}
+	if outputJSON {
+		err := utils.PrintPrettyJSON(slcmd.UI, results)
+		if err != nil {
+			return err
+		}
+	} else {
+		table := slcmd.UI.Table([]string{T("ID"), T("Name"), T("Status"), T("Error")})
+		for _, result := range results {
+			status := T("Done")
+			if result.DryRun {
+				status = T("Dry run")
+			} else if !result.Success {
+				status = T("Failed")
+			}
+			table.Add(fmt.Sprintf("%d", result.Id), bulkValue(result.Name), status, bulkValue(result.Error))
+		}
+		table.Print()
+	}
+	if failed > 0 {
+		return errors.New(T("{{.FAILED}} of {{.TOTAL}} servers failed.", map[string]interface{}{"FAILED": failed, "TOTAL": len(results)}))
+	}
+	return nil
+}
+
+func bulkValue(value string) string {
+	if value == "" {
+		return utils.EMPTY_VALUE
+	}
+	return value
+}
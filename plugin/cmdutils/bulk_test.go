@@ -0,0 +1,191 @@
+package cmdutils_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+	"github.com/spf13/cobra"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/cmdutils"
+	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
+)
+
+var _ = Describe("Bulk commands", func() {
+	Describe("ServerSelectorArgs", func() {
+		var (
+			selector cmdutils.ServerSelector
+			args     cobra.PositionalArgs
+		)
+		BeforeEach(func() {
+			selector = cmdutils.ServerSelector{}
+			args = cmdutils.ServerSelectorArgs(&selector)
+		})
+		It("Needs identifiers or a selector", func() {
+			err := args(nil, []string{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Incorrect Usage: This command requires one argument or more"))
+			Expect(args(nil, []string{"1", "2"})).To(Succeed())
+			selector.FromFile = "servers.txt"
+			Expect(args(nil, []string{})).To(Succeed())
+			selector = cmdutils.ServerSelector{Tags: []string{"web"}}
+			Expect(args(nil, []string{})).To(Succeed())
+		})
+		It("Doesn't mix identifiers and filters", func() {
+			selector.Datacenter = "dal13"
+			err := args(nil, []string{"1"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Identifiers and --from-file can't be used with --tag, --datacenter or --hostname-pattern."))
+		})
+		It("Knows when a command is bulk", func() {
+			Expect(selector.IsBulk([]string{"1"})).To(BeFalse())
+			Expect(selector.IsBulk([]string{"1", "2"})).To(BeTrue())
+			selector.HostnamePattern = "web*"
+			Expect(selector.IsBulk([]string{})).To(BeTrue())
+		})
+	})
+
+	Describe("ServerSelector.Identifiers", func() {
+		It("Reads identifiers from a file", func() {
+			fileName := filepath.Join(GinkgoT().TempDir(), "servers.txt")
+			Expect(os.WriteFile(fileName, []byte("# servers to reboot\n11 web1.example.com\n\n  12\n"), 0600)).To(Succeed())
+			selector := cmdutils.ServerSelector{FromFile: fileName}
+			identifiers, err := selector.Identifiers([]string{"10"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(identifiers).To(Equal([]string{"10", "11", "12"}))
+		})
+		It("Returns an error for a missing file", func() {
+			selector := cmdutils.ServerSelector{FromFile: filepath.Join(GinkgoT().TempDir(), "missing.txt")}
+			_, err := selector.Identifiers([]string{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Failed to read"))
+		})
+	})
+
+	Describe("RunBulkAction", func() {
+		It("Keeps the order of the targets and no more than BulkWorkers run at once", func() {
+			targets := []cmdutils.BulkTarget{}
+			for id := 1; id <= 20; id++ {
+				targets = append(targets, cmdutils.BulkTarget{Id: id})
+			}
+			var mutex sync.Mutex
+			running, mostRunning := 0, 0
+			results := cmdutils.RunBulkAction(targets, func(id int) error {
+				mutex.Lock()
+				running++
+				mostRunning = max(mostRunning, running)
+				mutex.Unlock()
+				defer func() {
+					mutex.Lock()
+					running--
+					mutex.Unlock()
+				}()
+				if id%5 == 0 {
+					return errors.New("failed")
+				}
+				return nil
+			})
+			Expect(results).To(HaveLen(20))
+			for i, result := range results {
+				Expect(result.Id).To(Equal(i + 1))
+				Expect(result.Success).To(Equal((i+1)%5 != 0))
+			}
+			Expect(results[4].Error).To(Equal("failed"))
+			Expect(mostRunning).To(BeNumerically("<=", cmdutils.BulkWorkers))
+		})
+	})
+
+	Describe("RunBulk", func() {
+		var (
+			fakeUI    *terminal.FakeUI
+			slCommand *metadata.SoftlayerCommand
+			targets   []cmdutils.BulkTarget
+			done      []int
+			mutex     sync.Mutex
+			action    func(id int) error
+		)
+		BeforeEach(func() {
+			fakeUI = terminal.NewFakeUI()
+			slCommand = metadata.NewSoftlayerCommand(fakeUI, testhelpers.NewFakeSoftlayerSession(nil))
+			targets = []cmdutils.BulkTarget{{Id: 1, Name: "web1.example.com"}, {Id: 2, Name: "web2.example.com"}}
+			done = []int{}
+			action = func(id int) error {
+				mutex.Lock()
+				defer mutex.Unlock()
+				done = append(done, id)
+				if id == 2 {
+					return errors.New("Internal Server Error")
+				}
+				return nil
+			}
+		})
+		It("Asks once and stops when the answer is no", func() {
+			fakeUI.Inputs("No")
+			err := cmdutils.RunBulk(slCommand, targets, false, "Reboot 2 servers?", action)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(ContainSubstring("web2.example.com"))
+			Expect(fakeUI.Outputs()).To(ContainSubstring("Reboot 2 servers?"))
+			Expect(fakeUI.Outputs()).To(ContainSubstring("Aborted."))
+			Expect(done).To(BeEmpty())
+		})
+		It("Prints a summary and fails when a server failed", func() {
+			fakeUI.Inputs("Yes")
+			err := cmdutils.RunBulk(slCommand, targets, false, "Reboot 2 servers?", action)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("1 of 2 servers failed."))
+			Expect(done).To(ConsistOf(1, 2))
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`1\s+web1.example.com\s+Done`))
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`2\s+web2.example.com\s+Failed\s+Internal Server Error`))
+		})
+		It("Doesn't ask with force and prints JSON", func() {
+			Expect(slCommand.OutputFlag.Set("json")).To(Succeed())
+			err := cmdutils.RunBulk(slCommand, targets[:1], true, "Reboot 1 server?", action)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).NotTo(ContainSubstring("Reboot 1 server?"))
+			Expect(fakeUI.Outputs()).To(ContainSubstring(`"success": true`))
+		})
+		It("Shows the servers --dry-run blocked and doesn't count them as failed", func() {
+			transport := &client.CLIRestTransport{RestTransport: &session.RestTransport{}, DryRun: true, DryRunOutput: io.Discard}
+			sess := &session.Session{TransportHandler: transport}
+			slCommand = metadata.NewSoftlayerCommand(fakeUI, sess)
+			err := cmdutils.RunBulk(slCommand, targets, true, "Reboot 2 servers?", func(id int) error {
+				err := transport.DoRequest(sess, "SoftLayer_Virtual_Guest", "rebootDefault", nil, &sl.Options{Id: &id}, nil)
+				return slErrors.NewAPIError("Failed to reboot.\n", err.Error(), 2)
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`1\s+web1.example.com\s+Dry run`))
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`2\s+web2.example.com\s+Dry run`))
+			Expect(fakeUI.Outputs()).NotTo(ContainSubstring("Failed"))
+		})
+		It("Prints only the results as JSON when it asks", func() {
+			Expect(slCommand.OutputFlag.Set("json")).To(Succeed())
+			fakeUI.Inputs("Yes")
+			err := cmdutils.RunBulk(slCommand, targets[:1], false, "Reboot 1 server?", action)
+			Expect(err).NotTo(HaveOccurred())
+			output := fakeUI.Outputs()
+			Expect(output).To(ContainSubstring("Reboot 1 server?"))
+			var results []cmdutils.BulkResult
+			Expect(json.Unmarshal([]byte(output[strings.Index(output, "["):]), &results)).To(Succeed())
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].Success).To(BeTrue())
+			Expect(output[:strings.Index(output, "[")]).NotTo(ContainSubstring("web1.example.com"))
+		})
+		It("Fails without targets", func() {
+			err := cmdutils.RunBulk(slCommand, []cmdutils.BulkTarget{}, true, "", action)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("No servers were selected."))
+		})
+	})
+})
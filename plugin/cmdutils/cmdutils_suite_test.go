@@ -0,0 +1,13 @@
+package cmdutils_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCmdutils(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Command Utils Suite")
+}
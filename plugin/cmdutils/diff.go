@@ -0,0 +1,151 @@
+package cmdutils
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
+)
+
+// One setting of a server for the diff commands. Key and Value don't change with the language, they are what JSON output has.
+type ConfigValue struct {
+	Key   string
+	Label string
+	Value string
+	// What the table shows instead of Value, like the translation of a keyword. Value when empty.
+	Text string
+}
+
+// One line of a diff between two servers
+type DiffField struct {
+	Field      string `json:"field"`
+	Label      string `json:"-"`
+	First      string `json:"first"`
+	Second     string `json:"second"`
+	FirstText  string `json:"-"`
+	SecondText string `json:"-"`
+	Same       bool   `json:"same"`
+}
+
+// Compares the settings of two servers, which have to come from the same function so the fields line up
+func DiffConfigs(first []ConfigValue, second []ConfigValue) ([]DiffField, error) {
+	if len(first) != len(second) {
+		return nil, errors.New(T("The servers have different settings and can't be compared."))
+	}
+	fields := []DiffField{}
+	for i := range first {
+		if first[i].Key != second[i].Key {
+			return nil, errors.New(T("The servers have different settings and can't be compared."))
+		}
+		fields = append(fields, DiffField{
+			Field:      first[i].Key,
+			Label:      first[i].Label,
+			First:      first[i].Value,
+			Second:     second[i].Value,
+			FirstText:  configText(first[i]),
+			SecondText: configText(second[i]),
+			Same:       first[i].Value == second[i].Value,
+		})
+	}
+	return fields, nil
+}
+
+// Prints the settings that differ between the servers named firstName and secondName, or all of them when all is set
+func PrintDiff(slcmd *metadata.SoftlayerCommand, firstName string, secondName string, fields []DiffField, all bool) error {
+	shown := []DiffField{}
+	for _, field := range fields {
+		if all || !field.Same {
+			shown = append(shown, field)
+		}
+	}
+	if slcmd.GetOutputFlag() == metadata.OutputJSON {
+		return utils.PrintPrettyJSON(slcmd.UI, shown)
+	}
+	if len(shown) == 0 {
+		slcmd.UI.Print(T("{{.FIRST}} and {{.SECOND}} have the same configuration.", map[string]interface{}{"FIRST": firstName, "SECOND": secondName}))
+		return nil
+	}
+	table := slcmd.UI.Table([]string{T("Field"), firstName, secondName})
+	for _, field := range shown {
+		table.Add(field.Label, diffValue(field.FirstText), diffValue(field.SecondText))
+	}
+	table.Print()
+	return nil
+}
+
+func configText(value ConfigValue) string {
+	if value.Text != "" {
+		return value.Text
+	}
+	return value.Value
+}
+
+func diffValue(value string) string {
+	if value == "" {
+		return utils.EMPTY_VALUE
+	}
+	return value
+}
+
+// A list setting, like tags or security groups, as one value that doesn't depend on the order the API returns them in
+func DiffList(values []string) string {
+	sorted := append([]string{}, values...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ", ")
+}
+
+// A number setting, empty when the API didn't return it
+func DiffInt(value *int) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.Itoa(*value)
+}
+
+func DiffUint(value *uint) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatUint(uint64(*value), 10)
+}
+
+func DiffBool(value *bool) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatBool(*value)
+}
+
+// The billing settings of a server: hourly or monthly, and the fee of billingItem.
+// Only the fee the server is billed by is set, so an hourly and a monthly server don't compare an hourly fee to a monthly one.
+func DiffBilling(hourly *bool, billingItem *datatypes.Billing_Item) []ConfigValue {
+	billing, billingText := "", ""
+	if hourly != nil {
+		billing, billingText = "monthly", T("Monthly")
+		if *hourly {
+			billing, billingText = "hourly", T("Hourly")
+		}
+	}
+	hourlyFee, monthlyFee := "", ""
+	if billingItem != nil {
+		if utils.BoolPointertoBool(hourly) {
+			if billingItem.HourlyRecurringFee != nil {
+				hourlyFee = fmt.Sprintf("%.3f", *billingItem.HourlyRecurringFee)
+			}
+		} else if billingItem.RecurringFee != nil {
+			monthlyFee = fmt.Sprintf("%.2f", *billingItem.RecurringFee)
+		}
+	}
+	return []ConfigValue{
+		{Key: "billing", Label: T("Billing"), Value: billing, Text: billingText},
+		{Key: "hourlyFee", Label: T("Hourly fee"), Value: hourlyFee},
+		{Key: "monthlyFee", Label: T("Monthly fee"), Value: monthlyFee},
+	}
+}
@@ -0,0 +1,113 @@
+package cmdutils_test
+
+import (
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/sl"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/cmdutils"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
+)
+
+var _ = Describe("Diff", func() {
+	first := []cmdutils.ConfigValue{
+		{Key: "os", Label: "OS", Value: "Ubuntu"},
+		{Key: "tags", Label: "Tags", Value: cmdutils.DiffList([]string{"web", "prod"})},
+		{Key: "notes", Label: "Notes", Value: ""},
+		{Key: "billing", Label: "Billing", Value: "hourly", Text: "Stündlich"},
+	}
+	second := []cmdutils.ConfigValue{
+		{Key: "os", Label: "OS", Value: "CentOS"},
+		{Key: "tags", Label: "Tags", Value: cmdutils.DiffList([]string{"prod", "web"})},
+		{Key: "notes", Label: "Notes", Value: "rebuilt"},
+		{Key: "billing", Label: "Billing", Value: "monthly", Text: "Monatlich"},
+	}
+
+	Describe("DiffConfigs", func() {
+		It("Compares the values field by field", func() {
+			fields, err := cmdutils.DiffConfigs(first, second)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fields).To(HaveLen(4))
+			Expect(fields[0]).To(Equal(cmdutils.DiffField{Field: "os", Label: "OS", First: "Ubuntu", Second: "CentOS", FirstText: "Ubuntu", SecondText: "CentOS", Same: false}))
+			Expect(fields[1].Same).To(BeTrue())
+			Expect(fields[1].First).To(Equal("prod, web"))
+			Expect(fields[2].Same).To(BeFalse())
+			Expect(fields[3].First).To(Equal("hourly"))
+			Expect(fields[3].FirstText).To(Equal("Stündlich"))
+		})
+		It("Refuses settings that don't line up", func() {
+			_, err := cmdutils.DiffConfigs(first, second[:2])
+			Expect(err).To(HaveOccurred())
+			_, err = cmdutils.DiffConfigs(first[1:], second[:2])
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("PrintDiff", func() {
+		var (
+			fakeUI    *terminal.FakeUI
+			slCommand *metadata.SoftlayerCommand
+			fields    []cmdutils.DiffField
+		)
+		BeforeEach(func() {
+			fakeUI = terminal.NewFakeUI()
+			slCommand = metadata.NewSoftlayerCommand(fakeUI, testhelpers.NewFakeSoftlayerSession(nil))
+			fields, _ = cmdutils.DiffConfigs(first, second)
+		})
+		It("Prints the fields that differ", func() {
+			Expect(cmdutils.PrintDiff(slCommand, "web1", "web2", fields, false)).To(Succeed())
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`Field\s+web1\s+web2`))
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`OS\s+Ubuntu\s+CentOS`))
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`Notes\s+-\s+rebuilt`))
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`Billing\s+Stündlich\s+Monatlich`))
+			Expect(fakeUI.Outputs()).NotTo(ContainSubstring("Tags"))
+		})
+		It("Prints every field with all", func() {
+			Expect(cmdutils.PrintDiff(slCommand, "web1", "web2", fields, true)).To(Succeed())
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`Tags\s+prod, web\s+prod, web`))
+		})
+		It("Says when there are no differences", func() {
+			same, _ := cmdutils.DiffConfigs(first, first)
+			Expect(cmdutils.PrintDiff(slCommand, "web1", "web2", same, false)).To(Succeed())
+			Expect(fakeUI.Outputs()).To(ContainSubstring("web1 and web2 have the same configuration."))
+		})
+		It("Prints JSON", func() {
+			Expect(slCommand.OutputFlag.Set("JSON")).To(Succeed())
+			Expect(cmdutils.PrintDiff(slCommand, "web1", "web2", fields, false)).To(Succeed())
+			Expect(fakeUI.Outputs()).To(ContainSubstring(`"field": "os",`))
+			Expect(fakeUI.Outputs()).To(ContainSubstring(`"same": false`))
+			Expect(fakeUI.Outputs()).NotTo(ContainSubstring(`"label"`))
+			Expect(fakeUI.Outputs()).To(ContainSubstring(`"first": "hourly",`))
+			Expect(fakeUI.Outputs()).NotTo(ContainSubstring("Stündlich"))
+			Expect(fakeUI.Outputs()).NotTo(ContainSubstring(`"tags"`))
+		})
+	})
+
+	Describe("DiffBilling", func() {
+		billingItem := &datatypes.Billing_Item{HourlyRecurringFee: sl.Float(0.1234), RecurringFee: sl.Float(56.789)}
+		It("Only has the hourly fee of an hourly server", func() {
+			config := cmdutils.DiffBilling(sl.Bool(true), billingItem)
+			Expect(config).To(HaveLen(3))
+			Expect(config[0]).To(Equal(cmdutils.ConfigValue{Key: "billing", Label: "Billing", Value: "hourly", Text: "Hourly"}))
+			Expect(config[1].Value).To(Equal("0.123"))
+			Expect(config[2].Value).To(Equal(""))
+		})
+		It("Only has the monthly fee of a monthly server", func() {
+			config := cmdutils.DiffBilling(sl.Bool(false), billingItem)
+			Expect(config[0].Value).To(Equal("monthly"))
+			Expect(config[1].Value).To(Equal(""))
+			Expect(config[2].Value).To(Equal("56.79"))
+		})
+		It("Leaves out what the API didn't return", func() {
+			config := cmdutils.DiffBilling(nil, nil)
+			Expect(config[0].Value).To(Equal(""))
+			Expect(config[1].Value).To(Equal(""))
+			Expect(config[2].Value).To(Equal(""))
+			Expect(cmdutils.DiffInt(nil)).To(Equal(""))
+			Expect(cmdutils.DiffBool(sl.Bool(false))).To(Equal("false"))
+		})
+	})
+})
@@ -0,0 +1,192 @@
+package cmdutils
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+)
+
+// The helpers for --interactive. Each answer is written back to the flag it stands for with SetFlag,
+// so the command runs the same way it would with the flags, and EquivalentCommandLine can show them.
+
+// Asks for a line of text. current is the default, a required answer can't be left empty.
+func AskText(slcmd *metadata.SoftlayerCommand, question string, current string, required bool) (string, error) {
+	answer := current
+	err := slcmd.UI.Prompt(question, &terminal.PromptOptions{Required: required && current == ""}).Resolve(&answer)
+	return strings.TrimSpace(answer), err
+}
+
+// Asks for a number, 0 when the question is skipped
+func AskInt(slcmd *metadata.SoftlayerCommand, question string, current int) (int, error) {
+	answer := current
+	err := slcmd.UI.Prompt(question, &terminal.PromptOptions{}).Resolve(&answer)
+	return answer, err
+}
+
+// Asks for a list of numbers separated by commas, like the IDs of SSH keys
+func AskIntList(slcmd *metadata.SoftlayerCommand, question string, current []int) ([]int, error) {
+	currentText := []string{}
+	for _, number := range current {
+		currentText = append(currentText, strconv.Itoa(number))
+	}
+	answer, err := AskText(slcmd, question, strings.Join(currentText, ","), false)
+	if err != nil {
+		return nil, err
+	}
+	numbers := []int{}
+	for _, field := range strings.FieldsFunc(answer, func(r rune) bool { return r == ',' || r == ' ' }) {
+		number, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, errors.NewInvalidUsageError(T("{{.VALUE}} is not a number.", map[string]interface{}{"VALUE": field}))
+		}
+		numbers = append(numbers, number)
+	}
+	return numbers, nil
+}
+
+// Asks a yes or no question
+func AskYesNo(slcmd *metadata.SoftlayerCommand, question string, current bool) (bool, error) {
+	answer := current
+	err := slcmd.UI.Prompt(question, &terminal.PromptOptions{}).Resolve(&answer)
+	return answer, err
+}
+
+// Asks to pick one of choices, which maps what the API wants (like dal13) to what people read (like Dallas 13).
+// Returns the key of the choice, current is the default. Without any choices the key is asked for as text.
+func AskChoice(slcmd *metadata.SoftlayerCommand, question string, choices map[string]string, current string) (string, error) {
+	if len(choices) == 0 {
+		return AskText(slcmd, question, current, true)
+	}
+	keys := []string{}
+	for key := range choices {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	labels := make([]string, len(keys))
+	answer := ""
+	for i, key := range keys {
+		labels[i] = key
+		if choices[key] != "" && choices[key] != key {
+			labels[i] = fmt.Sprintf("%s (%s)", key, choices[key])
+		}
+		if key == current {
+			answer = labels[i]
+		}
+	}
+	err := slcmd.UI.ChoicesPrompt(question, labels, &terminal.PromptOptions{Required: answer == ""}).Resolve(&answer)
+	if err != nil {
+		return "", err
+	}
+	for i, label := range labels {
+		if label == answer {
+			return keys[i], nil
+		}
+	}
+	return current, nil
+}
+
+// Sets the flag name of cmd to value, as if it was given on the command line. Slice flags get every value of a []int or []string.
+// Setting a flag to its default value puts it back to not given.
+func SetFlag(cmd *cobra.Command, name string, value interface{}) error {
+	flag := cmd.Flags().Lookup(name)
+	if flag == nil {
+		return errors.New(T("Unknown flag --{{.FLAG}}.", map[string]interface{}{"FLAG": name}))
+	}
+	var err error
+	switch typed := value.(type) {
+	case []int:
+		values := []string{}
+		for _, number := range typed {
+			values = append(values, strconv.Itoa(number))
+		}
+		err = setSliceFlag(flag, values)
+	case []string:
+		err = setSliceFlag(flag, typed)
+	default:
+		err = flag.Value.Set(fmt.Sprint(typed))
+	}
+	if err != nil {
+		return err
+	}
+	flag.Changed = flag.Value.String() != flag.DefValue
+	return nil
+}
+
+// SetFlag for every flag in values, in the order of their names. The error tells which flag the value didn't fit.
+func SetFlags(cmd *cobra.Command, values map[string]interface{}) error {
+	names := []string{}
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		err := SetFlag(cmd, name, values[name])
+		if err != nil {
+			return errors.NewInvalidUsageError(T("Invalid input for '{{.Name}}'. {{.ERROR}}", map[string]interface{}{"Name": "--" + name, "ERROR": err.Error()}))
+		}
+	}
+	return nil
+}
+
+func setSliceFlag(flag *pflag.Flag, values []string) error {
+	sliceValue, ok := flag.Value.(pflag.SliceValue)
+	if !ok {
+		return errors.New(T("--{{.FLAG}} only takes one value.", map[string]interface{}{"FLAG": flag.Name}))
+	}
+	return sliceValue.Replace(values)
+}
+
+// The command line that runs cmd with the flags it has now, leaving out the skip flags.
+// Values are quoted for a POSIX shell when they need to be.
+func EquivalentCommandLine(cmd *cobra.Command, skip ...string) string {
+	line := []string{"ibmcloud", "sl"}
+	path := strings.Fields(cmd.CommandPath())
+	if len(path) > 0 && path[0] == "sl" {
+		path = path[1:]
+	}
+	line = append(line, path...)
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		if !flag.Changed || flag.Hidden {
+			return
+		}
+		for _, name := range skip {
+			if flag.Name == name {
+				return
+			}
+		}
+		if sliceValue, ok := flag.Value.(pflag.SliceValue); ok {
+			for _, value := range sliceValue.GetSlice() {
+				line = append(line, "--"+flag.Name, shellQuote(value))
+			}
+			return
+		}
+		if flag.Value.Type() == "bool" {
+			if flag.Value.String() == "true" {
+				line = append(line, "--"+flag.Name)
+			} else {
+				line = append(line, "--"+flag.Name+"=false")
+			}
+			return
+		}
+		line = append(line, "--"+flag.Name, shellQuote(flag.Value.String()))
+	})
+	return strings.Join(line, " ")
+}
+
+func shellQuote(value string) string {
+	if value != "" && strings.IndexFunc(value, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("-_./:=@,+", r))
+	}) == -1 {
+		return value
+	}
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -0,0 +1,108 @@
+package cmdutils_test
+
+import (
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/cmdutils"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
+)
+
+var _ = Describe("Interactive", func() {
+	var (
+		fakeUI    *terminal.FakeUI
+		slCommand *metadata.SoftlayerCommand
+		cmd       *cobra.Command
+	)
+	BeforeEach(func() {
+		fakeUI = terminal.NewFakeUI()
+		slCommand = metadata.NewSoftlayerCommand(fakeUI, testhelpers.NewFakeSoftlayerSession(nil))
+		root := &cobra.Command{Use: "sl"}
+		vs := &cobra.Command{Use: "vs"}
+		cmd = &cobra.Command{Use: "create"}
+		root.AddCommand(vs)
+		vs.AddCommand(cmd)
+		cmd.Flags().String("hostname", "", "")
+		cmd.Flags().String("billing", "hourly", "")
+		cmd.Flags().Int("cpu", 0, "")
+		cmd.Flags().Bool("private", false, "")
+		cmd.Flags().IntSlice("key", []int{}, "")
+		cmd.Flags().StringSlice("tag", []string{}, "")
+		cmd.Flags().String("userdata", "", "")
+	})
+
+	Describe("AskChoice", func() {
+		choices := map[string]string{"dal13": "Dallas 13", "ams01": "Amsterdam 1", "fra02": ""}
+		It("Returns the key of the choice", func() {
+			fakeUI.Inputs("2")
+			answer, err := cmdutils.AskChoice(slCommand, "Datacenter", choices, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(answer).To(Equal("dal13"))
+			Expect(fakeUI.Outputs()).To(ContainSubstring("1. ams01 (Amsterdam 1)"))
+			Expect(fakeUI.Outputs()).To(ContainSubstring("3. fra02\n"))
+		})
+		It("Keeps the current choice", func() {
+			fakeUI.Inputs("")
+			answer, err := cmdutils.AskChoice(slCommand, "Datacenter", choices, "fra02")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(answer).To(Equal("fra02"))
+		})
+		It("Asks for text without choices", func() {
+			fakeUI.Inputs("dal10")
+			answer, err := cmdutils.AskChoice(slCommand, "Datacenter", map[string]string{}, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(answer).To(Equal("dal10"))
+		})
+	})
+
+	Describe("AskIntList", func() {
+		It("Splits numbers on commas and spaces", func() {
+			fakeUI.Inputs("1, 2 3")
+			answer, err := cmdutils.AskIntList(slCommand, "Keys", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(answer).To(Equal([]int{1, 2, 3}))
+		})
+		It("Errors on anything else", func() {
+			fakeUI.Inputs("1,two")
+			_, err := cmdutils.AskIntList(slCommand, "Keys", nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("two is not a number."))
+		})
+	})
+
+	Describe("SetFlag and EquivalentCommandLine", func() {
+		It("Shows the flags that were set", func() {
+			Expect(cmdutils.SetFlag(cmd, "hostname", "web1")).To(Succeed())
+			Expect(cmdutils.SetFlag(cmd, "cpu", 4)).To(Succeed())
+			Expect(cmdutils.SetFlag(cmd, "private", true)).To(Succeed())
+			Expect(cmdutils.SetFlag(cmd, "key", []int{1, 2})).To(Succeed())
+			Expect(cmdutils.SetFlag(cmd, "tag", []string{"web server"})).To(Succeed())
+			Expect(cmdutils.SetFlag(cmd, "userdata", "it's")).To(Succeed())
+			Expect(cmdutils.EquivalentCommandLine(cmd)).To(Equal(
+				`ibmcloud sl vs create --cpu 4 --hostname web1 --key 1 --key 2 --private --tag 'web server' --userdata 'it'\''s'`))
+		})
+		It("Leaves out defaults and skipped flags", func() {
+			Expect(cmdutils.SetFlag(cmd, "hostname", "web1")).To(Succeed())
+			Expect(cmdutils.SetFlag(cmd, "billing", "hourly")).To(Succeed())
+			Expect(cmdutils.SetFlag(cmd, "cpu", 4)).To(Succeed())
+			Expect(cmdutils.SetFlag(cmd, "cpu", 0)).To(Succeed())
+			Expect(cmdutils.SetFlag(cmd, "key", []int{})).To(Succeed())
+			Expect(cmdutils.EquivalentCommandLine(cmd, "hostname")).To(Equal("ibmcloud sl vs create"))
+		})
+		It("Errors on unknown flags and bad values", func() {
+			Expect(cmdutils.SetFlag(cmd, "memory", 4)).NotTo(Succeed())
+			Expect(cmdutils.SetFlag(cmd, "cpu", "four")).NotTo(Succeed())
+			Expect(cmdutils.SetFlag(cmd, "hostname", []string{"a", "b"})).NotTo(Succeed())
+		})
+		It("Sets several flags and tells which one failed", func() {
+			Expect(cmdutils.SetFlags(cmd, map[string]interface{}{"hostname": "web1", "cpu": 4})).To(Succeed())
+			Expect(cmdutils.EquivalentCommandLine(cmd)).To(Equal("ibmcloud sl vs create --cpu 4 --hostname web1"))
+			err := cmdutils.SetFlags(cmd, map[string]interface{}{"hostname": "web2", "cpu": "four"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Invalid input for '--cpu'."))
+		})
+	})
+})
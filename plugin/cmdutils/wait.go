@@ -0,0 +1,79 @@
+// Package cmdutils has what commands share to prompt, wait, work on many servers and compare them.
+package cmdutils
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+)
+
+// Seconds between checks when --wait-interval isn't given
+var DefaultWaitInterval = 10
+
+// Used instead of time.Now and time.Sleep while waiting, tests replace them
+var (
+	WaitNow   = time.Now
+	WaitSleep = time.Sleep
+)
+
+// How long a command with --wait waits for its operation to finish, from the --wait and --wait-interval flags
+type WaitOptions struct {
+	// Most seconds to wait, 0 doesn't wait
+	Timeout int
+	// Seconds between checks
+	Interval int
+}
+
+// Adds --wait and --wait-interval to a command, the values end up in options.
+// until finishes the sentence "Wait up to X seconds until ...", like "the reload is finished".
+func AddWaitFlags(cmd *cobra.Command, options *WaitOptions, until string) {
+	cmd.Flags().IntVar(&options.Timeout, "wait", 0,
+		T("Wait up to this many seconds until {{.UNTIL}}. 0 doesn't wait", map[string]interface{}{"UNTIL": until}))
+	cmd.Flags().IntVar(&options.Interval, "wait-interval", DefaultWaitInterval,
+		T("Seconds between checks while waiting"))
+}
+
+// True when --wait was given
+func (o WaitOptions) Enabled() bool {
+	return o.Timeout > 0
+}
+
+// Runs check every options.Interval seconds until it is done, fails, or options.Timeout seconds have gone by.
+// Every new status is printed on stderr, so it doesn't get mixed into --output=JSON. what names the operation in those lines.
+func WaitFor(slcmd *metadata.SoftlayerCommand, options WaitOptions, what string, check managers.WaitCheck) error {
+	interval := time.Duration(options.Interval) * time.Second
+	if interval <= 0 {
+		interval = time.Duration(DefaultWaitInterval) * time.Second
+	}
+	start := WaitNow()
+	until := start.Add(time.Duration(options.Timeout) * time.Second)
+	lastStatus := ""
+	for {
+		done, status, err := check()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		if status == "" {
+			status = T("in progress")
+		}
+		now := WaitNow()
+		if status != lastStatus {
+			subs := map[string]interface{}{"WHAT": what, "STATUS": status, "ELAPSED": now.Sub(start).Round(time.Second).String()}
+			// Info takes a format, a % in the status (it comes from the API) has to stay as it is
+			slcmd.UI.Info("%s", T("Waiting for {{.WHAT}}: {{.STATUS}} ({{.ELAPSED}})", subs))
+			lastStatus = status
+		}
+		if !now.Before(until) {
+			return errors.NewWaitTimeoutError(options.Timeout, status)
+		}
+		WaitSleep(min(interval, until.Sub(now)))
+	}
+}
@@ -0,0 +1,98 @@
+package cmdutils_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/cmdutils"
+	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
+)
+
+var _ = Describe("WaitFor", func() {
+	var (
+		fakeUI    *terminal.FakeUI
+		slCommand *metadata.SoftlayerCommand
+		now       time.Time
+		sleeps    []time.Duration
+		statuses  []string
+		checks    int
+		check     managers.WaitCheck
+	)
+	BeforeEach(func() {
+		fakeUI = terminal.NewFakeUI()
+		slCommand = metadata.NewSoftlayerCommand(fakeUI, testhelpers.NewFakeSoftlayerSession(nil))
+		now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		sleeps = []time.Duration{}
+		cmdutils.WaitNow = func() time.Time { return now }
+		cmdutils.WaitSleep = func(wait time.Duration) {
+			sleeps = append(sleeps, wait)
+			now = now.Add(wait)
+		}
+		statuses = []string{"Provisioning", "Provisioning", "Cloud Configure", ""}
+		checks = 0
+		check = func() (bool, string, error) {
+			status := statuses[checks]
+			checks++
+			return status == "", status, nil
+		}
+	})
+	AfterEach(func() {
+		cmdutils.WaitNow = time.Now
+		cmdutils.WaitSleep = time.Sleep
+	})
+	It("Checks every interval until the operation is done", func() {
+		err := cmdutils.WaitFor(slCommand, cmdutils.WaitOptions{Timeout: 600, Interval: 5}, "virtual server instance 1234", check)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(checks).To(Equal(4))
+		Expect(sleeps).To(Equal([]time.Duration{5 * time.Second, 5 * time.Second, 5 * time.Second}))
+	})
+	It("Prints each new status on stderr", func() {
+		err := cmdutils.WaitFor(slCommand, cmdutils.WaitOptions{Timeout: 600, Interval: 5}, "virtual server instance 1234", check)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeUI.Outputs()).To(Equal(""))
+		Expect(fakeUI.Errors()).To(ContainSubstring("Waiting for virtual server instance 1234: Provisioning (0s)"))
+		Expect(fakeUI.Errors()).To(ContainSubstring("Waiting for virtual server instance 1234: Cloud Configure (10s)"))
+		Expect(fakeUI.Errors()).NotTo(ContainSubstring("(5s)"))
+	})
+	It("Prints a status with a % as it is", func() {
+		statuses = []string{"Image copy 50% done", ""}
+		err := cmdutils.WaitFor(slCommand, cmdutils.WaitOptions{Timeout: 600, Interval: 5}, "image 1234", check)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeUI.Errors()).To(ContainSubstring("Waiting for image 1234: Image copy 50% done (0s)"))
+	})
+	It("Gives up after the timeout", func() {
+		statuses = []string{"Provisioning", "Provisioning", "Provisioning", "Provisioning"}
+		err := cmdutils.WaitFor(slCommand, cmdutils.WaitOptions{Timeout: 12, Interval: 5}, "order 1", check)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("Stopped waiting after 12 seconds, the operation is still running: Provisioning"))
+		Expect(slErr.ExitCode(err)).To(Equal(slErr.EXIT_TIMEOUT))
+		Expect(sleeps).To(Equal([]time.Duration{5 * time.Second, 5 * time.Second, 2 * time.Second}))
+	})
+	It("Stops at the first error", func() {
+		err := cmdutils.WaitFor(slCommand, cmdutils.WaitOptions{Timeout: 600, Interval: 5}, "order 1", func() (bool, string, error) {
+			return false, "", errors.New("Internal Server Error")
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("Internal Server Error"))
+		Expect(sleeps).To(BeEmpty())
+	})
+	It("Adds --wait and --wait-interval", func() {
+		options := cmdutils.WaitOptions{}
+		cobraCmd := &cobra.Command{Use: "test", RunE: func(*cobra.Command, []string) error { return nil }}
+		cmdutils.AddWaitFlags(cobraCmd, &options, "the test is done")
+		Expect(options.Enabled()).To(BeFalse())
+		Expect(options.Interval).To(Equal(cmdutils.DefaultWaitInterval))
+		err := testhelpers.RunCobraCommand(cobraCmd, "--wait", "300", "--wait-interval", "30")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(options).To(Equal(cmdutils.WaitOptions{Timeout: 300, Interval: 30}))
+		Expect(options.Enabled()).To(BeTrue())
+	})
+})
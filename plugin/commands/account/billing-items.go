@@ -66,13 +66,29 @@ func (cmd *BillingItemsCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to get billing items."), err.Error(), 2)
 	}
-	PrintBillingItems(billingItems, cmd.UI, cmd.Ordered, outputFormat)
-	return nil
+	if outputFormat == "JSON" {
+		filteredItems := []datatypes.Billing_Item{}
+		for _, billingItem := range billingItems {
+			if cmd.Ordered == "" || cmd.Ordered == billingItemOrderedBy(billingItem) {
+				filteredItems = append(filteredItems, billingItem)
+			}
+		}
+		return utils.PrintPrettyJSON(cmd.UI, filteredItems)
+	}
+	return PrintBillingItems(billingItems, cmd.UI, cmd.Ordered, outputFormat)
 }
 
-func PrintBillingItems(billingItems []datatypes.Billing_Item, ui terminal.UI, orderedFilter string, outputFormat string) {
+// Display name of the user who ordered the billing item, IBM if it was not ordered by a user
+func billingItemOrderedBy(billingItem datatypes.Billing_Item) string {
+	if billingItem.OrderItem != nil {
+		return utils.FormatStringPointer(billingItem.OrderItem.Order.UserRecord.DisplayName)
+	}
+	return "IBM"
+}
+
+func PrintBillingItems(billingItems []datatypes.Billing_Item, ui terminal.UI, orderedFilter string, outputFormat string) error {
 	bufEvent := new(bytes.Buffer)
-	table := terminal.NewTable(bufEvent, []string{
+	table := utils.NewTable(bufEvent, []string{
 		T("Id"),
 		T("Create Date"),
 		T("Cost"),
@@ -87,11 +103,7 @@ func PrintBillingItems(billingItems []datatypes.Billing_Item, ui terminal.UI, or
 		if fqdn != "." {
 			Description = &fqdn
 		}
-		OrderedBy := "IBM"
-
-		if billingItems.OrderItem != nil {
-			OrderedBy = utils.FormatStringPointer(billingItems.OrderItem.Order.UserRecord.DisplayName)
-		}
+		OrderedBy := billingItemOrderedBy(billingItems)
 
 		if orderedFilter != "" && orderedFilter != OrderedBy {
 			continue
@@ -107,5 +119,5 @@ func PrintBillingItems(billingItems []datatypes.Billing_Item, ui terminal.UI, or
 			utils.ShortenStringWithLimit(utils.FormatStringPointer(billingItems.Notes), 50),
 		)
 	}
-	utils.PrintTableWithTitle(ui, table, bufEvent, "Billing Items", outputFormat)
+	return utils.PrintTableWithTitle(ui, table, bufEvent, "Billing Items", outputFormat)
 }
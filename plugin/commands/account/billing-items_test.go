@@ -31,7 +31,7 @@ var _ = Describe("Account list BillingItems", func() {
 			It("Set command with an invalid output option", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 		})
 
@@ -51,15 +51,10 @@ var _ = Describe("Account list BillingItems", func() {
 			It("return account events in format json", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output", "json")
 				Expect(err).NotTo(HaveOccurred())
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`Billing Items`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Id": "81336973",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Create Date": "2016-01-20T17:00:19Z",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Cost": "0.00",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Ordered By": "TestName",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`[`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`{`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`}`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`]`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"id": 81336973,`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"createDate": "2016-01-20T11:00:19-06:00",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"nextInvoiceTotalRecurringAmount": 0,`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"displayName": "TestName",`))
 			})
 		})
 	})
@@ -63,16 +63,23 @@ func (cmd *EventDetailCommand) Run(args []string) error {
 		sub := map[string]interface{}{"eventID": eventID}
 		return slErr.NewAPIError(T("Failed to get the event {{.eventID}}. ", sub), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, event)
+	}
 
-	BasicEventTable(event, cmd.UI, outputFormat)
-	ImpactedTable(event, cmd.UI, outputFormat)
-	UpdateTable(event, cmd.UI, outputFormat)
+	if err := BasicEventTable(event, cmd.UI, outputFormat); err != nil {
+		return err
+	}
+	if err := ImpactedTable(event, cmd.UI, outputFormat); err != nil {
+		return err
+	}
+	UpdateTable(event, cmd.UI)
 	return nil
 }
 
-func BasicEventTable(event datatypes.Notification_Occurrence_Event, ui terminal.UI, outputFormat string) {
+func BasicEventTable(event datatypes.Notification_Occurrence_Event, ui terminal.UI, outputFormat string) error {
 	bufEvent := new(bytes.Buffer)
-	table := terminal.NewTable(bufEvent, []string{
+	table := utils.NewTable(bufEvent, []string{
 		T("Id"),
 		T("Status"),
 		T("Type"),
@@ -86,11 +93,10 @@ func BasicEventTable(event datatypes.Notification_Occurrence_Event, ui terminal.
 		utils.FormatSLTimePointer(event.StartDate),
 		utils.FormatSLTimePointer(event.EndDate),
 	)
-	utils.PrintTableWithTitle(ui, table, bufEvent, utils.FormatStringPointer(event.Subject), outputFormat)
-
+	return utils.PrintTableWithTitle(ui, table, bufEvent, utils.FormatStringPointer(event.Subject), outputFormat)
 }
 
-func ImpactedTable(event datatypes.Notification_Occurrence_Event, ui terminal.UI, outputFormat string) {
+func ImpactedTable(event datatypes.Notification_Occurrence_Event, ui terminal.UI, outputFormat string) error {
 	table := ui.Table([]string{
 		T("Id"),
 		T("Hostname"),
@@ -103,10 +109,10 @@ func ImpactedTable(event datatypes.Notification_Occurrence_Event, ui terminal.UI
 			utils.FormatStringPointer(resources.FilterLabel),
 		)
 	}
-	utils.PrintTable(ui, table, outputFormat)
+	return utils.PrintTable(ui, table, outputFormat)
 }
 
-func UpdateTable(event datatypes.Notification_Occurrence_Event, ui terminal.UI, outputFormat string) {
+func UpdateTable(event datatypes.Notification_Occurrence_Event, ui terminal.UI) {
 	updateStartDate := ""
 	text := ""
 	for _, update := range event.Updates {
@@ -115,15 +121,6 @@ func UpdateTable(event datatypes.Notification_Occurrence_Event, ui terminal.UI,
 	}
 	header := fmt.Sprintf("======= Update #%d on %s =======", len(event.Updates), updateStartDate)
 
-	if outputFormat == "JSON" {
-		table := ui.Table([]string{
-			T("Updates"),
-		})
-		table.Add(header)
-		table.Add(text)
-		table.PrintJson()
-	} else {
-		ui.Print(header)
-		ui.Print(text)
-	}
+	ui.Print(header)
+	ui.Print(text)
 }
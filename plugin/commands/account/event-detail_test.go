@@ -42,7 +42,7 @@ var _ = Describe("Account list EventDetail", func() {
 			It("Set command with an invalid output option", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "123", "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 		})
 
@@ -62,16 +62,11 @@ var _ = Describe("Account list EventDetail", func() {
 			It("return account events in format json", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "123", "--output", "json")
 				Expect(err).NotTo(HaveOccurred())
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`ACTION REQUIRED - Windows`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Id": "340846",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Status": "Published",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Type": "ANNOUNCEMENT",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Updates": "======= Update #1 on 2022-03-23T00:50:57Z ======="`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Updates": "Updated message"`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`[`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`{`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`}`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`]`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"subject": "ACTION REQUIRED - Windows",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"id": 340846,`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"name": "Published"`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"keyName": "ANNOUNCEMENT"`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"contents": "Updated message",`))
 			})
 		})
 	})
@@ -100,28 +100,55 @@ func (cmd *EventsCommand) Run(args []string) error {
 		}
 	}
 
+	if outputFormat == "JSON" {
+		showAll := !cmd.Planned && !cmd.Unplanned && !cmd.Announcement
+		events := map[string]interface{}{}
+		if showAll || cmd.Planned {
+			events["planned"] = plannedEvents
+		}
+		if showAll || cmd.Unplanned {
+			events["unplanned"] = unplannedEvents
+		}
+		if showAll || cmd.Announcement {
+			events["announcement"] = announcement
+		}
+		return utils.PrintPrettyJSON(cmd.UI, events)
+	}
+
 	if cmd.Planned {
-		PrintPlannedEvents(plannedEvents, cmd.UI, outputFormat)
+		if err := PrintPlannedEvents(plannedEvents, cmd.UI, outputFormat); err != nil {
+			return err
+		}
 	}
 	if cmd.Unplanned {
-		PrintUnplannedEvents(unplannedEvents, cmd.UI, outputFormat)
+		if err := PrintUnplannedEvents(unplannedEvents, cmd.UI, outputFormat); err != nil {
+			return err
+		}
 	}
 	if cmd.Announcement {
-		PrintAnnouncementEvents(announcement, cmd.UI, outputFormat)
+		if err := PrintAnnouncementEvents(announcement, cmd.UI, outputFormat); err != nil {
+			return err
+		}
 	}
 
 	if !cmd.Planned && !cmd.Unplanned && !cmd.Announcement {
-		PrintPlannedEvents(plannedEvents, cmd.UI, outputFormat)
-		PrintUnplannedEvents(unplannedEvents, cmd.UI, outputFormat)
-		PrintAnnouncementEvents(announcement, cmd.UI, outputFormat)
+		if err := PrintPlannedEvents(plannedEvents, cmd.UI, outputFormat); err != nil {
+			return err
+		}
+		if err := PrintUnplannedEvents(unplannedEvents, cmd.UI, outputFormat); err != nil {
+			return err
+		}
+		if err := PrintAnnouncementEvents(announcement, cmd.UI, outputFormat); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-func PrintPlannedEvents(events []datatypes.Notification_Occurrence_Event, ui terminal.UI, outputFormat string) {
+func PrintPlannedEvents(events []datatypes.Notification_Occurrence_Event, ui terminal.UI, outputFormat string) error {
 	bufEvent := new(bytes.Buffer)
-	table := terminal.NewTable(bufEvent, []string{
+	table := utils.NewTable(bufEvent, []string{
 		T("Event Data"),
 		T("Id"),
 		T("Event ID"),
@@ -147,12 +174,12 @@ func PrintPlannedEvents(events []datatypes.Notification_Occurrence_Event, ui ter
 			utils.FormatUIntPointer(event.UpdateCount),
 		)
 	}
-	utils.PrintTableWithTitle(ui, table, bufEvent, "Planned", outputFormat)
+	return utils.PrintTableWithTitle(ui, table, bufEvent, "Planned", outputFormat)
 }
 
-func PrintUnplannedEvents(events []datatypes.Notification_Occurrence_Event, ui terminal.UI, outputFormat string) {
+func PrintUnplannedEvents(events []datatypes.Notification_Occurrence_Event, ui terminal.UI, outputFormat string) error {
 	bufEvent := new(bytes.Buffer)
-	table := terminal.NewTable(bufEvent, []string{
+	table := utils.NewTable(bufEvent, []string{
 		T("Id"),
 		T("Event ID"),
 		T("Subject"),
@@ -176,12 +203,12 @@ func PrintUnplannedEvents(events []datatypes.Notification_Occurrence_Event, ui t
 			utils.FormatUIntPointer(event.UpdateCount),
 		)
 	}
-	utils.PrintTableWithTitle(ui, table, bufEvent, "Unplanned", outputFormat)
+	return utils.PrintTableWithTitle(ui, table, bufEvent, "Unplanned", outputFormat)
 }
 
-func PrintAnnouncementEvents(events []datatypes.Notification_Occurrence_Event, ui terminal.UI, outputFormat string) {
+func PrintAnnouncementEvents(events []datatypes.Notification_Occurrence_Event, ui terminal.UI, outputFormat string) error {
 	bufEvent := new(bytes.Buffer)
-	table := terminal.NewTable(bufEvent, []string{
+	table := utils.NewTable(bufEvent, []string{
 		T("Id"),
 		T("Event ID"),
 		T("Subject"),
@@ -201,7 +228,7 @@ func PrintAnnouncementEvents(events []datatypes.Notification_Occurrence_Event, u
 			utils.FormatUIntPointer(event.UpdateCount),
 		)
 	}
-	utils.PrintTableWithTitle(ui, table, bufEvent, "Announcement", outputFormat)
+	return utils.PrintTableWithTitle(ui, table, bufEvent, "Announcement", outputFormat)
 }
 
 func ackAll(events []datatypes.Notification_Occurrence_Event, accountManager managers.AccountManager) error {
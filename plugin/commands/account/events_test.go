@@ -31,7 +31,7 @@ var _ = Describe("Account list Events", func() {
 			It("Set command with an invalid output option", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 			It("Set command with an invalid date option", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--date-min", "abcd")
@@ -78,16 +78,11 @@ var _ = Describe("Account list Events", func() {
 			It("return account events in format json", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output", "json")
 				Expect(err).NotTo(HaveOccurred())
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`Planned`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Event Data": "2022-04-08T00:30:00Z",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`Unplanned`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Id": "341058",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`Announcement`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Id": "341058",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`[`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`{`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`}`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`]`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"planned": [`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"unplanned": [`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"announcement": [`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"id": 341058,`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"startDate": "2022-04-07T18:30:00-06:00",`))
 			})
 		})
 	})
@@ -60,6 +60,9 @@ func (cmd *HookCreateCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to create Provisioning Hook."), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, provisioningHook)
+	}
 
 	table := cmd.UI.Table([]string{T("Name"), T("Value")})
 	table.Add(T("Id"), utils.FormatIntPointer(provisioningHook.Id))
@@ -67,6 +70,5 @@ func (cmd *HookCreateCommand) Run(args []string) error {
 	table.Add(T("Created"), utils.FormatSLTimePointer(provisioningHook.CreateDate))
 	table.Add(T("Uri"), utils.FormatStringPointer(provisioningHook.Uri))
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
@@ -42,7 +42,7 @@ var _ = Describe("account hook-create", func() {
 			It("Set invalid output", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--name=myhook", "--uri=http://myuritest.com", "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 		})
 
@@ -41,6 +41,9 @@ func (cmd *HooksCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to get Provisioning Hooks."), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, provisioningHooks)
+	}
 	table := cmd.UI.Table([]string{T("Id"), T("Name"), T("Uri")})
 	for _, hook := range provisioningHooks {
 		table.Add(
@@ -50,6 +53,5 @@ func (cmd *HooksCommand) Run(args []string) error {
 		)
 	}
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
@@ -41,7 +41,7 @@ var _ = Describe("account hooks", func() {
 			It("Set invalid output", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 		})
 
@@ -58,11 +58,13 @@ func (cmd *InvoiceDetailCommand) Run(args []string) error {
 		subs := map[string]interface{}{"invoiceID": invoiceID}
 		return slErr.NewAPIError(T("Failed to get the invoice {{.invoiceID}}. ", subs), err.Error(), 2)
 	}
-	PrintInvoiceDetail(invoiceID, invoice, cmd.UI, outputFormat, cmd.Details)
-	return nil
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, invoice)
+	}
+	return PrintInvoiceDetail(invoiceID, invoice, cmd.UI, outputFormat, cmd.Details)
 }
 
-func PrintInvoiceDetail(invoiceID int, invoice []datatypes.Billing_Invoice_Item, ui terminal.UI, outputFormat string, details bool) {
+func PrintInvoiceDetail(invoiceID int, invoice []datatypes.Billing_Invoice_Item, ui terminal.UI, outputFormat string, details bool) error {
 	table := ui.Table([]string{
 		T("Item Id"),
 		T("Category"),
@@ -125,7 +127,7 @@ func PrintInvoiceDetail(invoiceID int, invoice []datatypes.Billing_Invoice_Item,
 			}
 		}
 	}
-	utils.PrintTable(ui, table, outputFormat)
+	return utils.PrintTable(ui, table, outputFormat)
 }
 
 func SumChildItems(item datatypes.Billing_Invoice_Item) (oneTime float64, recurring float64) {
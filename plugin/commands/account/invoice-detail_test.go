@@ -48,7 +48,7 @@ var _ = Describe("Account list InvoiceDetail", func() {
 			It("Set command with an invalid output option", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "123", "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 		})
 
@@ -76,12 +76,12 @@ var _ = Describe("Account list InvoiceDetail", func() {
 			It("return account invoice detail in format json", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "123", "--output", "json")
 				Expect(err).NotTo(HaveOccurred())
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Item Id": "123456789",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Category": "Server",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Description": "Dual Intel Xeon Silver 4210 (20 Cores, 2.20 GHz) (test-gpu.softlayer-community-f...",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Single": "22.59",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Monthly": "35.26",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Location": "mex01"`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"id": 123456789,`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"categoryCode": "server",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"description": "Dual Intel Xeon Silver 4210 (20 Cores, 2.20 GHz)",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"oneTimeAfterTaxAmount": 10.234,`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"recurringAfterTaxAmount": 20.345`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"name": "mex01"`))
 			})
 		})
 		Context("issues856", func() {
@@ -15,9 +15,8 @@ type InvoicesCommand struct {
 	*metadata.SoftlayerCommand
 	AccountManager managers.AccountManager
 	Command        *cobra.Command
-	Limit          int
 	Closed         bool
-	All            bool
+	Paging         metadata.Paging
 }
 
 func NewInvoicesCommand(sl *metadata.SoftlayerCommand) *InvoicesCommand {
@@ -41,9 +40,8 @@ func NewInvoicesCommand(sl *metadata.SoftlayerCommand) *InvoicesCommand {
 			return thisCmd.Run(args)
 		},
 	}
-	cobraCmd.Flags().BoolVar(&thisCmd.All, "all", false, T("Return ALL invoices. There may be a lot of these."))
-	cobraCmd.Flags().BoolVar(&thisCmd.Closed, "closed", false, T("Include invoices with a CLOSED status."))
-	cobraCmd.Flags().IntVar(&thisCmd.Limit, "limit", 50, T("How many invoices to get back."))
+	cobraCmd.Flags().BoolVar(&thisCmd.Closed, "closed", false, T("Include invoices with a CLOSED status. --all includes them as well"))
+	metadata.AddLimitedPagingFlags(cobraCmd, &thisCmd.Paging, 50, "")
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
@@ -51,10 +49,13 @@ func NewInvoicesCommand(sl *metadata.SoftlayerCommand) *InvoicesCommand {
 func (cmd *InvoicesCommand) Run(args []string) error {
 	outputFormat := cmd.GetOutputFlag()
 
-	invoices, err := cmd.AccountManager.GetInvoices(cmd.Limit, cmd.Closed, cmd.All)
+	invoices, err := cmd.AccountManager.GetInvoices(cmd.Closed, cmd.Paging)
 	if err != nil {
 		return errors.NewAPIError(T("Failed to get invoices."), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, invoices)
+	}
 	table := cmd.UI.Table([]string{
 		T("Id"),
 		T("Created"),
@@ -77,6 +78,5 @@ func (cmd *InvoicesCommand) Run(args []string) error {
 			utils.FormatUIntPointer(invoice.ItemCount),
 		)
 	}
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
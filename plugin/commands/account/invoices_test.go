@@ -5,8 +5,10 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/softlayer/softlayer-go/session"
+	"github.com/spf13/cobra"
 
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/account"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
 )
@@ -31,7 +33,7 @@ var _ = Describe("Account list Invoices", func() {
 			It("Set command with an invalid output option", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 		})
 
@@ -43,17 +45,35 @@ var _ = Describe("Account list Invoices", func() {
 				Expect(fakeUI.Outputs()).To(ContainSubstring("76602936   2021-11-24T21:07:42Z   NEW    OPEN     264111.300000      264111.300000    0.000000         14"))
 				Expect(fakeUI.Outputs()).To(ContainSubstring("77186102   2021-12-10T13:44:59Z   NEW    CLOSED   266803.650000      266803.650000    0.000000         3"))
 			})
+			It("return every open and closed invoice with --all", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--all")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeUI.Outputs()).To(ContainSubstring("77186102   2021-12-10T13:44:59Z   NEW    CLOSED"))
+			})
 			It("return account invoices in format json", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output", "json")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(fakeUI.Outputs()).To(ContainSubstring(`[`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`{`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Id": "76602936",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Created": "2021-11-24T21:07:42Z",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Status": "OPEN",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`}`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"id": 76602936,`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"createDate": "2021-11-24T15:07:42-06:00",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"itemCount": 14,`))
 				Expect(fakeUI.Outputs()).To(ContainSubstring(`]`))
 			})
 		})
 	})
+	Context("JSON output in every locale", func() {
+		It("matches the golden file", func() {
+			outputs, err := testhelpers.RunCobraCommandInAllLocales(func() (*cobra.Command, *terminal.FakeUI) {
+				localeUI := terminal.NewFakeUI()
+				localeCommand := account.NewInvoicesCommand(metadata.NewSoftlayerCommand(localeUI, testhelpers.NewFakeSoftlayerSession(nil)))
+				localeCommand.Command.PersistentFlags().Var(localeCommand.OutputFlag, "output", "--output=JSON for json output.")
+				return localeCommand.Command, localeUI
+			}, "--output=JSON")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(outputs).To(HaveLen(len(i18n.SUPPORTED_LOCALES)))
+			for locale, output := range outputs {
+				Expect(output).To(Equal(testhelpers.ReadGoldenFile("account_invoices")), locale)
+			}
+		})
+	})
 })
@@ -63,13 +63,15 @@ nextInvoiceTotalRecurringAmount,location, hourlyFlag, children
 		}
 
 	}
-	PrintItemDetail(itemID, item, cmd.UI, outputFormat)
-	return nil
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, item)
+	}
+	return PrintItemDetail(itemID, item, cmd.UI, outputFormat)
 }
 
-func PrintItemDetail(itemID int, item datatypes.Billing_Item, ui terminal.UI, outputFormat string) {
+func PrintItemDetail(itemID int, item datatypes.Billing_Item, ui terminal.UI, outputFormat string) error {
 	bufEvent := new(bytes.Buffer)
-	table := terminal.NewTable(bufEvent, []string{
+	table := utils.NewTable(bufEvent, []string{
 		T("Key"),
 		T("Value"),
 	})
@@ -106,5 +108,5 @@ func PrintItemDetail(itemID int, item datatypes.Billing_Item, ui terminal.UI, ou
 		}
 	}
 
-	utils.PrintTableWithTitle(ui, table, bufEvent, utils.FormatStringPointer(item.Description), outputFormat)
+	return utils.PrintTableWithTitle(ui, table, bufEvent, utils.FormatStringPointer(item.Description), outputFormat)
 }
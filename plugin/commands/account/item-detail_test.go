@@ -48,7 +48,7 @@ var _ = Describe("Account list ItemDetail", func() {
 			It("Set command with an invalid output option", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "123", "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 		})
 
@@ -72,21 +72,10 @@ var _ = Describe("Account list ItemDetail", func() {
 			It("return account item detail in format json", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "123", "--output", "json")
 				Expect(err).NotTo(HaveOccurred())
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"1 x 2.0 GHz or higher Core":`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Key":`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Value":`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"createDate","`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"2022-01-05T01:19:21Z""`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"description","`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Value": "1 x 2.0 GHz or higher Core""`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"FQDN","`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"test.test.com""`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"hoursUsed","`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"423""`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`[`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`{`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`}`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`]`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"categoryCode": "guest_core",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"description": "2 GB",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"hoursUsed": "423",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"id": 921242276,`))
 			})
 			It("Test Fallback to GetItemDetailFromInvoiceItem", func() {
 				fakeHandler.AddApiError("SoftLayer_Billing_Item", "getObject", 404, "NOT FOUND")
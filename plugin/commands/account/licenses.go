@@ -46,20 +46,24 @@ func (cmd *LicensesCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to get virtual licenses."), err.Error(), 2)
 	}
-	PrintVirtualLicenses(virtualLicenses, cmd.UI, outputFormat)
 
 	mask = "mask[billingItem,softwareDescription]"
 	vmwares, err := cmd.AccountManager.GetActiveAccountLicenses(mask)
 	if err != nil {
 		return errors.NewAPIError(T("Failed to get account licenses."), err.Error(), 2)
 	}
-	PrintVmwaresLicenses(vmwares, cmd.UI, outputFormat)
-	return nil
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, map[string]interface{}{"virtualLicenses": virtualLicenses, "accountLicenses": vmwares})
+	}
+	if err := PrintVirtualLicenses(virtualLicenses, cmd.UI, outputFormat); err != nil {
+		return err
+	}
+	return PrintVmwaresLicenses(vmwares, cmd.UI, outputFormat)
 }
 
-func PrintVirtualLicenses(virtualLicenses []datatypes.Software_VirtualLicense, ui terminal.UI, outputFormat string) {
+func PrintVirtualLicenses(virtualLicenses []datatypes.Software_VirtualLicense, ui terminal.UI, outputFormat string) error {
 	bufEvent := new(bytes.Buffer)
-	table := terminal.NewTable(bufEvent, []string{
+	table := utils.NewTable(bufEvent, []string{
 		T("Id"),
 		T("Ip_address"),
 		T("Manufacturer"),
@@ -95,12 +99,12 @@ func PrintVirtualLicenses(virtualLicenses []datatypes.Software_VirtualLicense, u
 		)
 	}
 
-	utils.PrintTableWithTitle(ui, table, bufEvent, "Control Panel Licenses", outputFormat)
+	return utils.PrintTableWithTitle(ui, table, bufEvent, "Control Panel Licenses", outputFormat)
 }
 
-func PrintVmwaresLicenses(vmwares []datatypes.Software_AccountLicense, ui terminal.UI, outputFormat string) {
+func PrintVmwaresLicenses(vmwares []datatypes.Software_AccountLicense, ui terminal.UI, outputFormat string) error {
 	bufEvent := new(bytes.Buffer)
-	table := terminal.NewTable(bufEvent, []string{
+	table := utils.NewTable(bufEvent, []string{
 		T("Name"),
 		T("License key"),
 		T("CPUs"),
@@ -134,5 +138,5 @@ func PrintVmwaresLicenses(vmwares []datatypes.Software_AccountLicense, ui termin
 		)
 	}
 
-	utils.PrintTableWithTitle(ui, table, bufEvent, "VMware Licenses", outputFormat)
+	return utils.PrintTableWithTitle(ui, table, bufEvent, "VMware Licenses", outputFormat)
 }
@@ -31,7 +31,7 @@ var _ = Describe("Account list Licenses", func() {
 			It("Set command with an invalid output option", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 		})
 
@@ -51,19 +51,12 @@ var _ = Describe("Account list Licenses", func() {
 			It("return account licenses in format json", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output", "json")
 				Expect(err).NotTo(HaveOccurred())
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Control Panel Licenses":`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Id": "1234","`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Software": "Plesk - Unlimited Domain w/ Power Pack for VPS 17.8.11 Linux","`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Ip_address": "11.111.11.11","`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"VMware Licenses":`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Name": "vCenter","`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"License key": "ABCDE-00000-99999-88888-77777","`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"CPUs": "4","`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Required User": "administrator@vsphere.local""`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`[`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`{`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`}`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`]`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"accountLicenses": [`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"key": "ABCDE-00000-99999-88888-77777",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"description": "vCenter Server Appliance 6.0",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"virtualLicenses": [`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"ipAddress": "11.111.11.11",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"key": "ABCD.00000000.0000",`))
 			})
 		})
 	})
@@ -52,7 +52,14 @@ func (cmd *OrdersCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to get orders."), err.Error(), 2)
 	}
-	PrintOrders(orders, cmd.UI, outputFormat)
+	if outputFormat == "JSON" && !cmd.Upgrades {
+		return utils.PrintPrettyJSON(cmd.UI, orders)
+	}
+	if outputFormat != "JSON" {
+		if err := PrintOrders(orders, cmd.UI, outputFormat); err != nil {
+			return err
+		}
+	}
 
 	if cmd.Upgrades {
 		mask = "mask[id,maintenanceStartTimeUtc,statusId,createDate,ticketId]"
@@ -60,15 +67,20 @@ func (cmd *OrdersCommand) Run(args []string) error {
 		if err != nil {
 			return errors.NewAPIError(T("Failed to get Upgrade Requests."), err.Error(), 2)
 		}
-		PrintUpgrades(upgrades, cmd.UI, outputFormat)
+		if outputFormat == "JSON" {
+			return utils.PrintPrettyJSON(cmd.UI, map[string]interface{}{"orders": orders, "upgrades": upgrades})
+		}
+		if err := PrintUpgrades(upgrades, cmd.UI, outputFormat); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-func PrintOrders(orders []datatypes.Billing_Order, ui terminal.UI, outputFormat string) {
+func PrintOrders(orders []datatypes.Billing_Order, ui terminal.UI, outputFormat string) error {
 	bufEvent := new(bytes.Buffer)
-	table := terminal.NewTable(bufEvent, []string{
+	table := utils.NewTable(bufEvent, []string{
 		T("Id"),
 		T("State"),
 		T("User"),
@@ -94,12 +106,12 @@ func PrintOrders(orders []datatypes.Billing_Order, ui terminal.UI, outputFormat
 		)
 	}
 
-	utils.PrintTableWithTitle(ui, table, bufEvent, "Orders", outputFormat)
+	return utils.PrintTableWithTitle(ui, table, bufEvent, "Orders", outputFormat)
 }
 
-func PrintUpgrades(upgrades []datatypes.Product_Upgrade_Request, ui terminal.UI, outputFormat string) {
+func PrintUpgrades(upgrades []datatypes.Product_Upgrade_Request, ui terminal.UI, outputFormat string) error {
 	bufEvent := new(bytes.Buffer)
-	table := terminal.NewTable(bufEvent, []string{
+	table := utils.NewTable(bufEvent, []string{
 		T("Id"),
 		T("Maintance Window"),
 		T("Status"),
@@ -117,5 +129,5 @@ func PrintUpgrades(upgrades []datatypes.Product_Upgrade_Request, ui terminal.UI,
 		)
 	}
 
-	utils.PrintTableWithTitle(ui, table, bufEvent, "Upgrade orders", outputFormat)
+	return utils.PrintTableWithTitle(ui, table, bufEvent, "Upgrade orders", outputFormat)
 }
@@ -31,7 +31,7 @@ var _ = Describe("Account list Orders", func() {
 			It("Set command with an invalid output option", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 			It("Set command with an invalid limit option", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--limit", "abcd")
@@ -51,12 +51,10 @@ var _ = Describe("Account list Orders", func() {
 			It("return account orders in format json", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output", "json")
 				Expect(err).NotTo(HaveOccurred())
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Orders":`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Id": "123456789","`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"State": "APPROVED","`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"User": "test.test@ibm.com","`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Amount": "0.000000","`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Item": "1 x 2.0 GHz or higher Core,1 x 2.0 GHz or higher Core,1 GB,Reboot / Remote Conso...""`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"id": 123456789,`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"createDate": "2022-04-26T13:50:06-06:00",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"invoiceTotalAmount": 0`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"description": "1 x 2.0 GHz or higher Core"`))
 			})
 		})
 
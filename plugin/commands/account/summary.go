@@ -45,14 +45,19 @@ func (cmd *SummaryCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to get summary."), err.Error(), 2)
 	}
-	PrintSummary(account, cmd.UI, outputFormat)
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, account)
+	}
+	if err := PrintSummary(account, cmd.UI, outputFormat); err != nil {
+		return err
+	}
 
 	return nil
 }
 
-func PrintSummary(account datatypes.Account, ui terminal.UI, outputFormat string) {
+func PrintSummary(account datatypes.Account, ui terminal.UI, outputFormat string) error {
 	bufEvent := new(bytes.Buffer)
-	table := terminal.NewTable(bufEvent, []string{
+	table := utils.NewTable(bufEvent, []string{
 		T("Name"),
 		T("Value"),
 	})
@@ -78,5 +83,5 @@ func PrintSummary(account datatypes.Account, ui terminal.UI, outputFormat string
 	table.Add("Subnets", utils.FormatUIntPointer(account.SubnetCount))
 	table.Add("Users", utils.FormatUIntPointer(account.UserCount))
 
-	utils.PrintTableWithTitle(ui, table, bufEvent, "Account Snapshot", outputFormat)
+	return utils.PrintTableWithTitle(ui, table, bufEvent, "Account Snapshot", outputFormat)
 }
@@ -31,7 +31,7 @@ var _ = Describe("Account shows Summary ", func() {
 			It("Set command with an invalid output option", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 		})
 
@@ -59,13 +59,9 @@ var _ = Describe("Account shows Summary ", func() {
 			It("return account summary in format json", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output", "json")
 				Expect(err).NotTo(HaveOccurred())
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Account Snapshot":`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Name": "Company Name","`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Value": "IBM Cloud IaaS""`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Name": "Balance","`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Value": "275246.130000""`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Name": "Upcoming Invoice","`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Value": "3052.870000""`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"companyName": "IBM Cloud IaaS",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"hardwareCount": 21,`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"nextInvoiceTotalAmount": 3128.47,`))
 			})
 		})
 
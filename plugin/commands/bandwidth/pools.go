@@ -41,6 +41,9 @@ func (cmd *PoolsCommand) Run(args []string) error {
 	}
 
 	outputFormat := cmd.GetOutputFlag()
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, pools)
+	}
 
 	table := cmd.UI.Table([]string{
 		T("ID"),
@@ -80,7 +83,9 @@ func (cmd *PoolsCommand) Run(args []string) error {
 		)
 	}
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
+	if err := utils.PrintTable(cmd.UI, table, outputFormat); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -71,6 +71,9 @@ func (cmd *PoolsCreateCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to get Create Bandwidth Pool."), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, poolCreated)
+	}
 
 	table := cmd.UI.Table([]string{T("Name"), T("Value")})
 	table.Add("Id", utils.FormatIntPointer(poolCreated.Id))
@@ -78,8 +81,7 @@ func (cmd *PoolsCreateCommand) Run(args []string) error {
 	table.Add("Region", cmd.Region)
 	table.Add("Created Date", utils.FormatSLTimePointer(poolCreated.CreateDate))
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
 
 func finIdLocationGroup(locations []datatypes.Location_Group, regionName string) int {
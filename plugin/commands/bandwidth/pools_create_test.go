@@ -38,7 +38,7 @@ var _ = Describe("bandwidth pools-create", func() {
 			It("Set invalid output", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--name=NameRegion", "--region=SJC/DAL/WDC/TOR/MON", "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 		})
 
@@ -56,14 +56,9 @@ var _ = Describe("bandwidth pools-create", func() {
 			It("Get Bandwidth Pool with devices", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--name=NameRegion", "--region=SJC/DAL/WDC/TOR/MON", "--output=json")
 				Expect(err).NotTo(HaveOccurred())
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Name": "Id",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Value": "123456789"`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Value": "NewRegion"`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Value": "SJC/DAL/WDC/TOR/MON"`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`[`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`{`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`}`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`]`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"id": 123456789,`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"name": "NewRegion",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"locationGroupId": 2,`))
 			})
 		})
 	})
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
 	"github.com/softlayer/softlayer-go/datatypes"
 
 	"github.com/spf13/cobra"
@@ -54,6 +53,9 @@ func (cmd *PoolsDetailCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to get Bandwidth Pool."), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, bandwidthPool)
+	}
 
 	currentUsage := "-"
 	if bandwidthPool.BillingCyclePublicBandwidthUsage != nil {
@@ -99,13 +101,12 @@ func (cmd *PoolsDetailCommand) Run(args []string) error {
 		table.Add(T("Netscaler"), T("Not Found"))
 	}
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
 
 func getHardwareTable(hardwares []datatypes.Hardware) *bytes.Buffer {
 	buf := new(bytes.Buffer)
-	hardwareTable := terminal.NewTable(buf, []string{T("Id"), T("Hostname"), T("IP Address"), T("Amount"), T("Current Usage")})
+	hardwareTable := utils.NewTable(buf, []string{T("Id"), T("Hostname"), T("IP Address"), T("Amount"), T("Current Usage")})
 	for _, hardware := range hardwares {
 		ipAddress := "-"
 		if hardware.PrimaryIpAddress != nil {
@@ -133,7 +134,7 @@ func getHardwareTable(hardwares []datatypes.Hardware) *bytes.Buffer {
 
 func getVirtualTable(virtuals []datatypes.Virtual_Guest) *bytes.Buffer {
 	buf := new(bytes.Buffer)
-	virtualTable := terminal.NewTable(buf, []string{T("Id"), T("Hostname"), T("IP Address"), T("Amount"), T("Current Usage")})
+	virtualTable := utils.NewTable(buf, []string{T("Id"), T("Hostname"), T("IP Address"), T("Amount"), T("Current Usage")})
 	for _, virtual := range virtuals {
 		ipAddress := "-"
 		if virtual.PrimaryIpAddress != nil {
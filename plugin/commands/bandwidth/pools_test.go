@@ -6,8 +6,10 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/softlayer/softlayer-go/session"
+	"github.com/spf13/cobra"
 
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/bandwidth"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
 )
@@ -47,15 +49,25 @@ var _ = Describe("Bandwidth Pools", func() {
 			It("Outputs JSON", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output=JSON")
 				Expect(err).NotTo(HaveOccurred())
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"ID": "265721",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Name": "TestPool",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Projected Usage": "-",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Cost": "$55"`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`[`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`{`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`}`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`]`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"id": 265721,`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"name": "TestPool",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"nextInvoiceTotalRecurringAmount": 55`))
 			})
 		})
 	})
+	Context("JSON output in every locale", func() {
+		It("matches the golden file", func() {
+			outputs, err := testhelpers.RunCobraCommandInAllLocales(func() (*cobra.Command, *terminal.FakeUI) {
+				localeUI := terminal.NewFakeUI()
+				localeCommand := bandwidth.NewPoolsCommand(metadata.NewSoftlayerCommand(localeUI, testhelpers.NewFakeSoftlayerSession(nil)))
+				localeCommand.Command.PersistentFlags().Var(localeCommand.OutputFlag, "output", "--output=JSON for json output.")
+				return localeCommand.Command, localeUI
+			}, "--output=JSON")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(outputs).To(HaveLen(len(i18n.SUPPORTED_LOCALES)))
+			for locale, output := range outputs {
+				Expect(output).To(Equal(testhelpers.ReadGoldenFile("bandwidth_pools")), locale)
+			}
+		})
+	})
 })
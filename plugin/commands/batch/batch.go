@@ -0,0 +1,33 @@
+package batch
+
+import (
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/plugin"
+	"github.com/spf13/cobra"
+
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+)
+
+// Makes a new `sl` command tree that prints to ui, every step of a batch gets its own so flags from one step
+// don't carry over to the next. The tree has to use the session of the batch, so it is only logged in once.
+type RootCommandFactory func(ui terminal.UI) *cobra.Command
+
+func SetupCobraCommands(sl *metadata.SoftlayerCommand, newRoot RootCommandFactory) *cobra.Command {
+	cobraCmd := &cobra.Command{
+		Use:   "batch",
+		Short: T("Run files of sl commands"),
+		RunE:  nil,
+	}
+
+	cobraCmd.AddCommand(NewRunCommand(sl, newRoot).Command)
+	return cobraCmd
+}
+
+func BatchNamespace() plugin.Namespace {
+	return plugin.Namespace{
+		ParentName:  "sl",
+		Name:        "batch",
+		Description: T("Run files of sl commands"),
+	}
+}
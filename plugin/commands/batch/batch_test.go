@@ -0,0 +1,67 @@
+package batch_test
+
+import (
+	"testing"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
+	fakeTerminal "github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/batch"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
+)
+
+func TestManagers(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Batch Suite")
+}
+
+var availableCommands = []string{
+	"run",
+}
+
+// This test suite exists to make sure commands don't get accidently removed from the SetupCobraCommands
+var _ = Describe("Test batch commands", func() {
+	fakeUI := fakeTerminal.NewFakeUI()
+	fakeSession := testhelpers.NewFakeSoftlayerSession(nil)
+	slMeta := metadata.NewSoftlayerCommand(fakeUI, fakeSession)
+
+	Context("New commands testable", func() {
+		commands := batch.SetupCobraCommands(slMeta, func(ui terminal.UI) *cobra.Command { return &cobra.Command{Use: "sl"} })
+
+		var arrayCommands = []string{}
+		for _, command := range commands.Commands() {
+			commandName := command.Name()
+			arrayCommands = append(arrayCommands, commandName)
+			It("available commands "+commands.Name(), func() {
+				available := false
+				if utils.StringInSlice(commandName, availableCommands) != -1 {
+					available = true
+				}
+				Expect(available).To(BeTrue(), commandName+" not found in array available Commands")
+			})
+		}
+		for _, command := range availableCommands {
+			commandName := command
+			It("ibmcloud sl "+commands.Name(), func() {
+				available := false
+				if utils.StringInSlice(commandName, arrayCommands) != -1 {
+					available = true
+				}
+				Expect(available).To(BeTrue(), commandName+" not found in ibmcloud sl "+commands.Name())
+			})
+		}
+	})
+
+	Context("Batch Namespace", func() {
+		It("Batch Name Space", func() {
+			Expect(batch.BatchNamespace().ParentName).To(ContainSubstring("sl"))
+			Expect(batch.BatchNamespace().Name).To(ContainSubstring("batch"))
+			Expect(batch.BatchNamespace().Description).To(ContainSubstring("sl commands"))
+		})
+	})
+})
@@ -0,0 +1,218 @@
+package batch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jmespath/go-jmespath"
+	"gopkg.in/yaml.v3"
+
+	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+)
+
+// A file of steps for `sl batch run`, in YAML (or JSON, which is also YAML)
+//
+//	continue-on-error: false
+//	vars:
+//	  datacenter: dal13
+//	steps:
+//	  - name: vlan
+//	    command: vlan create --datacenter ${vars.datacenter} --network public --force --output JSON
+//	  - name: server
+//	    args: [vs, create, --hostname, web1, --vlan-public, "${steps.vlan.output.id}", --force]
+type Plan struct {
+	// Keep going after a step fails, unless the step says otherwise
+	ContinueOnError bool              `yaml:"continue-on-error"`
+	Vars            map[string]string `yaml:"vars"`
+	Steps           []Step            `yaml:"steps"`
+}
+
+// One sl command of a Plan, given as Command or Args
+type Step struct {
+	// How later steps refer to the output of this one, step-1, step-2 and so on when not given
+	Name string `yaml:"name"`
+	// The command line, split into arguments like a shell does. A leading "sl" or "ibmcloud sl" is optional.
+	Command string `yaml:"command"`
+	// The arguments, for values that are hard to quote
+	Args []string `yaml:"args"`
+	// Overrides the ContinueOnError of the plan for this step
+	ContinueOnError *bool `yaml:"continue-on-error"`
+}
+
+// ${vars.NAME} and ${steps.NAME.output[.JMESPATH]}
+var variablePattern = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+// Reads and checks the plan in file
+func LoadPlan(file string) (Plan, error) {
+	plan := Plan{}
+	planBytes, err := os.ReadFile(file) // #nosec
+	if err != nil {
+		return plan, slErr.NewInvalidUsageError(T("Failed to read {{.FILE}}: {{.ERROR}}", map[string]interface{}{"FILE": file, "ERROR": err.Error()}))
+	}
+	// Strict, so a typo like flavour: is an error instead of a setting that silently isn't used
+	decoder := yaml.NewDecoder(bytes.NewReader(planBytes))
+	decoder.KnownFields(true)
+	err = decoder.Decode(&plan)
+	if err != nil && err != io.EOF {
+		return plan, slErr.NewInvalidUsageError(T("Failed to read {{.FILE}}: {{.ERROR}}", map[string]interface{}{"FILE": file, "ERROR": err.Error()}))
+	}
+	if plan.Vars == nil {
+		plan.Vars = map[string]string{}
+	}
+	if len(plan.Steps) == 0 {
+		return plan, slErr.NewInvalidUsageError(T("{{.FILE}} has no steps.", map[string]interface{}{"FILE": file}))
+	}
+	names := map[string]bool{}
+	for i := range plan.Steps {
+		step := &plan.Steps[i]
+		subs := map[string]interface{}{"FILE": file, "STEP": i + 1, "NAME": step.Name}
+		if step.Name == "" {
+			step.Name = fmt.Sprintf("step-%d", i+1)
+		}
+		if names[step.Name] {
+			return plan, slErr.NewInvalidUsageError(T("{{.FILE}} has more than one step named {{.NAME}}.", subs))
+		}
+		names[step.Name] = true
+		if (step.Command == "") == (len(step.Args) == 0) {
+			return plan, slErr.NewInvalidUsageError(T("Step {{.STEP}} of {{.FILE}} needs either command or args.", subs))
+		}
+	}
+	return plan, nil
+}
+
+// Keep going after step fails
+func (plan Plan) ContinueAfter(step Step) bool {
+	if step.ContinueOnError != nil {
+		return *step.ContinueOnError
+	}
+	return plan.ContinueOnError
+}
+
+// The arguments of step, before any variables are filled in
+func (step Step) Arguments() ([]string, error) {
+	args := step.Args
+	if step.Command != "" {
+		var err error
+		args, err = SplitCommandLine(step.Command)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(args) > 1 && args[0] == "ibmcloud" && args[1] == "sl" {
+		args = args[2:]
+	} else if len(args) > 0 && args[0] == "sl" {
+		args = args[1:]
+	}
+	return args, nil
+}
+
+// Splits line into arguments the way a shell would, with single quotes, double quotes and backslashes
+func SplitCommandLine(line string) ([]string, error) {
+	args := []string{}
+	var current strings.Builder
+	inArg := false
+	var quote rune
+	escaped := false
+	for _, char := range line {
+		switch {
+		case escaped:
+			current.WriteRune(char)
+			escaped = false
+		case char == '\\' && quote != '\'':
+			escaped = true
+			inArg = true
+		case quote != 0:
+			if char == quote {
+				quote = 0
+			} else {
+				current.WriteRune(char)
+			}
+		case char == '\'' || char == '"':
+			quote = char
+			inArg = true
+		case char == ' ' || char == '\t' || char == '\n':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(char)
+			inArg = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, slErr.NewInvalidUsageError(T("Unterminated quote in: {{.LINE}}", map[string]interface{}{"LINE": line}))
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
+
+// Fills in the ${vars.NAME} and ${steps.NAME.output} variables of arg. After output comes an optional
+// JMESPath expression, used on the JSON output of the step, like ${steps.vlan.output.id}
+func Substitute(arg string, vars map[string]string, outputs map[string]string) (string, error) {
+	var substituteErr error
+	result := variablePattern.ReplaceAllStringFunc(arg, func(match string) string {
+		variable := strings.TrimSpace(match[2 : len(match)-1])
+		value, err := variableValue(variable, vars, outputs)
+		if err != nil && substituteErr == nil {
+			substituteErr = err
+		}
+		return value
+	})
+	return result, substituteErr
+}
+
+func variableValue(variable string, vars map[string]string, outputs map[string]string) (string, error) {
+	subs := map[string]interface{}{"VARIABLE": variable}
+	if name, found := strings.CutPrefix(variable, "vars."); found {
+		value, ok := vars[name]
+		if !ok {
+			return "", slErr.NewInvalidUsageError(T("Variable {{.VARIABLE}} is not set.", subs))
+		}
+		return value, nil
+	}
+	rest, found := strings.CutPrefix(variable, "steps.")
+	if !found {
+		return "", slErr.NewInvalidUsageError(T("Unknown variable {{.VARIABLE}}, use vars.NAME or steps.NAME.output.", subs))
+	}
+	name, query, found := strings.Cut(rest, ".output")
+	output, ok := outputs[name]
+	if !found || !ok {
+		return "", slErr.NewInvalidUsageError(T("Unknown variable {{.VARIABLE}}, use vars.NAME or steps.NAME.output of an earlier step.", subs))
+	}
+	query = strings.TrimPrefix(query, ".")
+	if query == "" {
+		return strings.TrimSpace(output), nil
+	}
+	var data interface{}
+	err := json.Unmarshal([]byte(output), &data)
+	if err != nil {
+		return "", slErr.New(T("{{.VARIABLE}} needs JSON output, add --output JSON to the step.", subs))
+	}
+	value, err := jmespath.Search(query, data)
+	if err != nil {
+		subs["ERROR"] = err.Error()
+		return "", slErr.NewInvalidUsageError(T("Invalid query in {{.VARIABLE}}: {{.ERROR}}", subs))
+	}
+	switch typed := value.(type) {
+	case nil:
+		return "", slErr.New(T("{{.VARIABLE}} is not in the output of the step.", subs))
+	case string:
+		return typed, nil
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64), nil
+	default:
+		valueJSON, err := json.Marshal(typed)
+		return string(valueJSON), err
+	}
+}
@@ -0,0 +1,143 @@
+package batch_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/batch"
+)
+
+// Writes plan to a file and returns its name
+func writePlan(plan string) string {
+	file := filepath.Join(GinkgoT().TempDir(), "plan.yaml")
+	Expect(os.WriteFile(file, []byte(plan), 0600)).To(Succeed())
+	return file
+}
+
+var _ = Describe("Plan", func() {
+	Describe("LoadPlan", func() {
+		It("Reads the steps", func() {
+			plan, err := batch.LoadPlan(writePlan(`
+continue-on-error: true
+vars:
+  datacenter: dal13
+steps:
+  - name: vlan
+    command: sl vlan create --datacenter ${vars.datacenter}
+  - args: [vs, list]
+    continue-on-error: false
+`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.Vars).To(Equal(map[string]string{"datacenter": "dal13"}))
+			Expect(plan.Steps).To(HaveLen(2))
+			Expect(plan.Steps[0].Name).To(Equal("vlan"))
+			Expect(plan.Steps[1].Name).To(Equal("step-2"))
+			Expect(plan.ContinueAfter(plan.Steps[0])).To(BeTrue())
+			Expect(plan.ContinueAfter(plan.Steps[1])).To(BeFalse())
+			args, err := plan.Steps[0].Arguments()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(args).To(Equal([]string{"vlan", "create", "--datacenter", "${vars.datacenter}"}))
+		})
+		It("Reads JSON", func() {
+			plan, err := batch.LoadPlan(writePlan(`{"steps": [{"command": "ibmcloud sl vs list"}]}`))
+			Expect(err).NotTo(HaveOccurred())
+			args, err := plan.Steps[0].Arguments()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(args).To(Equal([]string{"vs", "list"}))
+		})
+		It("Errors without steps", func() {
+			_, err := batch.LoadPlan(writePlan("vars: {}\n"))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("has no steps."))
+		})
+		It("Errors on steps without a command, or with two", func() {
+			_, err := batch.LoadPlan(writePlan("steps:\n  - name: nothing\n"))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Step 1 of"))
+			Expect(err.Error()).To(ContainSubstring("needs either command or args."))
+			_, err = batch.LoadPlan(writePlan("steps:\n  - command: vs list\n    args: [vs, list]\n"))
+			Expect(err).To(HaveOccurred())
+		})
+		It("Errors on steps with the same name", func() {
+			_, err := batch.LoadPlan(writePlan("steps:\n  - {name: a, command: vs list}\n  - {name: a, command: hw list}\n"))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("has more than one step named a."))
+		})
+		It("Errors on unknown keys", func() {
+			_, err := batch.LoadPlan(writePlan("steps:\n  - command: vs list\n    continue-on-eror: true\n"))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("field continue-on-eror not found"))
+		})
+		It("Errors on files that can't be read", func() {
+			_, err := batch.LoadPlan(filepath.Join(GinkgoT().TempDir(), "missing.yaml"))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Failed to read"))
+			_, err = batch.LoadPlan(writePlan("steps: [\n"))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Failed to read"))
+		})
+	})
+
+	Describe("SplitCommandLine", func() {
+		It("Splits like a shell", func() {
+			args, err := batch.SplitCommandLine(`vs edit 1234  --userdata 'echo "hi there"' --tag "web server" a\ b`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(args).To(Equal([]string{"vs", "edit", "1234", "--userdata", `echo "hi there"`, "--tag", "web server", "a b"}))
+		})
+		It("Keeps empty quoted arguments", func() {
+			args, err := batch.SplitCommandLine(`vs edit 1234 --tag ""`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(args).To(Equal([]string{"vs", "edit", "1234", "--tag", ""}))
+		})
+		It("Errors on unterminated quotes", func() {
+			_, err := batch.SplitCommandLine(`vs edit 'oops`)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Unterminated quote"))
+		})
+	})
+
+	Describe("Substitute", func() {
+		vars := map[string]string{"datacenter": "dal13"}
+		outputs := map[string]string{
+			"vlan": `{"id": 1234, "name": "public", "subnets": [{"id": 55}]}`,
+			"text": "Hello\n",
+		}
+		It("Fills in variables", func() {
+			value, err := batch.Substitute("--datacenter=${vars.datacenter}", vars, outputs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal("--datacenter=dal13"))
+		})
+		It("Fills in step outputs", func() {
+			value, err := batch.Substitute("${steps.vlan.output.id}", vars, outputs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal("1234"))
+			value, err = batch.Substitute("${steps.vlan.output.subnets[0]}", vars, outputs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal(`{"id":55}`))
+			value, err = batch.Substitute("${steps.text.output}!", vars, outputs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal("Hello!"))
+		})
+		It("Errors on unknown variables", func() {
+			_, err := batch.Substitute("${vars.missing}", vars, outputs)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Variable vars.missing is not set."))
+			_, err = batch.Substitute("${steps.later.output.id}", vars, outputs)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Unknown variable steps.later.output.id"))
+			_, err = batch.Substitute("${HOME}", vars, outputs)
+			Expect(err).To(HaveOccurred())
+		})
+		It("Errors on output that isn't JSON, or doesn't have the value", func() {
+			_, err := batch.Substitute("${steps.text.output.id}", vars, outputs)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("needs JSON output"))
+			_, err = batch.Substitute("${steps.vlan.output.missing}", vars, outputs)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("is not in the output of the step."))
+		})
+	})
+})
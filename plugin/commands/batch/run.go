@@ -0,0 +1,214 @@
+package batch
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
+	"github.com/spf13/cobra"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
+)
+
+// What happened to a step, the Status of a StepResult
+const (
+	STEP_DONE    = "done"
+	STEP_FAILED  = "failed"
+	STEP_SKIPPED = "skipped"
+	STEP_DRY_RUN = "dry-run"
+)
+
+// What happened to one step of a batch
+type StepResult struct {
+	Name    string `json:"name"`
+	Command string `json:"command,omitempty"`
+	Status  string `json:"status"`
+	Error   string `json:"error,omitempty"`
+}
+
+type RunCommand struct {
+	*metadata.SoftlayerCommand
+	NewRoot RootCommandFactory
+	Command *cobra.Command
+	Vars    []string
+	// Where the steps read answers to prompts from
+	Stdin io.Reader
+	// Where the steps print their warnings and progress
+	Stderr io.Writer
+}
+
+func NewRunCommand(sl *metadata.SoftlayerCommand, newRoot RootCommandFactory) *RunCommand {
+	thisCmd := &RunCommand{
+		SoftlayerCommand: sl,
+		NewRoot:          newRoot,
+		Stdin:            os.Stdin,
+		Stderr:           os.Stderr,
+	}
+	cobraCmd := &cobra.Command{
+		Use:   "run " + T("FILE"),
+		Short: T("Run the steps in a file of sl commands"),
+		Long: T(`${COMMAND_NAME} sl batch run FILE [OPTIONS]
+
+Runs the sl commands in FILE one after another, with the same login. FILE is YAML (or JSON) like:
+
+  continue-on-error: false
+  vars:
+    datacenter: dal13
+  steps:
+    - name: vlan
+      command: vlan create --datacenter ${vars.datacenter} --network public --force --output JSON
+    - name: server
+      args: [vs, create, --hostname, web1, --vlan-public, "${steps.vlan.output.id}", --force]
+      continue-on-error: true
+
+A step has a command line (quoted like in a shell) or a list of args. The leading "sl" is optional.
+${vars.NAME} is a variable from vars or --var. ${steps.NAME.output} is what an earlier step printed, and
+${steps.NAME.output.QUERY} is part of its JSON output (add --output JSON to the step), QUERY being a JMESPath expression like id or guests[0].id.
+The run stops at the first step that fails, unless the plan or the step sets continue-on-error. Commands that ask
+for confirmation need --force. A report of every step is printed at the end.
+
+EXAMPLE:
+   ${COMMAND_NAME} sl batch run plan.yaml --var datacenter=dal10
+   Runs the steps in plan.yaml in dal10.`),
+		Args: metadata.OneArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return thisCmd.Run(args)
+		},
+	}
+	cobraCmd.Flags().StringArrayVar(&thisCmd.Vars, "var", []string{}, T("Set the variable NAME=VALUE, overriding vars in the file (multiple occurrence permitted)"))
+	thisCmd.Command = cobraCmd
+	return thisCmd
+}
+
+func (cmd *RunCommand) Run(args []string) error {
+	plan, err := LoadPlan(args[0])
+	if err != nil {
+		return err
+	}
+	for _, variable := range cmd.Vars {
+		name, value, found := strings.Cut(variable, "=")
+		if !found || name == "" {
+			return slErr.NewInvalidUsageError(T("Invalid input for '{{.Name}}'. It must be NAME=VALUE.", map[string]interface{}{"Name": "--var"}))
+		}
+		plan.Vars[name] = value
+	}
+	outputFormat := cmd.GetOutputFlag()
+	// Every step sets up its own output format, the report is printed in the one of the batch
+	structuredOutput := *utils.StructuredOutput
+
+	outputs := map[string]string{}
+	results := []StepResult{}
+	failed := 0
+	stopped := false
+	for _, step := range plan.Steps {
+		if stopped {
+			results = append(results, StepResult{Name: step.Name, Status: STEP_SKIPPED})
+			continue
+		}
+		result, output := cmd.runStep(step, plan.Vars, outputs, outputFormat == "JSON")
+		*utils.StructuredOutput = structuredOutput
+		outputs[step.Name] = output
+		results = append(results, result)
+		if result.Status == STEP_FAILED {
+			failed++
+			stopped = !plan.ContinueAfter(step)
+		}
+	}
+
+	if outputFormat == "JSON" {
+		err = utils.PrintPrettyJSON(cmd.UI, results)
+		if err != nil {
+			return err
+		}
+	} else {
+		table := cmd.UI.Table([]string{T("Step"), T("Command"), T("Status"), T("Error")})
+		for _, result := range results {
+			table.Add(result.Name, stepValue(result.Command), stepStatus(result.Status), stepValue(result.Error))
+		}
+		table.Print()
+	}
+	if failed > 0 {
+		return slErr.New(T("{{.FAILED}} of {{.TOTAL}} steps failed.", map[string]interface{}{"FAILED": failed, "TOTAL": len(results)}))
+	}
+	return nil
+}
+
+// Runs step in a new command tree and returns what happened and what it printed.
+// The output only goes to the terminal when the report isn't JSON, so it doesn't get mixed in.
+func (cmd *RunCommand) runStep(step Step, vars map[string]string, outputs map[string]string, quiet bool) (StepResult, string) {
+	result := StepResult{Name: step.Name, Status: STEP_FAILED}
+	args, err := step.Arguments()
+	if err != nil {
+		result.Error = err.Error()
+		return result, ""
+	}
+	for i, arg := range args {
+		args[i], err = Substitute(arg, vars, outputs)
+		if err != nil {
+			result.Error = err.Error()
+			return result, ""
+		}
+	}
+	result.Command = strings.Join(append([]string{"sl"}, args...), " ")
+	if len(args) > 0 && args[0] == "batch" {
+		result.Error = T("Batch steps can't run other batches.")
+		return result, ""
+	}
+	cmd.UI.Info(T("Step {{.NAME}}: {{.COMMAND}}", map[string]interface{}{"NAME": step.Name, "COMMAND": result.Command}))
+
+	var output bytes.Buffer
+	var out io.Writer = &output
+	if !quiet {
+		out = io.MultiWriter(&output, cmd.UI.Writer())
+	}
+	// The step's global flags change the transport every step shares, they are undone once it is done
+	if transport, ok := client.GetCLITransport(cmd.Session); ok {
+		defer transport.RestoreSettings(transport.Settings())
+	}
+	root := cmd.NewRoot(terminal.NewUI(cmd.Stdin, out, cmd.Stderr))
+	root.SetOut(out)
+	root.SetErr(cmd.Stderr)
+	root.SetArgs(args)
+	err = root.Execute()
+	switch {
+	case cmd.isDryRunError(err):
+		// Like a single command, stopping at the first request --dry-run blocks is what is expected
+		result.Status = STEP_DRY_RUN
+	case err != nil:
+		result.Error = err.Error()
+	default:
+		result.Status = STEP_DONE
+	}
+	return result, output.String()
+}
+
+func (cmd *RunCommand) isDryRunError(err error) bool {
+	transport, ok := client.GetCLITransport(cmd.Session)
+	return ok && transport.IsDryRunError(err)
+}
+
+func stepStatus(status string) string {
+	switch status {
+	case STEP_DONE:
+		return T("Done")
+	case STEP_SKIPPED:
+		return T("Skipped")
+	case STEP_DRY_RUN:
+		return T("Dry run")
+	default:
+		return T("Failed")
+	}
+}
+
+func stepValue(value string) string {
+	if value == "" {
+		return utils.EMPTY_VALUE
+	}
+	return value
+}
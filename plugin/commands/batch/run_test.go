@@ -0,0 +1,176 @@
+package batch_test
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
+	fakeTerminal "github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/spf13/cobra"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/batch"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
+)
+
+var _ = Describe("batch run", func() {
+	var (
+		fakeUI      *fakeTerminal.FakeUI
+		cliCommand  *batch.RunCommand
+		fakeSession *session.Session
+		slCommand   *metadata.SoftlayerCommand
+		roots       int
+		vlanPublic  []string
+	)
+	// A small sl: `vlan create` prints JSON, `vs create` saves its --vlan-public and `fail` fails
+	newRoot := func(ui terminal.UI) *cobra.Command {
+		roots++
+		root := &cobra.Command{Use: "sl", SilenceErrors: true, SilenceUsage: true}
+		root.PersistentFlags().String("output", "", "")
+		// Like applyGlobalFlags in plugin.go
+		root.PersistentFlags().Bool("dry-run", false, "")
+		root.PersistentPreRun = func(cmd *cobra.Command, args []string) {
+			transport, ok := client.GetCLITransport(fakeSession)
+			if dryRun, _ := cmd.Flags().GetBool("dry-run"); ok && dryRun {
+				transport.DryRun = true
+				transport.DryRunOutput = ui.Writer()
+			}
+		}
+		vlan := &cobra.Command{Use: "vlan"}
+		vlan.AddCommand(&cobra.Command{Use: "create", RunE: func(cmd *cobra.Command, args []string) error {
+			ui.Print(`{"id": 1234, "name": "public"}`)
+			return nil
+		}})
+		vs := &cobra.Command{Use: "vs"}
+		vsCreate := &cobra.Command{Use: "create", RunE: func(cmd *cobra.Command, args []string) error {
+			value, _ := cmd.Flags().GetString("vlan-public")
+			vlanPublic = append(vlanPublic, value)
+			ui.Print("Created")
+			return nil
+		}}
+		vsCreate.Flags().String("vlan-public", "", "")
+		vs.AddCommand(vsCreate)
+		root.AddCommand(vlan, vs, &cobra.Command{Use: "fail", RunE: func(cmd *cobra.Command, args []string) error {
+			return errors.New("SoftLayer_Exception_Public: nope")
+		}})
+		root.AddCommand(&cobra.Command{Use: "cancel", RunE: func(cmd *cobra.Command, args []string) error {
+			var result bool
+			return fakeSession.DoRequest("SoftLayer_Virtual_Guest", "deleteObject", nil, nil, &result)
+		}})
+		return root
+	}
+	BeforeEach(func() {
+		roots = 0
+		vlanPublic = []string{}
+		fakeUI = fakeTerminal.NewFakeUI()
+		fakeSession = testhelpers.NewFakeSoftlayerSession(nil)
+		slCommand = metadata.NewSoftlayerCommand(fakeUI, fakeSession)
+		cliCommand = batch.NewRunCommand(slCommand, newRoot)
+		cliCommand.Command.PersistentFlags().Var(cliCommand.OutputFlag, "output", "--output=JSON for json output.")
+		cliCommand.Stdin = new(bytes.Buffer)
+		cliCommand.Stderr = new(bytes.Buffer)
+	})
+
+	Context("Invalid Usage", func() {
+		It("Errors without a file", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Incorrect Usage: This command requires one argument"))
+		})
+		It("Errors on a bad --var", func() {
+			file := writePlan("steps:\n  - command: vs create\n")
+			err := testhelpers.RunCobraCommand(cliCommand.Command, file, "--var", "nothing")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Invalid input for '--var'"))
+		})
+	})
+
+	Context("Running steps", func() {
+		It("Runs every step in its own command tree and fills in outputs", func() {
+			file := writePlan(`
+steps:
+  - name: vlan
+    command: sl vlan create --output JSON
+  - name: server
+    args: [vs, create, --vlan-public, "${steps.vlan.output.id}"]
+`)
+			err := testhelpers.RunCobraCommand(cliCommand.Command, file)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(roots).To(Equal(2))
+			Expect(vlanPublic).To(Equal([]string{"1234"}))
+			Expect(fakeUI.Outputs()).To(ContainSubstring(`{"id": 1234, "name": "public"}`))
+			Expect(fakeUI.Outputs()).To(ContainSubstring("Created"))
+			Expect(fakeUI.Outputs()).To(ContainSubstring("sl vs create --vlan-public 1234"))
+			Expect(fakeUI.Errors()).To(ContainSubstring("Step server: sl vs create --vlan-public 1234"))
+		})
+		It("Uses --var over vars in the file", func() {
+			file := writePlan("vars:\n  vlan: '1'\nsteps:\n  - command: vs create --vlan-public ${vars.vlan}\n")
+			err := testhelpers.RunCobraCommand(cliCommand.Command, file, "--var", "vlan=2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vlanPublic).To(Equal([]string{"2"}))
+		})
+		It("Stops at the first failure", func() {
+			file := writePlan("steps:\n  - command: fail\n  - command: vs create\n")
+			err := testhelpers.RunCobraCommand(cliCommand.Command, file, "--output", "JSON")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("1 of 2 steps failed."))
+			Expect(vlanPublic).To(BeEmpty())
+			Expect(fakeUI.Outputs()).To(ContainSubstring(`"status": "failed"`))
+			Expect(fakeUI.Outputs()).To(ContainSubstring(`"error": "SoftLayer_Exception_Public: nope"`))
+			Expect(fakeUI.Outputs()).To(ContainSubstring(`"status": "skipped"`))
+		})
+		It("Keeps going with continue-on-error", func() {
+			file := writePlan("steps:\n  - command: fail\n    continue-on-error: true\n  - command: vs create\n")
+			err := testhelpers.RunCobraCommand(cliCommand.Command, file)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("1 of 2 steps failed."))
+			Expect(vlanPublic).To(Equal([]string{""}))
+			Expect(fakeUI.Outputs()).To(ContainSubstring("Failed"))
+			Expect(fakeUI.Outputs()).To(ContainSubstring("Done"))
+		})
+		It("Fails steps that use unknown variables", func() {
+			file := writePlan("steps:\n  - command: vs create --vlan-public ${steps.vlan.output.id}\n")
+			err := testhelpers.RunCobraCommand(cliCommand.Command, file)
+			Expect(err).To(HaveOccurred())
+			Expect(roots).To(Equal(0))
+			Expect(fakeUI.Outputs()).To(ContainSubstring("Unknown variable steps.vlan.output.id"))
+		})
+		It("Doesn't run batches from a batch", func() {
+			file := writePlan("steps:\n  - command: batch run plan.yaml\n")
+			err := testhelpers.RunCobraCommand(cliCommand.Command, file)
+			Expect(err).To(HaveOccurred())
+			Expect(roots).To(Equal(0))
+			Expect(fakeUI.Outputs()).To(ContainSubstring("Batch steps can't run other batches."))
+		})
+		It("Reports steps stopped by --dry-run", func() {
+			fakeSession.TransportHandler = &client.CLIRestTransport{
+				RestTransport: &session.RestTransport{},
+				DryRun:        true,
+				DryRunOutput:  new(bytes.Buffer),
+			}
+			file := writePlan("steps:\n  - command: cancel\n  - command: vs create\n")
+			err := testhelpers.RunCobraCommand(cliCommand.Command, file, "--output", "JSON")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(ContainSubstring(`"status": "dry-run"`))
+			Expect(vlanPublic).To(Equal([]string{""}))
+		})
+		It("Doesn't keep the --dry-run of a step for the next steps", func() {
+			transport := &client.CLIRestTransport{
+				RestTransport: &session.RestTransport{},
+				Handler:       testhelpers.FakeTransportHandler_True{},
+			}
+			fakeSession.TransportHandler = transport
+			file := writePlan("steps:\n  - command: cancel --dry-run\n  - command: cancel\n")
+			err := testhelpers.RunCobraCommand(cliCommand.Command, file, "--output", "JSON")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`"status": "dry-run"(.|\n)*"status": "done"`))
+			Expect(transport.BlockedRequests()).To(HaveLen(1))
+			Expect(transport.DryRun).To(BeFalse())
+			Expect(transport.DryRunOutput).To(BeNil())
+		})
+	})
+})
@@ -47,6 +47,9 @@ func (cmd *DuplicateConvertStatusCommand) Run(args []string) error {
 		return slErr.NewAPIError(T("Failed to get duplicate conversion status of volume {{.VolumeID}}.\n",
 			map[string]interface{}{"VolumeID": volumeID}), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, duplicateConversionStatus)
+	}
 
 	table := cmd.UI.Table([]string{T("Username"), T("Active Conversion Start Timestamp"), T("Completed Percentage")})
 	table.Add(
@@ -55,7 +58,9 @@ func (cmd *DuplicateConvertStatusCommand) Run(args []string) error {
 		utils.FormatIntPointer(duplicateConversionStatus.DeDuplicateConversionPercentage),
 	)
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
+	if err := utils.PrintTable(cmd.UI, table, outputFormat); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -39,6 +39,9 @@ func (cmd *ObjectListCommand) Run(args []string) error {
 	if err != nil {
 		return slErr.NewAPIError(T("Failed to get Cloud Object Storages.\n"), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, cloudObjectStorages)
+	}
 
 	table := cmd.UI.Table([]string{T("Id"), T("Account name"), T("Description"), T("Create Date"), T("Type")})
 	for _, objectStorage := range cloudObjectStorages {
@@ -51,6 +54,5 @@ func (cmd *ObjectListCommand) Run(args []string) error {
 		)
 	}
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
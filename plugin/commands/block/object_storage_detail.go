@@ -54,6 +54,9 @@ func (cmd *ObjectStorageDetailCommand) Run(args []string) error {
 		return slErr.NewAPIError(T("Failed to get bucket of storage {{.StorageID}}.",
 			map[string]interface{}{"StorageID": storageID}), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, map[string]interface{}{"storage": networkStorageDetail, "buckets": bucket})
+	}
 
 	table := cmd.UI.Table([]string{
 		T("Name"),
@@ -68,6 +71,5 @@ func (cmd *ObjectStorageDetailCommand) Run(args []string) error {
 	table.Add("Bytes Used", utils.B2GB(*bucket[0].BytesUsed))
 	table.Add("Bucket Name", utils.FormatStringPointer(bucket[0].Name))
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
@@ -113,18 +113,14 @@ var _ = Describe("block object-storage-detail", func() {
 			It("Return object storage detail in json format", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "123", "--output=json")
 				Expect(err).NotTo(HaveOccurred())
-				Expect(fakeUI.Outputs()).To(ContainSubstring("["))
-				Expect(fakeUI.Outputs()).To(ContainSubstring("]"))
-				Expect(fakeUI.Outputs()).To(ContainSubstring("{"))
-				Expect(fakeUI.Outputs()).To(ContainSubstring("}"))
-				Expect(fakeUI.Outputs()).To(ContainSubstring("123"))
-				Expect(fakeUI.Outputs()).To(ContainSubstring("StorageName"))
-				Expect(fakeUI.Outputs()).To(ContainSubstring("ResourceName"))
-				Expect(fakeUI.Outputs()).To(ContainSubstring("CLEVERSAFE_SVC_API"))
-				Expect(fakeUI.Outputs()).To(ContainSubstring("sjc03"))
-				Expect(fakeUI.Outputs()).To(ContainSubstring("OBJECT_STORAGE_STANDARD"))
-				Expect(fakeUI.Outputs()).To(ContainSubstring("6.09G"))
-				Expect(fakeUI.Outputs()).To(ContainSubstring("BucketName"))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"id": 123,`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"username": "StorageName"`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"name": "ResourceName",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"type": "CLEVERSAFE_SVC_API"`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"name": "sjc03"`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"keyName": "OBJECT_STORAGE_STANDARD"`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"bytesUsed": 6543211234,`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"name": "BucketName"`))
 			})
 		})
 	})
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"strconv"
 
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
 	"github.com/spf13/cobra"
 	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
@@ -55,6 +54,9 @@ func (cmd *ObjectStoragePermissionCommand) Run(args []string) error {
 	if err != nil {
 		return slErr.NewAPIError(T("Failed to get endPoints."), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, map[string]interface{}{"messageDeliveryAccount": cloudObjectStorages, "endpoints": endPoints})
+	}
 
 	table := cmd.UI.Table([]string{
 		T("Name"),
@@ -64,7 +66,7 @@ func (cmd *ObjectStoragePermissionCommand) Run(args []string) error {
 	table.Add("UUID", utils.FormatStringPointer(cloudObjectStorages.Uuid))
 
 	bufTableCredentials := new(bytes.Buffer)
-	tableCredentials := terminal.NewTable(bufTableCredentials, []string{
+	tableCredentials := utils.NewTable(bufTableCredentials, []string{
 		T("Id"),
 		T("Access Key ID"),
 		T("Secret Access Key"),
@@ -80,11 +82,13 @@ func (cmd *ObjectStoragePermissionCommand) Run(args []string) error {
 		)
 	}
 
-	utils.PrintTable(cmd.UI, tableCredentials, outputFormat)
+	if err := utils.PrintTable(cmd.UI, tableCredentials, outputFormat); err != nil {
+		return err
+	}
 	table.Add("Credentials", bufTableCredentials.String())
 
 	bufTableEndPoints := new(bytes.Buffer)
-	tableEndPoints := terminal.NewTable(bufTableEndPoints, []string{
+	tableEndPoints := utils.NewTable(bufTableEndPoints, []string{
 		T("Region"),
 		T("Location"),
 		T("Type"),
@@ -100,9 +104,10 @@ func (cmd *ObjectStoragePermissionCommand) Run(args []string) error {
 		)
 	}
 
-	utils.PrintTable(cmd.UI, tableEndPoints, outputFormat)
+	if err := utils.PrintTable(cmd.UI, tableEndPoints, outputFormat); err != nil {
+		return err
+	}
 	table.Add("EndPoint URL's", bufTableEndPoints.String())
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
@@ -123,19 +123,11 @@ var _ = Describe("block object-storage-permission", func() {
 			It("Return object storage permission in json format", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "123", "--output=json")
 				Expect(err).NotTo(HaveOccurred())
-				Expect(fakeUI.Outputs()).To(ContainSubstring("["))
-				Expect(fakeUI.Outputs()).To(ContainSubstring("]"))
-				Expect(fakeUI.Outputs()).To(ContainSubstring("{"))
-				Expect(fakeUI.Outputs()).To(ContainSubstring("}"))
-				Expect(fakeUI.Outputs()).To(ContainSubstring("UUID"))
-				Expect(fakeUI.Outputs()).To(ContainSubstring("abc123"))
-				Expect(fakeUI.Outputs()).To(ContainSubstring("Credentials"))
-				Expect(fakeUI.Outputs()).To(ContainSubstring("Access Key ID"))
-				Expect(fakeUI.Outputs()).To(ContainSubstring("EndPoint URL's"))
-				Expect(fakeUI.Outputs()).To(ContainSubstring("Region"))
-				Expect(fakeUI.Outputs()).To(ContainSubstring("us-geo"))
-				Expect(fakeUI.Outputs()).To(ContainSubstring("Location"))
-				Expect(fakeUI.Outputs()).To(ContainSubstring("Dallas"))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"uuid": "abc123"`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"username": "credential"`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"password": "abc321",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"region": "us-geo",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"location": "Dallas",`))
 			})
 		})
 	})
@@ -5,6 +5,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/cmdutils"
 	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -15,6 +16,7 @@ type SnapshotRestoreCommand struct {
 	*metadata.SoftlayerStorageCommand
 	Command        *cobra.Command
 	StorageManager managers.StorageManager
+	Wait           cmdutils.WaitOptions
 }
 
 func NewSnapshotRestoreCommand(sl *metadata.SoftlayerStorageCommand) *SnapshotRestoreCommand {
@@ -35,6 +37,7 @@ EXAMPLE:
 		},
 	}
 	thisCmd.Command = cobraCmd
+	cmdutils.AddWaitFlags(cobraCmd, &thisCmd.Wait, T("the restore is finished"))
 	return thisCmd
 }
 
@@ -57,5 +60,12 @@ func (cmd *SnapshotRestoreCommand) Run(args []string) error {
 	}
 	cmd.UI.Ok()
 	cmd.UI.Print(T("Block volume {{.VolumeId}} is being restored using snapshot {{.SnapshotId}}.", subs))
+	if cmd.Wait.Enabled() {
+		err = cmdutils.WaitFor(cmd.SoftlayerCommand, cmd.Wait, T("volume {{.VolumeId}}", subs), managers.VolumeOperationCheck(cmd.StorageManager, cmd.StorageType, volumeID))
+		if err != nil {
+			return err
+		}
+		cmd.UI.Print(T("Volume {{.VolumeId}} was restored.", subs))
+	}
 	return nil
 }
@@ -53,6 +53,9 @@ func (cmd *SubnetsListCommand) Run(args []string) error {
 	if err != nil {
 		return slErr.NewAPIError(T("Failed to get subnets."), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, subnets)
+	}
 
 	table := cmd.UI.Table([]string{T("Id"), T("Network Identifier"), T("CIDR")})
 	for _, subnet := range subnets {
@@ -63,6 +66,5 @@ func (cmd *SubnetsListCommand) Run(args []string) error {
 		)
 	}
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
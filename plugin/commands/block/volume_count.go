@@ -44,7 +44,7 @@ func (cmd *VolumeCountCommand) Run(args []string) error {
 	sortby := cmd.SortBy
 	flag := false
 	mask := "mask[id,serviceResource.datacenter.name]"
-	volumes, err := cmd.StorageManager.ListVolumes(managers.VOLUME_TYPE_BLOCK, cmd.Datacenter, "", "", "", 0, mask)
+	volumes, err := cmd.StorageManager.ListVolumes(managers.VOLUME_TYPE_BLOCK, cmd.Datacenter, "", "", "", 0, mask, metadata.Paging{})
 	if err != nil {
 		return slErr.NewAPIError(T("Failed to list volumes on your account.\n"), err.Error(), 2)
 	}
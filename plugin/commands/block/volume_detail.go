@@ -6,10 +6,9 @@ import (
 	"net/url"
 	"strings"
 
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
-
 	"github.com/spf13/cobra"
 
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -36,10 +35,24 @@ func NewVolumeDetailCommand(sl *metadata.SoftlayerStorageCommand) *VolumeDetailC
 			return thisCmd.Run(args)
 		},
 	}
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "block_volume", thisCmd.completeIdentifiers)
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
+// The ID of every block volume, with its username as the description
+func (cmd *VolumeDetailCommand) completeIdentifiers() ([]string, error) {
+	volumes, err := cmd.StorageManager.ListVolumes(managers.VOLUME_TYPE_BLOCK, "", "", "", "", 0, "mask[id,username]", metadata.Paging{})
+	if err != nil {
+		return nil, err
+	}
+	completions := []string{}
+	for _, volume := range volumes {
+		completions = append(completions, fmt.Sprintf("%d\t%s", utils.IntPointertoInt(volume.Id), utils.StringPointertoString(volume.Username)))
+	}
+	return completions, nil
+}
+
 func (cmd *VolumeDetailCommand) Run(args []string) error {
 
 	volumeID, err := cmd.StorageManager.GetVolumeId(args[0], cmd.StorageType)
@@ -105,7 +118,7 @@ func (cmd *VolumeDetailCommand) Run(args []string) error {
 	if blockVolume.ReplicationPartnerCount != nil && int(*blockVolume.ReplicationPartnerCount) > 0 {
 		table.Add(T("Replication Status"), utils.FormatStringPointer(blockVolume.ReplicationStatus))
 		buf := new(bytes.Buffer)
-		repTable := terminal.NewTable(buf, []string{"", ""})
+		repTable := utils.NewTable(buf, []string{"", ""})
 		for _, replicant := range blockVolume.ReplicationPartners {
 			repTable.Add(T("Replicant ID"), utils.FormatIntPointer(replicant.Id))
 			repTable.Add(T("Volume Name"), utils.FormatStringPointer(replicant.Username))
@@ -123,7 +136,7 @@ func (cmd *VolumeDetailCommand) Run(args []string) error {
 
 	if blockVolume.OriginalVolumeSize != nil {
 		buf := new(bytes.Buffer)
-		dupTable := terminal.NewTable(buf, []string{"", ""})
+		dupTable := utils.NewTable(buf, []string{"", ""})
 		dupTable.Add(T("Original Volume Name"), utils.FormatStringPointer(blockVolume.OriginalVolumeName))
 		dupTable.Add(T("Original Volume Size"), utils.FormatStringPointer(blockVolume.OriginalVolumeSize))
 		dupTable.Add(T("Original Snapshot Name"), utils.FormatStringPointer(blockVolume.OriginalSnapshotName))
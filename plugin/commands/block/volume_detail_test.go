@@ -1,12 +1,17 @@
 package block_test
 
 import (
+	"os"
+
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
 
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/block"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
@@ -71,4 +76,25 @@ var _ = Describe("Block Volume-Detail Tests", func() {
 			})
 		})
 	})
+	Describe("Volume detail completion", func() {
+		var fakeStorageManager *testhelpers.FakeStorageManager
+		BeforeEach(func() {
+			os.Setenv(client.ENV_SL_CACHE_DIR, GinkgoT().TempDir())
+			fakeStorageManager = new(testhelpers.FakeStorageManager)
+			fakeStorageManager.ListVolumesReturns([]datatypes.Network_Storage{
+				{Id: sl.Int(1234), Username: sl.String("SL01SEL123-1")},
+			}, nil)
+			cliCommand.StorageManager = fakeStorageManager
+		})
+		AfterEach(func() {
+			os.Unsetenv(client.ENV_SL_CACHE_DIR)
+		})
+		It("Completes block volume IDs", func() {
+			completions, _ := cliCommand.Command.ValidArgsFunction(cliCommand.Command, []string{}, "12")
+			Expect(completions).To(Equal([]string{"1234\tSL01SEL123-1"}))
+			volumeType, _, _, _, _, _, mask, _ := fakeStorageManager.ListVolumesArgsForCall(0)
+			Expect(volumeType).To(Equal("block"))
+			Expect(mask).To(Equal("mask[id,username]"))
+		})
+	})
 })
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/cmdutils"
 	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -15,6 +16,7 @@ type VolumeDuplicateCommand struct {
 	*metadata.SoftlayerStorageCommand
 	Command               *cobra.Command
 	StorageManager        managers.StorageManager
+	OrderManager          managers.OrderManager
 	OriginSnapshotId      int
 	DuplicateSize         int
 	DuplicateIops         int
@@ -23,12 +25,14 @@ type VolumeDuplicateCommand struct {
 	DependentDuplicate    bool
 	Force                 bool
 	Billing               string
+	Wait                  cmdutils.WaitOptions
 }
 
 func NewVolumeDuplicateCommand(sl *metadata.SoftlayerStorageCommand) *VolumeDuplicateCommand {
 	thisCmd := &VolumeDuplicateCommand{
 		SoftlayerStorageCommand: sl,
 		StorageManager:          managers.NewStorageManager(sl.Session),
+		OrderManager:            managers.NewOrderManager(sl.Session),
 	}
 	cobraCmd := &cobra.Command{
 		Use:   "volume-duplicate " + T("IDENTIFIER"),
@@ -56,6 +60,7 @@ EXAMPLE:
 		T("   [default: False]"))
 	cobraCmd.Flags().BoolVarP(&thisCmd.Force, "force", "f", false, T("Force operation without confirmation"))
 	cobraCmd.Flags().StringVar(&thisCmd.Billing, "billing", "monthly", T("Optional parameter for Billing rate (default to monthly) Choices: hourly or monthly"))
+	cmdutils.AddWaitFlags(cobraCmd, &thisCmd.Wait, T("the duplicate volume is provisioned"))
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
@@ -103,19 +108,39 @@ func (cmd *VolumeDuplicateCommand) Run(args []string) error {
 		return slErr.NewAPIError(T("Failed to order duplicate volume from {{.VolumeID}}.Please verify your options and try again.\n", map[string]interface{}{"VolumeID": volumeID}), err.Error(), 2)
 	}
 
+	subs := map[string]interface{}{"OrderID": *orderReceipt.OrderId, "CommandName": "ibmcloud"}
 	if outputFormat == "JSON" {
+		err = cmd.waitForOrder(*orderReceipt.OrderId, subs)
+		if err != nil {
+			return err
+		}
 		return utils.PrintPrettyJSON(cmd.UI, orderReceipt)
 	}
 
 	cmd.UI.Ok()
-	cmd.UI.Print(T("Order {{.OrderID}} was placed.", map[string]interface{}{"OrderID": *orderReceipt.OrderId}))
+	cmd.UI.Print(T("Order {{.OrderID}} was placed.", subs))
 	for _, item := range orderReceipt.PlacedOrder.Items {
 		if item.Description != nil {
 			cmd.UI.Print(fmt.Sprintf(" > %s", *item.Description))
 			cmd.UI.Print("")
 		}
 	}
-	cmd.UI.Print(T("You may run '{{.CommandName}} sl block volume-list --order {{.OrderID}}' to find this block volume after it is ready.",
-		map[string]interface{}{"OrderID": *orderReceipt.OrderId, "CommandName": "ibmcloud"}))
+	if !cmd.Wait.Enabled() {
+		cmd.UI.Print(T("You may run '{{.CommandName}} sl block volume-list --order {{.OrderID}}' to find this block volume after it is ready.", subs))
+		return nil
+	}
+	err = cmd.waitForOrder(*orderReceipt.OrderId, subs)
+	if err != nil {
+		return err
+	}
+	cmd.UI.Print(T("Order {{.OrderID}} is ready. Run '{{.CommandName}} sl block volume-list --order {{.OrderID}}' to find this block volume.", subs))
 	return nil
 }
+
+// Waits for the duplicate volume to be provisioned when --wait is set
+func (cmd *VolumeDuplicateCommand) waitForOrder(orderId int, subs map[string]interface{}) error {
+	if !cmd.Wait.Enabled() {
+		return nil
+	}
+	return cmdutils.WaitFor(cmd.SoftlayerCommand, cmd.Wait, T("order {{.OrderID}}", subs), managers.OrderReadyCheck(cmd.OrderManager, orderId))
+}
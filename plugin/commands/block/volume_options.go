@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"strings"
 
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/spf13/cobra"
 
@@ -224,13 +223,13 @@ func getRegions(regionConflicts []datatypes.Product_Item_Resource_Conflict, data
 
 func getPrices(prices []datatypes.Product_Item_Price, tierLevel bool) string {
 	buf := new(bytes.Buffer)
-	tablePrices := terminal.NewTable(buf, []string{
+	tablePrices := utils.NewTable(buf, []string{
 		T("Id"),
 		T("Hourly/Monthly"),
 		T("Datacenters"),
 	})
 	if tierLevel {
-		tablePrices = terminal.NewTable(buf, []string{
+		tablePrices = utils.NewTable(buf, []string{
 			T("Id"),
 			T("Tier"),
 			T("Hourly/Monthly"),
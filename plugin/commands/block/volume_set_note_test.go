@@ -67,12 +67,12 @@ var _ = Describe("Block Volume Set Note", func() {
 
 		Context("Bad output format", func() {
 			BeforeEach(func() {
-				FakeStorageManager.VolumeSetNoteReturns(false, errors.New("Invalid output format, only JSON is supported now."))
+				FakeStorageManager.VolumeSetNoteReturns(false, errors.New("Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 			It("error resolving volume ID", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "1234", "--note=thisismynote", "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 		})
 
@@ -0,0 +1,28 @@
+package cache
+
+import (
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/plugin"
+	"github.com/spf13/cobra"
+
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+)
+
+func SetupCobraCommands(sl *metadata.SoftlayerCommand) *cobra.Command {
+	cobraCmd := &cobra.Command{
+		Use:   "cache",
+		Short: T("Manage the local API response cache"),
+		RunE:  nil,
+	}
+
+	cobraCmd.AddCommand(NewClearCommand(sl).Command)
+	return cobraCmd
+}
+
+func CacheNamespace() plugin.Namespace {
+	return plugin.Namespace{
+		ParentName:  "sl",
+		Name:        "cache",
+		Description: T("Manage the local API response cache"),
+	}
+}
@@ -0,0 +1,47 @@
+package cache
+
+import (
+	"github.com/spf13/cobra"
+
+	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+)
+
+type ClearCommand struct {
+	*metadata.SoftlayerCommand
+	CacheManager managers.CacheManager
+	Command      *cobra.Command
+}
+
+func NewClearCommand(sl *metadata.SoftlayerCommand) (cmd *ClearCommand) {
+	thisCmd := &ClearCommand{
+		SoftlayerCommand: sl,
+		CacheManager:     managers.NewCacheManager(sl.Session),
+	}
+
+	cobraCmd := &cobra.Command{
+		Use:   "clear",
+		Short: T("Remove all cached API responses."),
+		Long: T(`Catalog lookups (product packages, prices and locations) are cached when the SoftlayerCacheTTL plugin config or --cache-ttl is set.
+This removes everything in the cache, so the next command gets fresh data from the API.`),
+		Args: metadata.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return thisCmd.Run(args)
+		},
+	}
+
+	thisCmd.Command = cobraCmd
+	return thisCmd
+}
+
+func (cmd *ClearCommand) Run(args []string) error {
+	removed, err := cmd.CacheManager.ClearCache()
+	if err != nil {
+		return slErr.NewAPIError(T("Failed to clear the cache.\n"), err.Error(), 2)
+	}
+	cmd.UI.Ok()
+	cmd.UI.Print(T("Removed {{.COUNT}} cached responses.", map[string]interface{}{"COUNT": removed}))
+	return nil
+}
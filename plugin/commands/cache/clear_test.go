@@ -0,0 +1,59 @@
+package cache_test
+
+import (
+	"errors"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/session"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/cache"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
+)
+
+var _ = Describe("cache clear", func() {
+	var (
+		fakeUI           *terminal.FakeUI
+		cliCommand       *cache.ClearCommand
+		fakeSession      *session.Session
+		slCommand        *metadata.SoftlayerCommand
+		fakeCacheManager *testhelpers.FakeCacheManager
+	)
+	BeforeEach(func() {
+		fakeUI = terminal.NewFakeUI()
+		fakeSession = testhelpers.NewFakeSoftlayerSession(nil)
+		fakeCacheManager = new(testhelpers.FakeCacheManager)
+		slCommand = metadata.NewSoftlayerCommand(fakeUI, fakeSession)
+		cliCommand = cache.NewClearCommand(slCommand)
+		cliCommand.CacheManager = fakeCacheManager
+	})
+
+	Describe("cache clear", func() {
+		Context("Invalid Usage", func() {
+			It("Errors with arguments", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "123")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("invalid argument 123 for clear"))
+			})
+		})
+		Context("Clear the cache", func() {
+			It("Prints how many responses were removed", func() {
+				fakeCacheManager.ClearCacheReturns(4, nil)
+				err := testhelpers.RunCobraCommand(cliCommand.Command)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeCacheManager.ClearCacheCallCount()).To(Equal(1))
+				Expect(fakeUI.Outputs()).To(ContainSubstring("OK"))
+				Expect(fakeUI.Outputs()).To(ContainSubstring("Removed 4 cached responses."))
+			})
+			It("Returns an error when the cache can't be cleared", func() {
+				fakeCacheManager.ClearCacheReturns(0, errors.New("permission denied"))
+				err := testhelpers.RunCobraCommand(cliCommand.Command)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Failed to clear the cache."))
+				Expect(err.Error()).To(ContainSubstring("permission denied"))
+			})
+		})
+	})
+})
@@ -12,6 +12,7 @@ import (
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
 )
 
 type CallAPICommand struct {
@@ -21,8 +22,7 @@ type CallAPICommand struct {
 	Init           int
 	Mask           string
 	Parameters     string
-	Limit          int
-	Offset         int
+	Paging         metadata.Paging
 	Filter         string
 }
 
@@ -55,8 +55,7 @@ EXAMPLE:
 	cobraCmd.Flags().IntVar(&thisCmd.Init, "init", 0, T("Init parameter"))
 	cobraCmd.Flags().StringVar(&thisCmd.Mask, "mask", "", T("Object mask: use to limit fields returned"))
 	cobraCmd.Flags().StringVar(&thisCmd.Parameters, "parameters", "", T("Append parameters to web call"))
-	cobraCmd.Flags().IntVar(&thisCmd.Limit, "limit", 0, T("Result limit"))
-	cobraCmd.Flags().IntVar(&thisCmd.Offset, "offset", 0, T("Result offset"))
+	metadata.AddPagingFlags(cobraCmd, &thisCmd.Paging)
 	cobraCmd.Flags().StringVar(&thisCmd.Filter, "filter", "", T("Object filters"))
 
 	thisCmd.Command = cobraCmd
@@ -77,11 +76,12 @@ func (cmd *CallAPICommand) Run(args []string) error {
 	}
 	options.Mask = cmd.Mask
 
-	if cmd.Offset != 0 {
-		options.Offset = &cmd.Offset
+	// Without a result limit the API returns every result, which is what --all asks for
+	if cmd.Paging.Offset != 0 {
+		options.Offset = &cmd.Paging.Offset
 	}
-	if cmd.Limit != 0 {
-		options.Limit = &cmd.Limit
+	if cmd.Paging.Limit != 0 {
+		options.Limit = &cmd.Paging.Limit
 	}
 	if cmd.Filter != "" {
 		options.Filter = cmd.Filter
@@ -97,6 +97,15 @@ func (cmd *CallAPICommand) Run(args []string) error {
 		return nil
 	}
 
+	// --query and YAML/template output need the actual data, not just the JSON text
+	if !utils.StructuredOutput.IsPlainJSON() {
+		var data interface{}
+		err = json.Unmarshal(output, &data)
+		if err == nil {
+			return utils.PrintPrettyJSON(cmd.UI, data)
+		}
+	}
+
 	err = json.Indent(&out, output, "", "\t")
 	if err != nil {
 		_, err := cmd.UI.Writer().Write(output)
@@ -33,6 +33,7 @@ var _ = Describe("CallAPI test", func() {
 		slCommand = metadata.NewSoftlayerCommand(fakeUI, fakeSession)
 		cliCommand = callapi.NewCallAPICommand(slCommand)
 		cliCommand.Command.PersistentFlags().Var(cliCommand.OutputFlag, "output", "--output=JSON for json output.")
+		cliCommand.Command.PersistentFlags().Var(cliCommand.QueryFlag, "query", "JMESPath query")
 		cliCommand.CallAPIManager = fakeManager
 	})
 
@@ -66,6 +67,31 @@ var _ = Describe("CallAPI test", func() {
 			})
 		})
 
+		Context("CallAPI paging", func() {
+			BeforeEach(func() {
+				fakeManager.CallAPIReturns([]byte(`[]`), nil)
+			})
+			It("Sends --limit and --offset as the result limit", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "SoftLayer_Account", "getVirtualGuests", "--limit", "10", "--offset", "20")
+				Expect(err).NotTo(HaveOccurred())
+				_, _, options, _ := fakeManager.CallAPIArgsForCall(0)
+				Expect(*options.Limit).To(Equal(10))
+				Expect(*options.Offset).To(Equal(20))
+			})
+			It("Sends no result limit for --all", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "SoftLayer_Account", "getVirtualGuests", "--all")
+				Expect(err).NotTo(HaveOccurred())
+				_, _, options, _ := fakeManager.CallAPIArgsForCall(0)
+				Expect(options.Limit).To(BeNil())
+				Expect(options.Offset).To(BeNil())
+			})
+			It("Rejects a negative --limit", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "SoftLayer_Account", "getVirtualGuests", "--limit", "-5")
+				Expect(err).To(HaveOccurred())
+				Expect(fakeManager.CallAPICallCount()).To(Equal(0))
+			})
+		})
+
 		Context("CallAPI account getUsers", func() {
 			It("return users", func() {
 				response := `[
@@ -92,5 +118,26 @@ var _ = Describe("CallAPI test", func() {
 				Expect(fakeUI.Outputs()).To(ContainSubstring(`"accountId": 12345,`))
 			})
 		})
+		Context("CallAPI with --query", func() {
+			BeforeEach(func() {
+				response := `[{"id": 111, "username": "user1"}, {"id": 222, "username": "user2"}]`
+				fakeManager.CallAPIReturns([]byte(response), nil)
+			})
+			It("return only the ids", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "Account", "getUsers", "--query", "[].id")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeUI.Outputs()).To(Equal("[\n    111,\n    222\n]\n"))
+			})
+			It("return a single field as yaml", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "Account", "getUsers", "--query", "[?id==`222`].username | [0]", "--output", "yaml")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeUI.Outputs()).To(Equal("user2\n"))
+			})
+			It("return an error for a bad query", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "Account", "getUsers", "--query", "[?id==")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Invalid query"))
+			})
+		})
 	})
 })
@@ -0,0 +1,78 @@
+package completion
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+)
+
+// The plugin only runs through ibmcloud, so the scripts ask `ibmcloud sl` for completions instead of `sl`
+const completionRequestCommand = "ibmcloud sl"
+
+// How each shell script runs the command it completes, replaced with completionRequestCommand
+var shellRequestCommands = map[string]string{
+	"bash": "${words[0]} " + cobra.ShellCompRequestCmd,
+	"zsh":  "${words[1]} " + cobra.ShellCompRequestCmd,
+	"fish": "$args[1] " + cobra.ShellCompRequestCmd,
+}
+
+type CompletionCommand struct {
+	*metadata.SoftlayerCommand
+	Command *cobra.Command
+}
+
+func NewCompletionCommand(sl *metadata.SoftlayerCommand) *CompletionCommand {
+	thisCmd := &CompletionCommand{
+		SoftlayerCommand: sl,
+	}
+	cobraCmd := &cobra.Command{
+		Use:   "completion " + T("SHELL"),
+		Short: T("Print the shell completion script for bash, zsh or fish"),
+		Long: T(`${COMMAND_NAME} sl completion SHELL
+
+The script completes the 'sl' command, so it works together with an alias for '${COMMAND_NAME} sl'.
+Identifiers of virtual servers, hardware servers, block volumes and DNS zones are completed from your account.
+
+EXAMPLE:
+   alias sl='${COMMAND_NAME} sl'
+   source <(${COMMAND_NAME} sl completion bash)
+   Loads completion for the current bash session.
+   ${COMMAND_NAME} sl completion fish > ~/.config/fish/completions/sl.fish
+   Loads completion for every new fish session.`),
+		Args:      cobra.MatchAll(metadata.OneArgs, cobra.OnlyValidArgs),
+		ValidArgs: []string{"bash", "zsh", "fish"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return thisCmd.Run(args)
+		},
+	}
+	thisCmd.Command = cobraCmd
+	return thisCmd
+}
+
+func (cmd *CompletionCommand) Run(args []string) error {
+	shell := args[0]
+	root := cmd.Command.Root()
+	var script bytes.Buffer
+	var err error
+	switch shell {
+	case "bash":
+		err = root.GenBashCompletionV2(&script, true)
+	case "zsh":
+		err = root.GenZshCompletion(&script)
+	case "fish":
+		err = root.GenFishCompletion(&script, true)
+	default:
+		return slErr.NewInvalidUsageError(T("Shell {{.SHELL}} is not supported, use bash, zsh or fish.", map[string]interface{}{"SHELL": shell}))
+	}
+	if err != nil {
+		return slErr.New(T("Failed to create the completion script: {{.ERROR}}", map[string]interface{}{"ERROR": err.Error()}))
+	}
+	requestCommand := completionRequestCommand + " " + cobra.ShellCompRequestCmd
+	cmd.UI.Print(strings.ReplaceAll(script.String(), shellRequestCommands[shell], requestCommand))
+	return nil
+}
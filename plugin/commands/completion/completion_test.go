@@ -0,0 +1,72 @@
+package completion_test
+
+import (
+	"testing"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/spf13/cobra"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/completion"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
+)
+
+func TestManagers(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Completion Suite")
+}
+
+var _ = Describe("completion", func() {
+	var (
+		fakeUI      *terminal.FakeUI
+		cliCommand  *completion.CompletionCommand
+		fakeSession *session.Session
+		slCommand   *metadata.SoftlayerCommand
+		rootCmd     *cobra.Command
+	)
+	BeforeEach(func() {
+		fakeUI = terminal.NewFakeUI()
+		fakeSession = testhelpers.NewFakeSoftlayerSession(nil)
+		slCommand = metadata.NewSoftlayerCommand(fakeUI, fakeSession)
+		cliCommand = completion.NewCompletionCommand(slCommand)
+		// The scripts are made for the root command
+		rootCmd = &cobra.Command{Use: "sl"}
+		rootCmd.AddCommand(cliCommand.Command)
+	})
+
+	Context("Invalid Usage", func() {
+		It("Requires a shell", func() {
+			err := testhelpers.RunCobraCommand(rootCmd, "completion")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("This command requires one argument"))
+		})
+		It("Only supports bash, zsh and fish", func() {
+			err := testhelpers.RunCobraCommand(rootCmd, "completion", "powershell")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`invalid argument "powershell"`))
+		})
+	})
+	Context("Completion scripts", func() {
+		It("Prints a bash script", func() {
+			err := testhelpers.RunCobraCommand(rootCmd, "completion", "bash")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(ContainSubstring("complete -o default -F __start_sl sl"))
+			Expect(fakeUI.Outputs()).To(ContainSubstring(`requestComp="ibmcloud sl __complete ${args[*]}"`))
+		})
+		It("Prints a zsh script", func() {
+			err := testhelpers.RunCobraCommand(rootCmd, "completion", "zsh")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(ContainSubstring("#compdef sl"))
+			Expect(fakeUI.Outputs()).To(ContainSubstring(`requestComp="ibmcloud sl __complete ${words[2,-1]}"`))
+		})
+		It("Prints a fish script", func() {
+			err := testhelpers.RunCobraCommand(rootCmd, "completion", "fish")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(ContainSubstring("complete -c sl"))
+			Expect(fakeUI.Outputs()).To(ContainSubstring("ibmcloud sl __complete $args[2..-1]"))
+		})
+	})
+})
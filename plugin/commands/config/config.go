@@ -0,0 +1,31 @@
+package config
+
+import (
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/plugin"
+	"github.com/spf13/cobra"
+
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+)
+
+func SetupCobraCommands(sl *metadata.SoftlayerCommand) *cobra.Command {
+	cobraCmd := &cobra.Command{
+		Use:   "config",
+		Short: T("Manage profiles for Classic infrastructure accounts and endpoints"),
+		RunE:  nil,
+	}
+
+	cobraCmd.AddCommand(NewProfileCreateCommand(sl).Command)
+	cobraCmd.AddCommand(NewProfileUseCommand(sl).Command)
+	cobraCmd.AddCommand(NewProfileListCommand(sl).Command)
+	cobraCmd.AddCommand(NewProfileShowCommand(sl).Command)
+	return cobraCmd
+}
+
+func ConfigNamespace() plugin.Namespace {
+	return plugin.Namespace{
+		ParentName:  "sl",
+		Name:        "config",
+		Description: T("Manage profiles for Classic infrastructure accounts and endpoints"),
+	}
+}
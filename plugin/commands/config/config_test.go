@@ -0,0 +1,67 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/config"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
+)
+
+func TestManagers(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Config Suite")
+}
+
+var availableCommands = []string{
+	"profile-create",
+	"profile-list",
+	"profile-show",
+	"profile-use",
+}
+
+// This test suite exists to make sure commands don't get accidently removed from the SetupCobraCommands
+var _ = Describe("Test config commands", func() {
+	fakeUI := terminal.NewFakeUI()
+	fakeSession := testhelpers.NewFakeSoftlayerSession(nil)
+	slMeta := metadata.NewSoftlayerCommand(fakeUI, fakeSession)
+
+	Context("New commands testable", func() {
+		commands := config.SetupCobraCommands(slMeta)
+
+		var arrayCommands = []string{}
+		for _, command := range commands.Commands() {
+			commandName := command.Name()
+			arrayCommands = append(arrayCommands, commandName)
+			It("available commands "+commands.Name(), func() {
+				available := false
+				if utils.StringInSlice(commandName, availableCommands) != -1 {
+					available = true
+				}
+				Expect(available).To(BeTrue(), commandName+" not found in array available Commands")
+			})
+		}
+		for _, command := range availableCommands {
+			commandName := command
+			It("ibmcloud sl "+commands.Name(), func() {
+				available := false
+				if utils.StringInSlice(commandName, arrayCommands) != -1 {
+					available = true
+				}
+				Expect(available).To(BeTrue(), commandName+" not found in ibmcloud sl "+commands.Name())
+			})
+		}
+	})
+
+	Context("Config Namespace", func() {
+		It("Config Name Space", func() {
+			Expect(config.ConfigNamespace().ParentName).To(ContainSubstring("sl"))
+			Expect(config.ConfigNamespace().Name).To(ContainSubstring("config"))
+			Expect(config.ConfigNamespace().Description).To(ContainSubstring("Manage profiles"))
+		})
+	})
+})
@@ -0,0 +1,85 @@
+package config
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+)
+
+type ProfileCreateCommand struct {
+	*metadata.SoftlayerCommand
+	ProfileManager managers.ProfileManager
+	Command        *cobra.Command
+	Endpoint       string
+	Datacenter     string
+	Output         string
+	Username       string
+	APIKeyEnv      string
+	Force          bool
+}
+
+func NewProfileCreateCommand(sl *metadata.SoftlayerCommand) (cmd *ProfileCreateCommand) {
+	thisCmd := &ProfileCreateCommand{
+		SoftlayerCommand: sl,
+		ProfileManager:   managers.NewProfileManager(sl.Session),
+	}
+
+	cobraCmd := &cobra.Command{
+		Use:   "profile-create " + T("NAME"),
+		Short: T("Create a profile with an endpoint, default datacenter, output format and API key."),
+		Long: T(`Profiles are picked with '${COMMAND_NAME} sl config profile-use NAME', or for a single command with --profile NAME or the SL_PROFILE environment variable.
+Settings that are left out fall back to the plugin config, and the IAM token of the current login is used unless --username is given.
+With --username the profile uses the classic infrastructure API key of that user. The key is not saved with the profile, every command reads it from the SL_API_KEY environment variable, or the one --api-key-env names.`),
+		Args: metadata.OneArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return thisCmd.Run(args)
+		},
+	}
+	cobraCmd.Flags().StringVar(&thisCmd.Endpoint, "endpoint", "", T("API endpoint, for example https://api.service.softlayer.com/rest/v3.1"))
+	cobraCmd.Flags().StringVarP(&thisCmd.Datacenter, "datacenter", "d", "", T("Datacenter shortname used by create and order commands when --datacenter is not given"))
+	cobraCmd.Flags().StringVar(&thisCmd.Output, "output-format", "", T("Output format used when --output is not given: JSON, CSV, YAML or template=<GO TEMPLATE>"))
+	cobraCmd.Flags().StringVarP(&thisCmd.Username, "username", "u", "", T("Classic infrastructure username, for API key authentication"))
+	cobraCmd.Flags().StringVar(&thisCmd.APIKeyEnv, "api-key-env", "", T("Environment variable with the API key of --username. Default: SL_API_KEY"))
+	cobraCmd.Flags().BoolVarP(&thisCmd.Force, "force", "f", false, T("Replace the profile if it already exists"))
+
+	thisCmd.Command = cobraCmd
+	return thisCmd
+}
+
+func (cmd *ProfileCreateCommand) Run(args []string) error {
+	name := args[0]
+	subs := map[string]interface{}{"NAME": name}
+	if cmd.APIKeyEnv != "" && cmd.Username == "" {
+		return slErr.NewInvalidUsageError(T("--api-key-env can only be used with --username."))
+	}
+	if cmd.Output != "" && !metadata.IsValidOutputFormat(cmd.Output) {
+		return slErr.NewInvalidUsageError(T("Invalid output format, supported formats are: {{.FORMATS}}",
+			map[string]interface{}{"FORMATS": "JSON, CSV, YAML, template=<TEMPLATE>"}))
+	}
+	if !cmd.Force {
+		_, err := cmd.ProfileManager.GetProfile(name)
+		if err == nil {
+			return slErr.NewInvalidUsageError(T("Profile {{.NAME}} already exists, use --force to replace it.", subs))
+		}
+	}
+
+	profile := client.Profile{
+		Name:       name,
+		Endpoint:   cmd.Endpoint,
+		Datacenter: cmd.Datacenter,
+		Output:     cmd.Output,
+		Username:   cmd.Username,
+		APIKeyEnv:  cmd.APIKeyEnv,
+	}
+	err := cmd.ProfileManager.SaveProfile(profile)
+	if err != nil {
+		return slErr.NewAPIError(T("Failed to save profile {{.NAME}}.\n", subs), err.Error(), 2)
+	}
+	cmd.UI.Ok()
+	cmd.UI.Print(T("Profile {{.NAME}} was created.", subs))
+	return nil
+}
@@ -0,0 +1,94 @@
+package config_test
+
+import (
+	"errors"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/session"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/config"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
+)
+
+var _ = Describe("config profile-create", func() {
+	var (
+		fakeUI             *terminal.FakeUI
+		cliCommand         *config.ProfileCreateCommand
+		fakeSession        *session.Session
+		slCommand          *metadata.SoftlayerCommand
+		fakeProfileManager *testhelpers.FakeProfileManager
+	)
+	BeforeEach(func() {
+		fakeUI = terminal.NewFakeUI()
+		fakeSession = testhelpers.NewFakeSoftlayerSession(nil)
+		fakeProfileManager = new(testhelpers.FakeProfileManager)
+		fakeProfileManager.GetProfileReturns(client.Profile{}, errors.New("Profile prod does not exist."))
+		slCommand = metadata.NewSoftlayerCommand(fakeUI, fakeSession)
+		cliCommand = config.NewProfileCreateCommand(slCommand)
+		cliCommand.ProfileManager = fakeProfileManager
+	})
+
+	Context("Invalid Usage", func() {
+		It("Requires a name", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("This command requires one argument"))
+		})
+		It("Requires --username with --api-key-env", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "prod", "--api-key-env", "PROD_API_KEY")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("--api-key-env can only be used with --username."))
+		})
+		It("Doesn't take the API key on the command line", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "prod", "--username", "user", "--api-key", "secret")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unknown flag: --api-key"))
+		})
+		It("Checks the output format", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "prod", "--output-format", "xml")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Invalid output format"))
+		})
+		It("Does not replace a profile without --force", func() {
+			fakeProfileManager.GetProfileReturns(client.Profile{Name: "prod"}, nil)
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "prod")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Profile prod already exists, use --force to replace it."))
+			Expect(fakeProfileManager.SaveProfileCallCount()).To(Equal(0))
+		})
+	})
+	Context("Create a profile", func() {
+		It("Saves every setting", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "prod", "--endpoint", "https://api.service.softlayer.com/rest/v3.1",
+				"-d", "dal13", "--output-format", "yaml", "-u", "user", "--api-key-env", "PROD_API_KEY")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(ContainSubstring("Profile prod was created."))
+			profile := fakeProfileManager.SaveProfileArgsForCall(0)
+			Expect(profile).To(Equal(client.Profile{
+				Name:       "prod",
+				Endpoint:   "https://api.service.softlayer.com/rest/v3.1",
+				Datacenter: "dal13",
+				Output:     "yaml",
+				Username:   "user",
+				APIKeyEnv:  "PROD_API_KEY",
+			}))
+		})
+		It("Replaces a profile with --force", func() {
+			fakeProfileManager.GetProfileReturns(client.Profile{Name: "prod"}, nil)
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "prod", "--force", "-d", "ams01")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeProfileManager.SaveProfileArgsForCall(0).Datacenter).To(Equal("ams01"))
+		})
+		It("Returns an error when the profile can't be saved", func() {
+			fakeProfileManager.SaveProfileReturns(errors.New("config is read only"))
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "prod")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Failed to save profile prod."))
+			Expect(err.Error()).To(ContainSubstring("config is read only"))
+		})
+	})
+})
@@ -0,0 +1,92 @@
+package config
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
+)
+
+type ProfileListCommand struct {
+	*metadata.SoftlayerCommand
+	ProfileManager managers.ProfileManager
+	Command        *cobra.Command
+}
+
+func NewProfileListCommand(sl *metadata.SoftlayerCommand) (cmd *ProfileListCommand) {
+	thisCmd := &ProfileListCommand{
+		SoftlayerCommand: sl,
+		ProfileManager:   managers.NewProfileManager(sl.Session),
+	}
+
+	cobraCmd := &cobra.Command{
+		Use:   "profile-list",
+		Short: T("List profiles, the profile in use is marked with *."),
+		Args:  metadata.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return thisCmd.Run(args)
+		},
+	}
+
+	thisCmd.Command = cobraCmd
+	return thisCmd
+}
+
+func (cmd *ProfileListCommand) Run(args []string) error {
+	outputFormat := cmd.GetOutputFlag()
+
+	profiles, err := cmd.ProfileManager.ListProfiles()
+	if err != nil {
+		return slErr.NewAPIError(T("Failed to get profiles.\n"), err.Error(), 2)
+	}
+	active, err := cmd.ProfileManager.GetActiveProfile()
+	if err != nil {
+		return slErr.NewAPIError(T("Failed to get profiles.\n"), err.Error(), 2)
+	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, profiles)
+	}
+
+	table := cmd.UI.Table([]string{
+		T("Active"),
+		T("Name"),
+		T("Endpoint"),
+		T("Datacenter"),
+		T("Output"),
+		T("Authentication"),
+	})
+	for _, profile := range profiles {
+		activeMark := ""
+		if profile.Name == active {
+			activeMark = "*"
+		}
+		table.Add(
+			activeMark,
+			profile.Name,
+			valueOrEmpty(profile.Endpoint),
+			valueOrEmpty(profile.Datacenter),
+			valueOrEmpty(profile.Output),
+			authenticationName(profile),
+		)
+	}
+	return utils.PrintTable(cmd.UI, table, outputFormat)
+}
+
+func authenticationName(profile client.Profile) string {
+	if profile.HasAPIKey() {
+		subs := map[string]interface{}{"USERNAME": profile.Username, "ENV": profile.GetAPIKeyEnv()}
+		return T("API key of {{.USERNAME}} from {{.ENV}}", subs)
+	}
+	return T("IAM token")
+}
+
+func valueOrEmpty(value string) string {
+	if value == "" {
+		return utils.EMPTY_VALUE
+	}
+	return value
+}
@@ -0,0 +1,59 @@
+package config_test
+
+import (
+	"errors"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/session"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/config"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
+)
+
+var _ = Describe("config profile-list", func() {
+	var (
+		fakeUI             *terminal.FakeUI
+		cliCommand         *config.ProfileListCommand
+		fakeSession        *session.Session
+		slCommand          *metadata.SoftlayerCommand
+		fakeProfileManager *testhelpers.FakeProfileManager
+	)
+	BeforeEach(func() {
+		fakeUI = terminal.NewFakeUI()
+		fakeSession = testhelpers.NewFakeSoftlayerSession(nil)
+		fakeProfileManager = new(testhelpers.FakeProfileManager)
+		fakeProfileManager.ListProfilesReturns([]client.Profile{
+			{Name: "dev", Username: "devuser"},
+			{Name: "prod", Endpoint: "https://api.service.softlayer.com/rest/v3.1", Datacenter: "dal13", Output: "yaml"},
+		}, nil)
+		fakeProfileManager.GetActiveProfileReturns("prod", nil)
+		slCommand = metadata.NewSoftlayerCommand(fakeUI, fakeSession)
+		cliCommand = config.NewProfileListCommand(slCommand)
+		cliCommand.Command.PersistentFlags().Var(cliCommand.OutputFlag, "output", "--output=JSON for json output.")
+		cliCommand.ProfileManager = fakeProfileManager
+	})
+
+	It("Lists profiles", func() {
+		err := testhelpers.RunCobraCommand(cliCommand.Command)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeUI.Outputs()).To(ContainSubstring("API key of devuser from SL_API_KEY"))
+		Expect(fakeUI.Outputs()).To(ContainSubstring("IAM token"))
+		Expect(fakeUI.Outputs()).To(MatchRegexp(`\*\s+prod\s+https://api.service.softlayer.com/rest/v3.1\s+dal13\s+yaml`))
+	})
+	It("Lists profiles as JSON", func() {
+		err := testhelpers.RunCobraCommand(cliCommand.Command, "--output=JSON")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeUI.Outputs()).To(ContainSubstring(`"name": "dev"`))
+		Expect(fakeUI.Outputs()).To(ContainSubstring(`"username": "devuser"`))
+	})
+	It("Returns an error when profiles can't be read", func() {
+		fakeProfileManager.ListProfilesReturns(nil, errors.New("bad config"))
+		err := testhelpers.RunCobraCommand(cliCommand.Command)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Failed to get profiles."))
+	})
+})
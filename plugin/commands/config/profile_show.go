@@ -0,0 +1,73 @@
+package config
+
+import (
+	"github.com/spf13/cobra"
+
+	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
+)
+
+type ProfileShowCommand struct {
+	*metadata.SoftlayerCommand
+	ProfileManager managers.ProfileManager
+	Command        *cobra.Command
+}
+
+func NewProfileShowCommand(sl *metadata.SoftlayerCommand) (cmd *ProfileShowCommand) {
+	thisCmd := &ProfileShowCommand{
+		SoftlayerCommand: sl,
+		ProfileManager:   managers.NewProfileManager(sl.Session),
+	}
+
+	cobraCmd := &cobra.Command{
+		Use:   "profile-show [" + T("NAME") + "]",
+		Short: T("Show the settings of a profile, the profile in use when NAME is not given."),
+		Args:  metadata.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return thisCmd.Run(args)
+		},
+	}
+
+	thisCmd.Command = cobraCmd
+	return thisCmd
+}
+
+func (cmd *ProfileShowCommand) Run(args []string) error {
+	outputFormat := cmd.GetOutputFlag()
+
+	name := ""
+	if len(args) > 0 {
+		name = args[0]
+	} else {
+		active, err := cmd.ProfileManager.GetActiveProfile()
+		if err != nil {
+			return slErr.NewAPIError(T("Failed to get profiles.\n"), err.Error(), 2)
+		}
+		if active == "" {
+			return slErr.NewInvalidUsageError(T("No profile is in use, give a profile NAME or run '${COMMAND_NAME} sl config profile-use NAME'."))
+		}
+		name = active
+	}
+	subs := map[string]interface{}{"NAME": name}
+	profile, err := cmd.ProfileManager.GetProfile(name)
+	if err != nil {
+		return slErr.NewAPIError(T("Failed to get profile {{.NAME}}.\n", subs), err.Error(), 2)
+	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, profile)
+	}
+
+	table := cmd.UI.Table([]string{
+		T("Name"),
+		T("Value"),
+	})
+	table.Add(T("Name"), profile.Name)
+	table.Add(T("Endpoint"), valueOrEmpty(profile.Endpoint))
+	table.Add(T("Datacenter"), valueOrEmpty(profile.Datacenter))
+	table.Add(T("Output"), valueOrEmpty(profile.Output))
+	table.Add(T("Authentication"), authenticationName(profile))
+	return utils.PrintTable(cmd.UI, table, outputFormat)
+}
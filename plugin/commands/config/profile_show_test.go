@@ -0,0 +1,62 @@
+package config_test
+
+import (
+	"errors"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/session"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/config"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
+)
+
+var _ = Describe("config profile-show", func() {
+	var (
+		fakeUI             *terminal.FakeUI
+		cliCommand         *config.ProfileShowCommand
+		fakeSession        *session.Session
+		slCommand          *metadata.SoftlayerCommand
+		fakeProfileManager *testhelpers.FakeProfileManager
+	)
+	BeforeEach(func() {
+		fakeUI = terminal.NewFakeUI()
+		fakeSession = testhelpers.NewFakeSoftlayerSession(nil)
+		fakeProfileManager = new(testhelpers.FakeProfileManager)
+		fakeProfileManager.GetProfileReturns(client.Profile{Name: "dev", Datacenter: "ams01", Username: "devuser", APIKeyEnv: "DEV_API_KEY"}, nil)
+		slCommand = metadata.NewSoftlayerCommand(fakeUI, fakeSession)
+		cliCommand = config.NewProfileShowCommand(slCommand)
+		cliCommand.Command.PersistentFlags().Var(cliCommand.OutputFlag, "output", "--output=JSON for json output.")
+		cliCommand.ProfileManager = fakeProfileManager
+	})
+
+	It("Shows a profile", func() {
+		err := testhelpers.RunCobraCommand(cliCommand.Command, "dev")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeProfileManager.GetProfileArgsForCall(0)).To(Equal("dev"))
+		Expect(fakeUI.Outputs()).To(ContainSubstring("ams01"))
+		Expect(fakeUI.Outputs()).To(ContainSubstring("API key of devuser from DEV_API_KEY"))
+	})
+	It("Shows the profile in use", func() {
+		fakeProfileManager.GetActiveProfileReturns("dev", nil)
+		err := testhelpers.RunCobraCommand(cliCommand.Command, "--output=JSON")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeProfileManager.GetProfileArgsForCall(0)).To(Equal("dev"))
+		Expect(fakeUI.Outputs()).To(ContainSubstring(`"datacenter": "ams01"`))
+		Expect(fakeUI.Outputs()).To(ContainSubstring(`"apiKeyEnv": "DEV_API_KEY"`))
+	})
+	It("Errors when no profile is in use", func() {
+		err := testhelpers.RunCobraCommand(cliCommand.Command)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("No profile is in use"))
+	})
+	It("Errors on a missing profile", func() {
+		fakeProfileManager.GetProfileReturns(client.Profile{}, errors.New("Profile nope does not exist."))
+		err := testhelpers.RunCobraCommand(cliCommand.Command, "nope")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Failed to get profile nope."))
+	})
+})
@@ -0,0 +1,64 @@
+package config
+
+import (
+	"github.com/spf13/cobra"
+
+	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+)
+
+type ProfileUseCommand struct {
+	*metadata.SoftlayerCommand
+	ProfileManager managers.ProfileManager
+	Command        *cobra.Command
+	None           bool
+}
+
+func NewProfileUseCommand(sl *metadata.SoftlayerCommand) (cmd *ProfileUseCommand) {
+	thisCmd := &ProfileUseCommand{
+		SoftlayerCommand: sl,
+		ProfileManager:   managers.NewProfileManager(sl.Session),
+	}
+
+	cobraCmd := &cobra.Command{
+		Use:   "profile-use [" + T("NAME") + "]",
+		Short: T("Use a profile for every command that doesn't pick one with --profile or SL_PROFILE."),
+		Args:  metadata.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return thisCmd.Run(args)
+		},
+	}
+	cobraCmd.Flags().BoolVar(&thisCmd.None, "none", false, T("Stop using a profile, commands go back to the settings of the plugin config and the IAM login"))
+
+	thisCmd.Command = cobraCmd
+	return thisCmd
+}
+
+func (cmd *ProfileUseCommand) Run(args []string) error {
+	if cmd.None {
+		if len(args) > 0 {
+			return slErr.NewInvalidUsageError(T("A profile NAME can't be used with --none."))
+		}
+		err := cmd.ProfileManager.SetActiveProfile("")
+		if err != nil {
+			return slErr.NewAPIError(T("Failed to stop using a profile.\n"), err.Error(), 2)
+		}
+		cmd.UI.Ok()
+		cmd.UI.Print(T("No profile is used anymore."))
+		return nil
+	}
+	if len(args) == 0 {
+		return slErr.NewInvalidUsageError(T("This command requires one argument."))
+	}
+	name := args[0]
+	subs := map[string]interface{}{"NAME": name}
+	err := cmd.ProfileManager.SetActiveProfile(name)
+	if err != nil {
+		return slErr.NewAPIError(T("Failed to use profile {{.NAME}}.\n", subs), err.Error(), 2)
+	}
+	cmd.UI.Ok()
+	cmd.UI.Print(T("Now using profile {{.NAME}}.", subs))
+	return nil
+}
@@ -0,0 +1,63 @@
+package config_test
+
+import (
+	"errors"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/session"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/config"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
+)
+
+var _ = Describe("config profile-use", func() {
+	var (
+		fakeUI             *terminal.FakeUI
+		cliCommand         *config.ProfileUseCommand
+		fakeSession        *session.Session
+		slCommand          *metadata.SoftlayerCommand
+		fakeProfileManager *testhelpers.FakeProfileManager
+	)
+	BeforeEach(func() {
+		fakeUI = terminal.NewFakeUI()
+		fakeSession = testhelpers.NewFakeSoftlayerSession(nil)
+		fakeProfileManager = new(testhelpers.FakeProfileManager)
+		slCommand = metadata.NewSoftlayerCommand(fakeUI, fakeSession)
+		cliCommand = config.NewProfileUseCommand(slCommand)
+		cliCommand.ProfileManager = fakeProfileManager
+	})
+
+	It("Requires a name", func() {
+		err := testhelpers.RunCobraCommand(cliCommand.Command)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("This command requires one argument."))
+	})
+	It("Uses the profile", func() {
+		err := testhelpers.RunCobraCommand(cliCommand.Command, "prod")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeProfileManager.SetActiveProfileArgsForCall(0)).To(Equal("prod"))
+		Expect(fakeUI.Outputs()).To(ContainSubstring("Now using profile prod."))
+	})
+	It("Stops using a profile with --none", func() {
+		err := testhelpers.RunCobraCommand(cliCommand.Command, "--none")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeProfileManager.SetActiveProfileArgsForCall(0)).To(Equal(""))
+		Expect(fakeUI.Outputs()).To(ContainSubstring("No profile is used anymore."))
+	})
+	It("Errors on a name with --none", func() {
+		err := testhelpers.RunCobraCommand(cliCommand.Command, "prod", "--none")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("A profile NAME can't be used with --none."))
+		Expect(fakeProfileManager.SetActiveProfileCallCount()).To(Equal(0))
+	})
+	It("Errors on a missing profile", func() {
+		fakeProfileManager.SetActiveProfileReturns(errors.New("Profile nope does not exist."))
+		err := testhelpers.RunCobraCommand(cliCommand.Command, "nope")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Failed to use profile nope."))
+		Expect(err.Error()).To(ContainSubstring("Profile nope does not exist."))
+	})
+})
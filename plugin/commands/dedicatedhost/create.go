@@ -41,6 +41,7 @@ func NewCreateCommand(sl *metadata.SoftlayerCommand) *CreateCommand {
 	cobraCmd.Flags().StringVarP(&thisCmd.Hostname, "hostname", "H", "", T("Host portion of the FQDN [required]"))
 	cobraCmd.Flags().StringVarP(&thisCmd.Domain, "domain", "D", "", T("Domain portion of the FQDN [required]"))
 	cobraCmd.Flags().StringVarP(&thisCmd.Datacenter, "datacenter", "d", "", T("Datacenter shortname [required]"))
+	metadata.UseProfileDatacenter(cobraCmd, "datacenter")
 	cobraCmd.Flags().StringVarP(&thisCmd.Size, "size", "s", "", T("Size of the dedicated host, currently only one size is available: 56_CORES_X_242_RAM_X_1_4_TB"))
 	cobraCmd.Flags().StringVarP(&thisCmd.Billing, "billing", "b", "", T("Billing rate. Default is: hourly. Options are: hourly, monthly"))
 	cobraCmd.Flags().IntVarP(&thisCmd.VlanPrivate, "vlan-private", "v", 0, T("The ID of the private VLAN on which you want the dedicated host placed. See: '${COMMAND_NAME} sl vlan list' for reference"))
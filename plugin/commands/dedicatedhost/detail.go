@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"fmt"
 
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/spf13/cobra"
 	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
@@ -54,6 +53,12 @@ func (cmd *DetailCommand) Run(args []string) error {
 	if err != nil {
 		return slErr.NewAPIError(T("Failed to get dedicatedhost instance: {{.HostID}}.", map[string]interface{}{"HostID": hostID}), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		if !cmd.Guests {
+			dedicatedhost.Guests = nil
+		}
+		return utils.PrintPrettyJSON(cmd.UI, dedicatedhost)
+	}
 
 	table := cmd.UI.Table([]string{T("Name"), T("Value")})
 	table.Add(T("ID"), utils.FormatIntPointer(dedicatedhost.Id))
@@ -102,7 +107,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 	if cmd.Guests {
 		if dedicatedhost.Guests != nil {
 			buf := new(bytes.Buffer)
-			guestTable := terminal.NewTable(buf, []string{T("Id"), T("Hostname"), T("Domain"), T("uuid")})
+			guestTable := utils.NewTable(buf, []string{T("Id"), T("Hostname"), T("Domain"), T("uuid")})
 			for _, guest := range dedicatedhost.Guests {
 				guestTable.Add(utils.FormatIntPointer(guest.Id), utils.FormatStringPointer(guest.Hostname), utils.FormatStringPointer(guest.Domain), utils.FormatStringPointer(guest.Uuid))
 			}
@@ -111,6 +116,5 @@ func (cmd *DetailCommand) Run(args []string) error {
 		}
 	}
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
@@ -34,6 +34,7 @@ type tableRow struct {
 	Memory     string
 	Disk       string
 	Guests     string
+	Host       datatypes.Virtual_DedicatedHost
 }
 
 func NewListCommand(sl *metadata.SoftlayerCommand) (cmd *ListCommand) {
@@ -103,6 +104,14 @@ func (cmd *ListCommand) Run(args []string) error {
 		sort.Sort(ById(tableRows))
 	}
 
+	if outputFormat == "JSON" {
+		sortedHosts := []datatypes.Virtual_DedicatedHost{}
+		for _, row := range tableRows {
+			sortedHosts = append(sortedHosts, row.Host)
+		}
+		return utils.PrintPrettyJSON(cmd.UI, sortedHosts)
+	}
+
 	table := cmd.UI.Table([]string{T("Id"), T("Name"), T("Datacenter"), T("Router"), T("Cpu (allocated/total)"), T("Memory (allocated/total)"), T("Disk (allocated/total)"), T("Guests")})
 	for _, row := range tableRows {
 		table.Add(
@@ -117,8 +126,7 @@ func (cmd *ListCommand) Run(args []string) error {
 		)
 	}
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
 
 func getTableRows(hosts []datatypes.Virtual_DedicatedHost) []tableRow {
@@ -134,6 +142,7 @@ func getTableRows(hosts []datatypes.Virtual_DedicatedHost) []tableRow {
 			Memory:     utils.FormatIntPointer(host.AllocationStatus.MemoryAllocated) + "/" + utils.FormatIntPointer(host.AllocationStatus.MemoryCapacity),
 			Disk:       utils.FormatIntPointer(host.AllocationStatus.DiskAllocated) + "/" + utils.FormatIntPointer(host.AllocationStatus.DiskCapacity),
 			Guests:     utils.FormatUIntPointer(host.GuestCount),
+			Host:       host,
 		}
 		tableRows = append(tableRows, row)
 	}
@@ -117,6 +117,10 @@ func (cmd *ListGuestsCommand) Run(args []string) error {
 		return slErr.NewInvalidUsageError(T("--sortby '{{.Column}}' is not supported.", map[string]interface{}{"Column": sortby}))
 	}
 
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, guests)
+	}
+
 	table := cmd.UI.Table(utils.GetColumnHeader(showColumns))
 	for _, vm := range guests {
 		values := make(map[string]string)
@@ -148,7 +152,9 @@ func (cmd *ListGuestsCommand) Run(args []string) error {
 		}
 		table.Add(row...)
 	}
-	utils.PrintTable(cmd.UI, table, outputFormat)
+	if err := utils.PrintTable(cmd.UI, table, outputFormat); err != nil {
+		return err
+	}
 
 	return nil
 }
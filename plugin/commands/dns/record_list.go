@@ -1,9 +1,11 @@
 package dns
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -43,10 +45,24 @@ EXAMPLE:
 	cobraCmd.Flags().StringVar(&thisCmd.Record, "record", "", T("Filter by host record, such as www"))
 	cobraCmd.Flags().IntVar(&thisCmd.Ttl, "ttl", 0, T("Filter by TTL(Time-To-Live) in seconds, such as 86400"))
 	cobraCmd.Flags().StringVar(&thisCmd.Type, "type", "", T("Filter by record type, such as A or CNAME"))
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "dns_zone", thisCmd.completeZones)
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
+// The name of every zone, the command takes names rather than IDs so the ID is the description
+func (cmd *RecordListCommand) completeZones() ([]string, error) {
+	zones, err := cmd.DNSManager.ListZones()
+	if err != nil {
+		return nil, err
+	}
+	completions := []string{}
+	for _, zone := range zones {
+		completions = append(completions, fmt.Sprintf("%s\t%d", utils.StringPointertoString(zone.Name), utils.IntPointertoInt(zone.Id)))
+	}
+	return completions, nil
+}
+
 func (cmd *RecordListCommand) Run(args []string) error {
 	zoneName := args[0]
 
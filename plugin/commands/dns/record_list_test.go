@@ -2,6 +2,7 @@ package dns_test
 
 import (
 	"errors"
+	"os"
 	"strings"
 
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
@@ -10,6 +11,7 @@ import (
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
 	"github.com/softlayer/softlayer-go/sl"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/dns"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
@@ -170,4 +172,20 @@ var _ = Describe("Record list", func() {
 			})
 		})
 	})
+	Describe("Record list completion", func() {
+		BeforeEach(func() {
+			os.Setenv(client.ENV_SL_CACHE_DIR, GinkgoT().TempDir())
+			fakeDNSManager.ListZonesReturns([]datatypes.Dns_Domain{
+				{Id: sl.Int(100), Name: sl.String("example.com")},
+				{Id: sl.Int(200), Name: sl.String("ibm.com")},
+			}, nil)
+		})
+		AfterEach(func() {
+			os.Unsetenv(client.ENV_SL_CACHE_DIR)
+		})
+		It("Completes zone names", func() {
+			completions, _ := cliCommand.Command.ValidArgsFunction(cliCommand.Command, []string{}, "ib")
+			Expect(completions).To(Equal([]string{"ibm.com\t200"}))
+		})
+	})
 })
@@ -51,6 +51,9 @@ func (cmd *DetailCommand) Run(args []string) error {
 	if err != nil {
 		return slErr.NewAPIError(T("Failed to get the email {{.emailID}}. ", map[string]interface{}{"emailID": emailID}), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, email)
+	}
 	table := cmd.UI.Table([]string{
 		T("Name"),
 		T("Value"),
@@ -59,7 +62,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 	//Commented these lines until we fix EmailManager.GetStatistics() method
 	/*
 		bufStatistics := new(bytes.Buffer)
-		statisticsTable := terminal.NewTable(bufStatistics, []string{
+		statisticsTable := utils.NewTable(bufStatistics, []string{
 			T("Delivered"),
 			T("Requests"),
 			T("Bounces"),
@@ -94,6 +97,5 @@ func (cmd *DetailCommand) Run(args []string) error {
 			bufStatistics.String(),
 		)
 	*/
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
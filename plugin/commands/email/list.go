@@ -43,6 +43,9 @@ func (cmd *ListCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to get Network Message Delivery Accounts."), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, emailList)
+	}
 
 	emailTable := cmd.UI.Table([]string{
 		T("Id"),
@@ -64,6 +67,5 @@ func (cmd *ListCommand) Run(args []string) error {
 		)
 	}
 
-	utils.PrintTable(cmd.UI, emailTable, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, emailTable, outputFormat)
 }
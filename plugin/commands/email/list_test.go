@@ -5,8 +5,10 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/softlayer/softlayer-go/session"
+	"github.com/spf13/cobra"
 
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/email"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
@@ -35,7 +37,7 @@ var _ = Describe("Email list Email", func() {
 			It("Set command with an invalid output option", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 		})
 
@@ -52,9 +54,24 @@ var _ = Describe("Email list Email", func() {
 			It("return email list JSON", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output=json")
 				Expect(err).NotTo(HaveOccurred())
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Username": "test.test2@ibm.com",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"SMTP": "1"`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"username": "test.test2@ibm.com",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"smtpAccess": "1"`))
 			})
 		})
 	})
+	Context("JSON output in every locale", func() {
+		It("matches the golden file", func() {
+			outputs, err := testhelpers.RunCobraCommandInAllLocales(func() (*cobra.Command, *terminal.FakeUI) {
+				localeUI := terminal.NewFakeUI()
+				localeCommand := email.NewListCommand(metadata.NewSoftlayerCommand(localeUI, testhelpers.NewFakeSoftlayerSession(nil)))
+				localeCommand.Command.PersistentFlags().Var(localeCommand.OutputFlag, "output", "--output=JSON for json output.")
+				return localeCommand.Command, localeUI
+			}, "--output=JSON")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(outputs).To(HaveLen(len(i18n.SUPPORTED_LOCALES)))
+			for locale, output := range outputs {
+				Expect(output).To(Equal(testhelpers.ReadGoldenFile("email_list")), locale)
+			}
+		})
+	})
 })
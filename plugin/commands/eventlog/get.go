@@ -24,7 +24,7 @@ type GetCommand struct {
 	ObjType         string
 	UtcOffset       string
 	Metadata        bool
-	Limit           int
+	Paging          metadata.Paging
 }
 
 func NewGetCommand(sl *metadata.SoftlayerCommand) (cmd *GetCommand) {
@@ -55,7 +55,7 @@ EXAMPLE:
 	cobraCmd.Flags().StringVarP(&thisCmd.ObjType, "obj-type", "t", "", T("The type of the object we want to get event logs for"))
 	cobraCmd.Flags().StringVarP(&thisCmd.UtcOffset, "utc-offset", "z", "", T("UTC Offset for searching with dates. +/-HHMM format  [default: -0000]"))
 	cobraCmd.Flags().BoolVar(&thisCmd.Metadata, "metadata", false, T("Display metadata if present  [default: no-metadata]"))
-	cobraCmd.Flags().IntVarP(&thisCmd.Limit, "limit", "l", 50, T("Total number of result to return. -1 to return ALL, there may be a LOT of these.  [default: 50]"))
+	metadata.AddLimitedPagingFlags(cobraCmd, &thisCmd.Paging, 50, "l")
 
 	thisCmd.Command = cobraCmd
 	return thisCmd
@@ -65,8 +65,6 @@ func (cmd *GetCommand) Run(args []string) error {
 
 	outputFormat := cmd.GetOutputFlag()
 
-	limit := cmd.Limit
-
 	dateMin := cmd.DateMin
 	if dateMin != "" {
 		time, err := time.Parse(time.RFC3339, dateMin+"T00:00:00Z")
@@ -98,11 +96,14 @@ func (cmd *GetCommand) Run(args []string) error {
 	filter := buildFilter(dateMin, dateMax, objEvent, objId, objType, utcOffset)
 
 	mask := "mask[eventName,label,objectName,eventCreateDate,userId,userType,objectId,metaData,user[username]]"
-	logs, err := cmd.EventLogManager.GetEventLogs(mask, filter, limit)
+	logs, err := cmd.EventLogManager.GetEventLogs(mask, filter, cmd.Paging)
 	if err != nil {
 		return errors.NewAPIError(T("Failed to get Event Logs.\n"), err.Error(), 2)
 	}
-	if logs[0].EventName != nil {
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, logs)
+	}
+	if len(logs) > 0 && logs[0].EventName != nil {
 		var table terminal.Table
 		if metadata {
 			table = cmd.UI.Table([]string{T("Event"), T("Object"), T("Type"), T("Date"), T("Username"), T("Metadata")})
@@ -138,7 +139,9 @@ func (cmd *GetCommand) Run(args []string) error {
 			}
 		}
 
-		utils.PrintTable(cmd.UI, table, outputFormat)
+		if err := utils.PrintTable(cmd.UI, table, outputFormat); err != nil {
+			return err
+		}
 	} else {
 		cmd.UI.Print(T("No logs available for filter {{.filter}}", map[string]interface{}{"filter": filter}))
 	}
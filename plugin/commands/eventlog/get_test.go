@@ -61,6 +61,37 @@ var _ = Describe("event-log get", func() {
 			})
 		})
 
+		Context("Paging", func() {
+			BeforeEach(func() {
+				fakeEventLogManager.GetEventLogsReturns([]datatypes.Event_Log{}, nil)
+			})
+			It("Gets the first 50 event logs by default", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command)
+				Expect(err).NotTo(HaveOccurred())
+				_, _, paging := fakeEventLogManager.GetEventLogsArgsForCall(0)
+				Expect(paging).To(Equal(metadata.Paging{Limit: 50}))
+				Expect(fakeUI.Outputs()).To(ContainSubstring("No logs available for filter"))
+			})
+			It("Gets every event log with --all", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--all")
+				Expect(err).NotTo(HaveOccurred())
+				_, _, paging := fakeEventLogManager.GetEventLogsArgsForCall(0)
+				Expect(paging.All).To(BeTrue())
+			})
+			It("Gets every event log with --limit -1", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--limit", "-1")
+				Expect(err).NotTo(HaveOccurred())
+				_, _, paging := fakeEventLogManager.GetEventLogsArgsForCall(0)
+				Expect(paging.All).To(BeTrue())
+			})
+			It("Takes -l for --limit", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "-l", "10")
+				Expect(err).NotTo(HaveOccurred())
+				_, _, paging := fakeEventLogManager.GetEventLogsArgsForCall(0)
+				Expect(paging).To(Equal(metadata.Paging{Limit: 10}))
+			})
+		})
+
 		Context("Return no error", func() {
 			BeforeEach(func() {
 				created, _ := time.Parse(time.RFC3339, "2017-01-01T00:00:00Z")
@@ -42,6 +42,9 @@ func (cmd *TypesCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to get Event Log types.\n"), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, types)
+	}
 
 	table := cmd.UI.Table([]string{T("Types")})
 
@@ -49,7 +52,9 @@ func (cmd *TypesCommand) Run(args []string) error {
 		table.Add(typeEvent)
 	}
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
+	if err := utils.PrintTable(cmd.UI, table, outputFormat); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -6,8 +6,6 @@ import (
 	"net/url"
 	"strings"
 
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
-
 	"github.com/spf13/cobra"
 
 	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
@@ -106,7 +104,7 @@ func (cmd *VolumeDetailCommand) Run(args []string) error {
 	if fileVolume.ReplicationPartnerCount != nil && int(*fileVolume.ReplicationPartnerCount) > 0 {
 		table.Add(T("Replication Status"), utils.FormatStringPointer(fileVolume.ReplicationStatus))
 		buf := new(bytes.Buffer)
-		repTable := terminal.NewTable(buf, []string{"", ""})
+		repTable := utils.NewTable(buf, []string{"", ""})
 		for _, replicant := range fileVolume.ReplicationPartners {
 			repTable.Add(T("Replicant ID"), utils.FormatIntPointer(replicant.Id))
 			repTable.Add(T("Volume Name"), utils.FormatStringPointer(replicant.Username))
@@ -124,7 +122,7 @@ func (cmd *VolumeDetailCommand) Run(args []string) error {
 
 	if fileVolume.OriginalVolumeSize != nil {
 		buf := new(bytes.Buffer)
-		dupTable := terminal.NewTable(buf, []string{"", ""})
+		dupTable := utils.NewTable(buf, []string{"", ""})
 		dupTable.Add(T("Original Volume Name"), utils.FormatStringPointer(fileVolume.OriginalVolumeName))
 		dupTable.Add(T("Original Volume Size"), utils.FormatStringPointer(fileVolume.OriginalVolumeSize))
 		dupTable.Add(T("Original Snapshot Name"), utils.FormatStringPointer(fileVolume.OriginalSnapshotName))
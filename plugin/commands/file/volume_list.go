@@ -17,6 +17,7 @@ import (
 type VolumeListCommand struct {
 	*metadata.SoftlayerStorageCommand
 	Command        *cobra.Command
+	Paging         metadata.Paging
 	StorageManager managers.StorageManager
 	Username       string
 	Datacenter     string
@@ -52,6 +53,7 @@ EXAMPLE:
 	cobraCmd.Flags().IntVarP(&thisCmd.Order, "order", "o", 0, T("Filter by ID of the order that purchased the file storage"))
 	cobraCmd.Flags().StringVar(&thisCmd.SortBy, "sortby", "id", T("Column to sort by, default:id, options are: id,username,datacenter,storage_type,capacity_gb,bytes_used,ip_addr,lunId,active_transactions,created_by"))
 	cobraCmd.Flags().StringSliceVar(&thisCmd.UserColumns, "column", []string{}, T("Column to display. Options are: id,username,datacenter,storage_type,capacity_gb,bytes_used,IOPs,ip_addr,lunId,created_by,active_transactions,rep_partner_count,notes. This option can be specified multiple times"))
+	metadata.AddPagingFlags(cobraCmd, &thisCmd.Paging)
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
@@ -88,7 +90,7 @@ func (cmd *VolumeListCommand) Run(args []string) error {
 
 	outputFormat := cmd.GetOutputFlag()
 
-	fileVolumes, err := cmd.StorageManager.ListVolumes("file", cmd.Datacenter, cmd.Username, cmd.StorageType, cmd.Notes, cmd.Order, mask)
+	fileVolumes, err := cmd.StorageManager.ListVolumes("file", cmd.Datacenter, cmd.Username, cmd.StorageType, cmd.Notes, cmd.Order, mask, cmd.Paging)
 	if err != nil {
 		return slErr.NewAPIError(T("Failed to list volumes on your account.\n"), err.Error(), 2)
 	}
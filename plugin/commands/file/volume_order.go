@@ -53,6 +53,7 @@ func NewVolumeOrderCommand(sl *metadata.SoftlayerStorageCommand) *VolumeOrderCom
 	cobraCmd.Flags().IntVarP(&thisCmd.Iops, "iops", "i", 0, T("Performance Storage IOPs, between 100 and 6000 in multiples of 100 [required for storage-type performance]"))
 	cobraCmd.Flags().Float64VarP(&thisCmd.Tier, "tier", "e", 0, T("Endurance Storage Tier (IOP per GB) [required for storage-type endurance], options are: 0.25,2,4,10"))
 	cobraCmd.Flags().StringVarP(&thisCmd.Datacenter, "datacenter", "d", "", T("Datacenter short name [required]"))
+	metadata.UseProfileDatacenter(cobraCmd, "datacenter")
 	cobraCmd.Flags().IntVarP(&thisCmd.SnapshotSize, "snapshot-size", "n", 0, T("Optional parameter for ordering snapshot space along with endurance file storage; specifies the size (in GB) of snapshot space to order"))
 	cobraCmd.Flags().StringVarP(&thisCmd.Billing, "billing", "b", "", T("Optional parameter for Billing rate (default to monthly), options are: hourly, monthly"))
 	cobraCmd.Flags().BoolVarP(&thisCmd.Force, "force", "f", false, T("Force operation without confirmation"))
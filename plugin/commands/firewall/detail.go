@@ -59,6 +59,12 @@ func (cmd *DetailCommand) Run(args []string) error {
 		if err != nil {
 			return errors.NewAPIError(T("Failed to get multi vlan firewall.\n"), err.Error(), 2)
 		}
+		if outputFormat == "JSON" {
+			if !cmd.Credentials {
+				firewall.ManagementCredentials = nil
+			}
+			return utils.PrintPrettyJSON(cmd.UI, firewall)
+		}
 		table = cmd.UI.Table([]string{T("Name"), T("Value")})
 		table.Add(T("Name"), utils.FormatStringPointer(firewall.NetworkGateway.Name))
 		table.Add(T("Datacenter"), utils.FormatStringPointer(firewall.Datacenter.LongName))
@@ -76,7 +82,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 
 		if rules := firewall.Rules; len(rules) > 0 {
 			buf := new(bytes.Buffer)
-			ruleTable := terminal.NewTable(buf, []string{"#", T("action"), T("protocol"), T("src_ip"), T("src_mask"), T("dest"), T("dest_mask")})
+			ruleTable := utils.NewTable(buf, []string{"#", T("action"), T("protocol"), T("src_ip"), T("src_mask"), T("dest"), T("dest_mask")})
 			for _, rule := range rules {
 				ruleTable.Add(utils.FormatIntPointer(rule.OrderValue),
 					utils.FormatStringPointer(rule.Action),
@@ -98,6 +104,9 @@ func (cmd *DetailCommand) Run(args []string) error {
 			if err != nil {
 				return errors.NewAPIError(T("Failed to get dedicated firewall rules.\n"), err.Error(), 2)
 			}
+			if outputFormat == "JSON" {
+				return utils.PrintPrettyJSON(cmd.UI, firewallRules)
+			}
 			for _, rule := range firewallRules {
 				table.Add(utils.FormatIntPointer(rule.OrderValue),
 					utils.FormatStringPointer(rule.Action),
@@ -112,6 +121,9 @@ func (cmd *DetailCommand) Run(args []string) error {
 			if err != nil {
 				return errors.NewAPIError(T("Failed to get standard firewall rules.\n"), err.Error(), 2)
 			}
+			if outputFormat == "JSON" {
+				return utils.PrintPrettyJSON(cmd.UI, firewallRules)
+			}
 			for _, rule := range firewallRules {
 				table.Add(utils.FormatIntPointer(rule.OrderValue),
 					utils.FormatStringPointer(rule.Action),
@@ -124,6 +136,5 @@ func (cmd *DetailCommand) Run(args []string) error {
 		}
 	}
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
@@ -49,6 +49,9 @@ func (cmd *ListCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to get multi vlan firewalls on your account.\n"), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, map[string]interface{}{"vlans": fwvlans, "multiVlanFirewalls": multiVlanFirewalls})
+	}
 
 	//dedicated firewalls
 	for _, vlan := range fwvlans {
@@ -95,7 +98,9 @@ func (cmd *ListCommand) Run(args []string) error {
 		}
 	}
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
+	if err := utils.PrintTable(cmd.UI, table, outputFormat); err != nil {
+		return err
+	}
 
 	cmd.UI.Print("\n")
 	table = cmd.UI.Table([]string{T("Firewall ID"), T("Firewall"), T("Type"), T("Hostname"), T("Location"), T("Public Ip"), T("Private Ip"), T("Associated VLANs"), T("Status")})
@@ -114,8 +119,7 @@ func (cmd *ListCommand) Run(args []string) error {
 		)
 	}
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
 
 func hasFirewallComponent(component datatypes.Network_Component_Firewall) bool {
@@ -2,6 +2,7 @@ package globalip
 
 import (
 	"github.com/spf13/cobra"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -33,15 +34,18 @@ EXAMPLE:
 			return thisCmd.Run(args)
 		},
 	}
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "global_ip", func() ([]string, error) {
+		return managers.GlobalIPCompletions(thisCmd.NetworkManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *AssignCommand) Run(args []string) error {
 
-	globalIPID, err := utils.ResolveGloablIPId(args[0])
+	globalIPID, err := managers.ResolveGlobalIPId(cmd.NetworkManager, args[0])
 	if err != nil {
-		return errors.NewInvalidSoftlayerIdInputError("Globalip ID")
+		return err
 	}
 	outputFormat := cmd.GetOutputFlag()
 
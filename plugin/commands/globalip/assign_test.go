@@ -52,7 +52,7 @@ var _ = Describe("GlobalIP assign", func() {
 			It("return error", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "abc", "1.2.3.4")
 				Expect(err).To(HaveOccurred())
-				Expect(strings.Contains(err.Error(), "Invalid input for 'Globalip ID'. It must be a positive integer.")).To(BeTrue())
+				Expect(strings.Contains(err.Error(), "Invalid input for 'Globalip ID'. It must be an ID or an IP address.")).To(BeTrue())
 			})
 		})
 
@@ -4,13 +4,12 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
-	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
-	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
 )
 
 type CancelCommand struct {
@@ -34,15 +33,18 @@ func NewCancelCommand(sl *metadata.SoftlayerCommand) *CancelCommand {
 		},
 	}
 	cobraCmd.Flags().BoolVarP(&thisCmd.Force, "force", "f", false, T("Force operation without confirmation"))
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "global_ip", func() ([]string, error) {
+		return managers.GlobalIPCompletions(thisCmd.NetworkManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *CancelCommand) Run(args []string) error {
 
-	globalIPID, err := utils.ResolveGloablIPId(args[0])
+	globalIPID, err := managers.ResolveGlobalIPId(cmd.NetworkManager, args[0])
 	if err != nil {
-		return slErr.NewInvalidSoftlayerIdInputError("Globalip ID")
+		return err
 	}
 	if !cmd.Force {
 		confirm, err := cmd.UI.Confirm(T("This will cancel the IP address: {{.ID}} and cannot be undone. Continue?", map[string]interface{}{"ID": globalIPID}))
@@ -44,7 +44,7 @@ var _ = Describe("GlobalIP cancel", func() {
 			It("return error", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "abc")
 				Expect(err).To(HaveOccurred())
-				Expect(strings.Contains(err.Error(), "Invalid input for 'Globalip ID'. It must be a positive integer.")).To(BeTrue())
+				Expect(strings.Contains(err.Error(), "Invalid input for 'Globalip ID'. It must be an ID or an IP address.")).To(BeTrue())
 			})
 		})
 
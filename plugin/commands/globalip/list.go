@@ -59,6 +59,9 @@ func (cmd *ListCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to list global IPs on your account.\n"), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, ips)
+	}
 
 	table := cmd.UI.Table([]string{T("ID"), T("ip"), T("assigned"), T("target")})
 	for _, ip := range ips {
@@ -82,6 +85,5 @@ func (cmd *ListCommand) Run(args []string) error {
 		}
 		table.Add(subnetId, ipAddress, assigned, target)
 	}
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
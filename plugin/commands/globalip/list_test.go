@@ -10,7 +10,9 @@ import (
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
 	"github.com/softlayer/softlayer-go/sl"
+	"github.com/spf13/cobra"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/globalip"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
 )
@@ -94,4 +96,19 @@ var _ = Describe("GlobalIP list", func() {
 			})
 		})
 	})
+	Context("JSON output in every locale", func() {
+		It("matches the golden file", func() {
+			outputs, err := testhelpers.RunCobraCommandInAllLocales(func() (*cobra.Command, *terminal.FakeUI) {
+				localeUI := terminal.NewFakeUI()
+				localeCommand := globalip.NewListCommand(metadata.NewSoftlayerCommand(localeUI, testhelpers.NewFakeSoftlayerSession(nil)))
+				localeCommand.Command.PersistentFlags().Var(localeCommand.OutputFlag, "output", "--output=JSON for json output.")
+				return localeCommand.Command, localeUI
+			}, "--output=JSON")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(outputs).To(HaveLen(len(i18n.SUPPORTED_LOCALES)))
+			for locale, output := range outputs {
+				Expect(output).To(Equal(testhelpers.ReadGoldenFile("globalip_list")), locale)
+			}
+		})
+	})
 })
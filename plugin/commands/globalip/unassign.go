@@ -2,6 +2,7 @@ package globalip
 
 import (
 	"github.com/spf13/cobra"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -28,6 +29,9 @@ func NewUnassignCommand(sl *metadata.SoftlayerCommand) *UnassignCommand {
 			return thisCmd.Run(args)
 		},
 	}
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "global_ip", func() ([]string, error) {
+		return managers.GlobalIPCompletions(thisCmd.NetworkManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
@@ -35,9 +39,9 @@ func NewUnassignCommand(sl *metadata.SoftlayerCommand) *UnassignCommand {
 func (cmd *UnassignCommand) Run(args []string) error {
 	outputFormat := cmd.GetOutputFlag()
 
-	globalIPID, err := utils.ResolveGloablIPId(args[0])
+	globalIPID, err := managers.ResolveGlobalIPId(cmd.NetworkManager, args[0])
 	if err != nil {
-		return errors.NewInvalidSoftlayerIdInputError("Globalip ID")
+		return err
 	}
 
 	resp, err := cmd.NetworkManager.UnassignGlobalIP(globalIPID)
@@ -1,11 +1,9 @@
 package hardware
 
 import (
-	"strconv"
-
 	"github.com/spf13/cobra"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
-	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
@@ -36,14 +34,17 @@ func NewAuthorizeStorageCommand(sl *metadata.SoftlayerCommand) (cmd *AuthorizeSt
 
 	cobraCmd.Flags().StringVarP(&thisCmd.UsernameStorage, "username-storage", "u", "", T("The storage username to be added to the hardware server."))
 
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "hardware", func() ([]string, error) {
+		return managers.HardwareCompletions(thisCmd.HardwareManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *AuthorizeStorageCommand) Run(args []string) error {
-	hardwareId, err := strconv.Atoi(args[0])
+	hardwareId, err := managers.ResolveHardwareId(cmd.HardwareManager, args[0])
 	if err != nil {
-		return slErr.NewInvalidSoftlayerIdInputError("Hardware server ID")
+		return err
 	}
 
 	outputFormat := cmd.GetOutputFlag()
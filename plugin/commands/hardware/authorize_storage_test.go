@@ -43,7 +43,7 @@ var _ = Describe("Authorize Block, File Storage to a Hardware Server", func() {
 			It("return error", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "abc")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Invalid input for 'Hardware server ID'. It must be a positive integer."))
+				Expect(err.Error()).To(ContainSubstring("Unable to find hardware server"))
 			})
 		})
 
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/virtual"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
@@ -53,14 +54,17 @@ Example::
 	cobraCmd.Flags().BoolVarP(&thisCmd.quiet, "quiet", "q", false, T("Only show the summary table."))
 	cobraCmd.Flags().SetNormalizeFunc(utils.NormalizeQuietFlag)
 
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "hardware", func() ([]string, error) {
+		return managers.HardwareCompletions(thisCmd.HardwareManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *BandwidthCommand) Run(args []string) error {
-	VsID, err := utils.ResolveVirtualGuestId(args[0])
+	VsID, err := managers.ResolveHardwareId(cmd.HardwareManager, args[0])
 	if err != nil {
-		return errors.NewInvalidSoftlayerIdInputError("Virtual server ID")
+		return err
 	}
 
 	var start, end string
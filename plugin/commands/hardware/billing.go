@@ -3,12 +3,10 @@ package hardware
 import (
 	"bytes"
 	"fmt"
-	"strconv"
 
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
 	"github.com/spf13/cobra"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
-	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
@@ -36,15 +34,17 @@ func NewBillingCommand(sl *metadata.SoftlayerCommand) (cmd *BillingCommand) {
 		},
 	}
 
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "hardware", func() ([]string, error) {
+		return managers.HardwareCompletions(thisCmd.HardwareManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *BillingCommand) Run(args []string) error {
-	hardwareID, err := strconv.Atoi(args[0])
-
+	hardwareID, err := managers.ResolveHardwareId(cmd.HardwareManager, args[0])
 	if err != nil {
-		return slErr.NewInvalidSoftlayerIdInputError("Hardware server ID")
+		return err
 	}
 
 	outputFormat := cmd.GetOutputFlag()
@@ -68,7 +68,7 @@ func (cmd *BillingCommand) Run(args []string) error {
 
 	if hardware.BillingItem != nil && hardware.BillingItem.NextInvoiceTotalRecurringAmount != nil {
 		buf := new(bytes.Buffer)
-		priceTable := terminal.NewTable(buf, []string{T("Item"), T("CategoryCode"), T("Recurring Price")})
+		priceTable := utils.NewTable(buf, []string{T("Item"), T("CategoryCode"), T("Recurring Price")})
 		for _, item := range hardware.BillingItem.NextInvoiceChildren {
 			if item.NextInvoiceTotalRecurringAmount != nil {
 				priceTable.Add(*item.Description, *item.CategoryCode, fmt.Sprintf("%.2f", *item.NextInvoiceTotalRecurringAmount))
@@ -0,0 +1,47 @@
+package hardware
+
+import (
+	"fmt"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/cmdutils"
+	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
+)
+
+// The hardware servers a bulk command works on, from its IDENTIFIER arguments or the filters of selector
+func selectHardwareServers(manager managers.HardwareServerManager, args []string, selector cmdutils.ServerSelector) ([]cmdutils.BulkTarget, error) {
+	targets := []cmdutils.BulkTarget{}
+	if selector.HasFilters() {
+		servers, err := manager.ListHardware(selector.Tags, 0, 0, selector.HostnamePattern, "", selector.Datacenter, 0, "", "", "", 0,
+			"mask[id,hostname,domain]", metadata.Paging{})
+		if err != nil {
+			return nil, slErr.NewAPIError(T("Failed to get hardware servers on your account.\n"), err.Error(), 2)
+		}
+		for _, server := range servers {
+			targets = append(targets, cmdutils.BulkTarget{
+				Id:   utils.IntPointertoInt(server.Id),
+				Name: fmt.Sprintf("%s.%s", utils.StringPointertoString(server.Hostname), utils.StringPointertoString(server.Domain)),
+			})
+		}
+		return targets, nil
+	}
+	identifiers, err := selector.Identifiers(args)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[int]bool{}
+	for _, identifier := range identifiers {
+		hardwareId, err := managers.ResolveHardwareId(manager, identifier)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[hardwareId] {
+			seen[hardwareId] = true
+			targets = append(targets, cmdutils.BulkTarget{Id: hardwareId})
+		}
+	}
+	return targets, nil
+}
@@ -1,12 +1,11 @@
 package hardware
 
 import (
-	"strconv"
 	"strings"
 
-	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
-
 	"github.com/spf13/cobra"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/cmdutils"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
@@ -22,6 +21,7 @@ type CancelCommand struct {
 	Reason          string
 	Comment         string
 	ForceFlag       bool
+	Selector        cmdutils.ServerSelector
 }
 
 func NewCancelCommand(sl *metadata.SoftlayerCommand) (cmd *CancelCommand) {
@@ -31,9 +31,9 @@ func NewCancelCommand(sl *metadata.SoftlayerCommand) (cmd *CancelCommand) {
 	}
 
 	cobraCmd := &cobra.Command{
-		Use:   "cancel " + T("IDENTIFIER"),
+		Use:   "cancel " + T("IDENTIFIER") + "...",
 		Short: T("Cancel a hardware server"),
-		Args:  metadata.OneArgs,
+		Args:  cmdutils.ServerSelectorArgs(&thisCmd.Selector),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return thisCmd.Run(args)
 		},
@@ -43,15 +43,30 @@ func NewCancelCommand(sl *metadata.SoftlayerCommand) (cmd *CancelCommand) {
 	cobraCmd.Flags().StringVarP(&thisCmd.Reason, "reason", "r", "", T("An optional cancellation reason. See '${COMMAND_NAME} sl hardware cancel-reasons' for a list of available options"))
 	cobraCmd.Flags().StringVarP(&thisCmd.Comment, "comment", "c", "", T("An optional comment to add to the cancellation ticket"))
 	cobraCmd.Flags().BoolVarP(&thisCmd.ForceFlag, "force", "f", false, T("Force operation without confirmation"))
+	cmdutils.AddServerSelectorFlags(cobraCmd, &thisCmd.Selector)
 
+	cobraCmd.ValidArgsFunction = client.CompleteEveryIdentifier(sl.Session, "hardware", func() ([]string, error) {
+		return managers.HardwareCompletions(thisCmd.HardwareManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *CancelCommand) Run(args []string) error {
-	hardwareID, err := strconv.Atoi(args[0])
+	if cmd.Selector.IsBulk(args) {
+		servers, err := selectHardwareServers(cmd.HardwareManager, args, cmd.Selector)
+		if err != nil {
+			return err
+		}
+		question := T("This will cancel {{.COUNT}} hardware servers and cannot be undone. Continue?", map[string]interface{}{"COUNT": len(servers)})
+		return cmdutils.RunBulk(cmd.SoftlayerCommand, servers, cmd.ForceFlag, question, func(id int) error {
+			return cmd.HardwareManager.CancelHardware(id, cmd.Reason, cmd.Comment, cmd.Immediate)
+		})
+	}
+
+	hardwareID, err := managers.ResolveHardwareId(cmd.HardwareManager, args[0])
 	if err != nil {
-		return slErr.NewInvalidSoftlayerIdInputError("Hardware server ID")
+		return err
 	}
 	if !cmd.ForceFlag {
 		confirm, err := cmd.UI.Confirm(T("This will cancel the hardware server: {{.ID}} and cannot be undone. Continue?", map[string]interface{}{"ID": hardwareID}))
@@ -7,6 +7,7 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/cmdutils"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -16,6 +17,8 @@ import (
 type CreateCommand struct {
 	*metadata.SoftlayerCommand
 	HardwareManager managers.HardwareServerManager
+	OrderManager    managers.OrderManager
+	SecurityManager managers.SecurityManager
 	Command         *cobra.Command
 	Hostname        string
 	Domain          string
@@ -32,18 +35,26 @@ type CreateCommand struct {
 	Template        string
 	Export          string
 	ForceFlag       bool
+	Interactive     bool
+	Wait            cmdutils.WaitOptions
 }
 
 func NewCreateCommand(sl *metadata.SoftlayerCommand) (cmd *CreateCommand) {
 	thisCmd := &CreateCommand{
 		SoftlayerCommand: sl,
 		HardwareManager:  managers.NewHardwareServerManager(sl.Session),
+		OrderManager:     managers.NewOrderManager(sl.Session),
+		SecurityManager:  managers.NewSecurityManager(sl.Session),
 	}
 
 	cobraCmd := &cobra.Command{
 		Use:   "create",
 		Short: T("Order/create a hardware server"),
-		Args:  metadata.NoArgs,
+		Long: T(`EXAMPLE:
+   ${COMMAND_NAME} sl hw create --interactive
+   This command asks for the datacenter, size, operating system, network and SSH keys from the create options,
+   then shows the same command without --interactive.`),
+		Args: metadata.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return thisCmd.Run(args)
 		},
@@ -54,6 +65,7 @@ func NewCreateCommand(sl *metadata.SoftlayerCommand) (cmd *CreateCommand) {
 	cobraCmd.Flags().StringVarP(&thisCmd.Size, "size", "s", "", T("Hardware size[required]"))
 	cobraCmd.Flags().StringVarP(&thisCmd.Os, "os", "o", "", T("OS install code[required]"))
 	cobraCmd.Flags().StringVarP(&thisCmd.Datacenter, "datacenter", "d", "", T("Datacenter shortname[required]"))
+	metadata.UseProfileDatacenter(cobraCmd, "datacenter")
 	cobraCmd.Flags().IntVarP(&thisCmd.PortSpeed, "port-speed", "p", 0, T("Port speed[required]"))
 	cobraCmd.Flags().StringVarP(&thisCmd.Billing, "billing", "b", "", T("Billing rate, either hourly or monthly, default is hourly if not specified"))
 	cobraCmd.Flags().StringVarP(&thisCmd.PostInstall, "post-install", "i", "", T("Post-install script to download"))
@@ -64,6 +76,9 @@ func NewCreateCommand(sl *metadata.SoftlayerCommand) (cmd *CreateCommand) {
 	cobraCmd.Flags().StringVarP(&thisCmd.Template, "template", "m", "", T("A template file that defaults the command-line options"))
 	cobraCmd.Flags().StringVarP(&thisCmd.Export, "export", "x", "", T("Exports options to a template file"))
 	cobraCmd.Flags().BoolVarP(&thisCmd.ForceFlag, "force", "f", false, T("Force operation without confirmation"))
+	cobraCmd.Flags().BoolVar(&thisCmd.Interactive, "interactive", false, T("Ask for the options one at a time, with the choices available"))
+	cobraCmd.MarkFlagsMutuallyExclusive("interactive", "template")
+	cmdutils.AddWaitFlags(cobraCmd, &thisCmd.Wait, T("the hardware server is provisioned"))
 
 	thisCmd.Command = cobraCmd
 	return thisCmd
@@ -71,6 +86,12 @@ func NewCreateCommand(sl *metadata.SoftlayerCommand) (cmd *CreateCommand) {
 
 func (cmd *CreateCommand) Run(args []string) error {
 	params := make(map[string]interface{})
+	if cmd.Interactive {
+		err := cmd.askOptions()
+		if err != nil {
+			return err
+		}
+	}
 	if cmd.Template != "" {
 		templateFile := cmd.Template
 		if _, err := os.Stat(templateFile); os.IsNotExist(err) {
@@ -200,8 +221,16 @@ func (cmd *CreateCommand) Run(args []string) error {
 			}
 			table.Add(T("Total monthly cost"), fmt.Sprintf("%.2f", total))
 		}
-		cmd.UI.Print(T("Run '{{.CommandName}} sl hardware list --order {{.OrderID}}' to find this hardware server after it is ready.",
-			map[string]interface{}{"OrderID": *orderReceipt.OrderId, "CommandName": "ibmcloud"}))
+		subs := map[string]interface{}{"OrderID": *orderReceipt.OrderId, "CommandName": "ibmcloud"}
+		if !cmd.Wait.Enabled() {
+			cmd.UI.Print(T("Run '{{.CommandName}} sl hardware list --order {{.OrderID}}' to find this hardware server after it is ready.", subs))
+			return nil
+		}
+		err = cmdutils.WaitFor(cmd.SoftlayerCommand, cmd.Wait, T("order {{.OrderID}}", subs), managers.OrderReadyCheck(cmd.OrderManager, *orderReceipt.OrderId))
+		if err != nil {
+			return err
+		}
+		cmd.UI.Print(T("Order {{.OrderID}} is ready. Run '{{.CommandName}} sl hardware list --order {{.OrderID}}' to find this hardware server.", subs))
 		return nil
 	}
 }
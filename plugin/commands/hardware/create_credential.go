@@ -1,13 +1,13 @@
 package hardware
 
 import (
-	"strconv"
 	"strings"
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/sl"
 	"github.com/spf13/cobra"
 
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -52,6 +52,9 @@ func NewCreateCredentialCommand(sl *metadata.SoftlayerCommand) (cmd *CreateCrede
 	//#nosec G104 -- This is a false positive
 	cobraCmd.MarkFlagRequired("software")
 
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "hardware", func() ([]string, error) {
+		return managers.HardwareCompletions(thisCmd.HardwareManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
@@ -59,9 +62,9 @@ func NewCreateCredentialCommand(sl *metadata.SoftlayerCommand) (cmd *CreateCrede
 func (cmd *CreateCredentialCommand) Run(args []string) error {
 	outputFormat := cmd.GetOutputFlag()
 
-	hardwareId, err := strconv.Atoi(args[0])
+	hardwareId, err := managers.ResolveHardwareId(cmd.HardwareManager, args[0])
 	if err != nil {
-		return errors.NewInvalidSoftlayerIdInputError("Hardware ID")
+		return err
 	}
 
 	hardware, err := cmd.HardwareManager.GetHardware(hardwareId, "mask[softwareComponents[softwareLicense[softwareDescription]]]")
@@ -91,6 +94,9 @@ func (cmd *CreateCredentialCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to create Software Credential."), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, softwareCredential)
+	}
 
 	table := cmd.UI.Table([]string{T("Name"), T("Value")})
 	table.Add("Software Credential Id", utils.FormatIntPointer(softwareCredential.Id))
@@ -103,6 +109,5 @@ func (cmd *CreateCredentialCommand) Run(args []string) error {
 	}
 	table.Add("Notes", notes)
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
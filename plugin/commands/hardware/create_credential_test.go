@@ -46,13 +46,13 @@ var _ = Describe("hadware create-credential", func() {
 			It("Set command with an invalid Id", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "abcde", "--username=myusername", "--password=password1234", "--software=ubuntu")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Invalid input for 'Hardware ID'. It must be a positive integer."))
+				Expect(err.Error()).To(ContainSubstring("Unable to find hardware server"))
 			})
 
 			It("Set invalid output", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "123456", "--username=myusername", "--password=password1234", "--software=ubuntu", "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 
 			It("Set command without required options", func() {
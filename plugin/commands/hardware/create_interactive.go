@@ -0,0 +1,119 @@
+package hardware
+
+import (
+	"strconv"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/cmdutils"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
+)
+
+// Walks through the options of --interactive, the flags that were given are the defaults.
+// The answers are set on the flags, so the rest of Run works the same as without --interactive.
+func (cmd *CreateCommand) askOptions() error {
+	productPackage, err := cmd.HardwareManager.GetPackage()
+	if err != nil {
+		return errors.NewAPIError(T("Failed to get product package for hardware server.\n"), err.Error(), 2)
+	}
+	options := cmd.HardwareManager.GetCreateOptions(productPackage)
+	cmd.UI.Print(T("Answer the questions to order a hardware server, the default answer is in parentheses."))
+
+	hostname, err := cmdutils.AskText(cmd.SoftlayerCommand, T("Hostname"), cmd.Hostname, true)
+	if err != nil {
+		return err
+	}
+	domain, err := cmdutils.AskText(cmd.SoftlayerCommand, T("Domain"), cmd.Domain, true)
+	if err != nil {
+		return err
+	}
+	datacenter, err := cmdutils.AskChoice(cmd.SoftlayerCommand, T("Datacenter"), options[managers.KEY_LOCATIONS], cmd.Datacenter)
+	if err != nil {
+		return err
+	}
+	size, err := cmdutils.AskChoice(cmd.SoftlayerCommand, T("Size"), options[managers.KEY_SIZES], cmd.Size)
+	if err != nil {
+		return err
+	}
+	operatingSystem, err := cmdutils.AskChoice(cmd.SoftlayerCommand, T("Operating system"), options[managers.KEY_OS], cmd.Os)
+	if err != nil {
+		return err
+	}
+	err = cmdutils.SetFlags(cmd.Command, map[string]interface{}{"hostname": hostname, "domain": domain, "datacenter": datacenter, "size": size, "os": operatingSystem})
+	if err != nil {
+		return err
+	}
+
+	noPublic, err := cmdutils.AskYesNo(cmd.SoftlayerCommand, T("Private network only?"), cmd.NoPublic)
+	if err != nil {
+		return err
+	}
+	// Every speed comes as a public and a private item, the flag only takes the speed
+	speeds := map[string]string{}
+	for key, speed := range options[managers.KEY_PORT_SPEED] {
+		if _, found := speeds[speed]; !found {
+			speeds[speed] = options[managers.KEY_PORT_SPEED_DESCRIPTION][key]
+		}
+	}
+	currentSpeed := ""
+	if cmd.PortSpeed != 0 {
+		currentSpeed = strconv.Itoa(cmd.PortSpeed)
+	}
+	speed, err := cmdutils.AskChoice(cmd.SoftlayerCommand, T("Port speed"), speeds, currentSpeed)
+	if err != nil {
+		return err
+	}
+	portSpeed, err := strconv.Atoi(speed)
+	if err != nil {
+		return errors.NewInvalidUsageError(T("{{.VALUE}} is not a number.", map[string]interface{}{"VALUE": speed}))
+	}
+	err = cmdutils.SetFlags(cmd.Command, map[string]interface{}{"no-public": noPublic, "port-speed": portSpeed})
+	if err != nil {
+		return err
+	}
+
+	sshKeys, err := cmd.SecurityManager.ListSSHKeys("")
+	if err != nil {
+		return errors.NewAPIError(T("Failed to get SSH keys on your account.\n"), err.Error(), 2)
+	}
+	if len(sshKeys) > 0 {
+		table := cmd.UI.Table([]string{T("ID"), T("Label")})
+		for _, key := range sshKeys {
+			table.Add(utils.FormatIntPointer(key.Id), utils.FormatStringPointer(key.Label))
+		}
+		table.Print()
+		keys, err := cmdutils.AskIntList(cmd.SoftlayerCommand, T("IDs of the SSH keys to add, separated by commas (blank for none)"), cmd.Key)
+		if err != nil {
+			return err
+		}
+		err = cmdutils.SetFlags(cmd.Command, map[string]interface{}{"key": keys})
+		if err != nil {
+			return err
+		}
+	}
+
+	currentBilling := cmd.Billing
+	if currentBilling == "" {
+		currentBilling = "hourly"
+	}
+	billing, err := cmdutils.AskChoice(cmd.SoftlayerCommand, T("Billing"), map[string]string{"hourly": T("Hourly"), "monthly": T("Monthly")}, currentBilling)
+	if err != nil {
+		return err
+	}
+	err = cmdutils.SetFlags(cmd.Command, map[string]interface{}{"billing": billing})
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.Print(T("The same hardware server can be ordered with:"))
+	cmd.UI.Print(cmdutils.EquivalentCommandLine(cmd.Command, "interactive", "export"))
+	if cmd.Export == "" {
+		export, err := cmdutils.AskText(cmd.SoftlayerCommand, T("File to export these options to as a template (blank to continue without one)"), "", false)
+		if err != nil {
+			return err
+		}
+		cmd.Export = export
+	}
+	return nil
+}
@@ -209,5 +209,62 @@ var _ = Describe("hardware create", func() {
 				Expect(fakeUI.Outputs()).To(ContainSubstring("Run 'ibmcloud sl hardware list --order 123456' to find this hardware server after it is ready."))
 			})
 		})
+		Context("hardware create --interactive", func() {
+			var fakeSecurityManager *testhelpers.FakeSecurityManager
+			BeforeEach(func() {
+				fakeSecurityManager = new(testhelpers.FakeSecurityManager)
+				cliCommand.SecurityManager = fakeSecurityManager
+				fakeSecurityManager.ListSSHKeysReturns([]datatypes.Security_Ssh_Key{}, nil)
+				fakeHardwareManager.GetCreateOptionsReturns(map[string]map[string]string{
+					"locations":              {"dal10": "Dallas 10", "dal13": "Dallas 13"},
+					"sizes":                  {"S1270_32GB_2X960GBSSD_NORAID": "E3-1270 v6, 32GB RAM"},
+					"operating_systems":      {"UBUNTU_16_64": "Ubuntu 16.04"},
+					"port_speed":             {"1_GBPS_PUBLIC_PRIVATE": "1000", "10_GBPS_PUBLIC_PRIVATE": "10000", "1_GBPS_REDUNDANT": "1000"},
+					"port_speed_description": {"1_GBPS_PUBLIC_PRIVATE": "1 Gbps", "10_GBPS_PUBLIC_PRIVATE": "10 Gbps", "1_GBPS_REDUNDANT": "1 Gbps"},
+				})
+				fakeHardwareManager.PlaceOrderReturns(datatypes.Container_Product_Order_Receipt{OrderId: sl.Int(123456)}, nil)
+			})
+			It("Asks for the options and shows the same command", func() {
+				fakeUI.Inputs("web1", "example.com", "2", "1", "1", "n", "1", "2", "")
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--interactive", "-f")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeUI.Outputs()).To(ContainSubstring("1000 (1 Gbps)"))
+				Expect(fakeUI.Outputs()).To(ContainSubstring("The same hardware server can be ordered with:"))
+				Expect(fakeUI.Outputs()).To(ContainSubstring("ibmcloud sl create --billing monthly --datacenter dal13 --domain example.com --force --hostname web1 --os UBUNTU_16_64 --port-speed 1000 --size S1270_32GB_2X960GBSSD_NORAID"))
+				Expect(fakeUI.Outputs()).To(ContainSubstring("Order 123456 was placed."))
+				_, params := fakeHardwareManager.GenerateCreateTemplateArgsForCall(0)
+				Expect(params["hostname"]).To(Equal("web1"))
+				Expect(params["datacenter"]).To(Equal("dal13"))
+				Expect(params["size"]).To(Equal("S1270_32GB_2X960GBSSD_NORAID"))
+				Expect(params["osName"]).To(Equal("UBUNTU_16_64"))
+				Expect(params["portSpeed"]).To(Equal(1000))
+				Expect(params["billing"]).To(Equal("monthly"))
+			})
+			It("Asks for SSH keys when the account has some", func() {
+				fakeSecurityManager.ListSSHKeysReturns([]datatypes.Security_Ssh_Key{{Id: sl.Int(123), Label: sl.String("mykey")}}, nil)
+				fakeUI.Inputs("", "", "", "", "", "y", "", "123", "", "")
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--interactive", "-f", "-H", "web1", "-D", "example.com", "-d", "dal10",
+					"-s", "S1270_32GB_2X960GBSSD_NORAID", "-o", "UBUNTU_16_64", "-p", "10000")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeUI.Outputs()).To(ContainSubstring("mykey"))
+				_, params := fakeHardwareManager.GenerateCreateTemplateArgsForCall(0)
+				Expect(params["datacenter"]).To(Equal("dal10"))
+				Expect(params["portSpeed"]).To(Equal(10000))
+				Expect(params["noPublic"]).To(BeTrue())
+				Expect(params["sshKeys"]).To(Equal([]int{123}))
+				Expect(params["billing"]).To(Equal("hourly"))
+			})
+			It("Can't be used with --template", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--interactive", "--template", "hw.json")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("[interactive template] were all set"))
+			})
+			It("Returns an error when the package can't be read", func() {
+				fakeHardwareManager.GetPackageReturns(datatypes.Product_Package{}, errors.New("Internal Server Error"))
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--interactive")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Failed to get product package for hardware server."))
+			})
+		})
 	})
 })
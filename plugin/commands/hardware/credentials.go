@@ -1,11 +1,9 @@
 package hardware
 
 import (
-	"strconv"
-
 	"github.com/spf13/cobra"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
-	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
@@ -33,14 +31,17 @@ func NewCredentialsCommand(sl *metadata.SoftlayerCommand) (cmd *CredentialsComma
 		},
 	}
 
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "hardware", func() ([]string, error) {
+		return managers.HardwareCompletions(thisCmd.HardwareManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *CredentialsCommand) Run(args []string) error {
-	hardwareID, err := strconv.Atoi(args[0])
+	hardwareID, err := managers.ResolveHardwareId(cmd.HardwareManager, args[0])
 	if err != nil {
-		return slErr.NewInvalidSoftlayerIdInputError("Hardware server ID")
+		return err
 	}
 
 	outputFormat := cmd.GetOutputFlag()
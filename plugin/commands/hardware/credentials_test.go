@@ -45,7 +45,7 @@ var _ = Describe("hardware credentials", func() {
 			It("return error", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "abcd")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Invalid input for 'Hardware server ID'. It must be a positive integer."))
+				Expect(err.Error()).To(ContainSubstring("Unable to find hardware server"))
 			})
 		})
 
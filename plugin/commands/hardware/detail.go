@@ -3,12 +3,10 @@ package hardware
 import (
 	"bytes"
 	"fmt"
-	"strconv"
 
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
 	"github.com/spf13/cobra"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
-	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
@@ -43,14 +41,17 @@ func NewDetailCommand(sl *metadata.SoftlayerCommand) (cmd *DetailCommand) {
 	cobraCmd.Flags().BoolVarP(&thisCmd.Price, "price", "c", false, T("Show associated prices"))
 	cobraCmd.Flags().BoolVar(&thisCmd.Components, "components", false, T("Show associated hardware components"))
 
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "hardware", func() ([]string, error) {
+		return managers.HardwareCompletions(thisCmd.HardwareManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *DetailCommand) Run(args []string) error {
-	hardwareId, err := strconv.Atoi(args[0])
+	hardwareId, err := managers.ResolveHardwareId(cmd.HardwareManager, args[0])
 	if err != nil {
-		return slErr.NewInvalidSoftlayerIdInputError("Hardware server ID")
+		return err
 	}
 
 	outputFormat := cmd.GetOutputFlag()
@@ -96,7 +97,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 
 	if len(hardDrives) > 0 {
 		buf := new(bytes.Buffer)
-		hardDriveTable := terminal.NewTable(buf, []string{T("Name"), T("Capacity"), T("Serial #")})
+		hardDriveTable := utils.NewTable(buf, []string{T("Name"), T("Capacity"), T("Serial #")})
 		for _, hardDrive := range hardDrives {
 			name := *hardDrive.HardwareComponentModel.Manufacturer + " " + *hardDrive.HardwareComponentModel.Name
 			capacity := fmt.Sprintf(
@@ -157,7 +158,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 
 	if vlans := hardware.NetworkVlans; len(vlans) > 0 {
 		buf := new(bytes.Buffer)
-		vlanTable := terminal.NewTable(buf, []string{T("Network"), T("Number"), T("ID"), T("Name"), T("Type")})
+		vlanTable := utils.NewTable(buf, []string{T("Network"), T("Number"), T("ID"), T("Name"), T("Type")})
 		for _, vlan := range vlans {
 			vlanTable.Add(
 				utils.FormatStringPointer(vlan.NetworkSpace),
@@ -191,7 +192,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 
 	if len(billingCycleBandwidthUsage) > 0 {
 		buf := new(bytes.Buffer)
-		bandwithTable := terminal.NewTable(buf, []string{T("Type"), T("In GB"), T("Out GB"), T("Allotment")})
+		bandwithTable := utils.NewTable(buf, []string{T("Type"), T("In GB"), T("Out GB"), T("Allotment")})
 		for _, billingCycle := range billingCycleBandwidthUsage {
 			bw_type := "Private"
 			allotment := "N/A"
@@ -216,7 +217,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 
 	if len(hardware.ActiveComponents) > 0 {
 		buf := new(bytes.Buffer)
-		vlanTable := terminal.NewTable(buf, []string{T("Type"), T("Name")})
+		vlanTable := utils.NewTable(buf, []string{T("Type"), T("Name")})
 		for _, activeComponent := range hardware.ActiveComponents {
 			vlanTable.Add(
 				utils.FormatStringPointer(activeComponent.HardwareComponentModel.HardwareGenericComponentModel.HardwareComponentType.KeyName),
@@ -230,7 +231,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 	if cmd.Price {
 		if hardware.BillingItem != nil && hardware.BillingItem.NextInvoiceTotalRecurringAmount != nil {
 			buf := new(bytes.Buffer)
-			priceTable := terminal.NewTable(buf, []string{T("Item"), T("CategoryCode"), T("Recurring Price")})
+			priceTable := utils.NewTable(buf, []string{T("Item"), T("CategoryCode"), T("Recurring Price")})
 
 			totalPrice := hardware.BillingItem.NextInvoiceTotalRecurringAmount
 			priceTable.Add("Total", "-", fmt.Sprintf("%.2f", *totalPrice))
@@ -247,7 +248,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 	if cmd.Passwords {
 		if hardware.OperatingSystem != nil && hardware.OperatingSystem.Passwords != nil {
 			buf := new(bytes.Buffer)
-			userTable := terminal.NewTable(buf, []string{T("Username"), T("Password")})
+			userTable := utils.NewTable(buf, []string{T("Username"), T("Password")})
 			for _, pwd := range hardware.OperatingSystem.Passwords {
 				userTable.Add(utils.FormatStringPointer(pwd.Username), utils.FormatStringPointer(pwd.Password))
 			}
@@ -257,7 +258,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 
 		if hardware.RemoteManagementAccounts != nil {
 			buf := new(bytes.Buffer)
-			userTable := terminal.NewTable(buf, []string{T("IPMI_username"), T("Password")})
+			userTable := utils.NewTable(buf, []string{T("IPMI_username"), T("Password")})
 			for _, pwd := range hardware.RemoteManagementAccounts {
 				userTable.Add(utils.FormatStringPointer(pwd.Username), utils.FormatStringPointer(pwd.Password))
 			}
@@ -273,7 +274,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 			return errors.NewAPIError(T("Failed to get components\n"), err.Error(), 2)
 		}
 		buf := new(bytes.Buffer)
-		componentTable := terminal.NewTable(buf, []string{T("Name"), T("Firmware version"), T("Firmware build date"), T("Type")})
+		componentTable := utils.NewTable(buf, []string{T("Name"), T("Firmware version"), T("Firmware build date"), T("Type")})
 		for _, component := range components {
 			if utils.IntInSlice(*component.Id, componentIds) == -1 {
 				componentTable.Add(
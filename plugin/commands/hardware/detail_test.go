@@ -40,7 +40,7 @@ var _ = Describe("hardware detail", func() {
 			It("return error", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "abc")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Invalid input for 'Hardware server ID'. It must be a positive integer."))
+				Expect(err.Error()).To(ContainSubstring("abc matches more than one hardware server"))
 			})
 		})
 
@@ -0,0 +1,169 @@
+package hardware
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/spf13/cobra"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/cmdutils"
+	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
+)
+
+// Everything hw diff compares
+const DIFF_HARDWARE_MASK = "id,hostname,fullyQualifiedDomainName,datacenter.name,processorPhysicalCoreAmount,memoryCapacity,notes," +
+	"hourlyBillingFlag,privateNetworkOnlyFlag,operatingSystemReferenceCode," +
+	"operatingSystem.softwareLicense.softwareDescription[longDescription,version]," +
+	"billingItem[recurringFee,hourlyRecurringFee,orderItem.preset.keyName]," +
+	"hardDrives.hardwareComponentModel.hardwareGenericComponentModel[capacity,units]," +
+	"primaryNetworkComponent.maxSpeed,primaryBackendNetworkComponent.maxSpeed,networkVlans[id,networkSpace]," +
+	"tagReferences.tag.name,userData.value"
+
+type DiffCommand struct {
+	*metadata.SoftlayerCommand
+	HardwareManager managers.HardwareServerManager
+	Command         *cobra.Command
+	All             bool
+}
+
+func NewDiffCommand(sl *metadata.SoftlayerCommand) (cmd *DiffCommand) {
+	thisCmd := &DiffCommand{
+		SoftlayerCommand: sl,
+		HardwareManager:  managers.NewHardwareServerManager(sl.Session),
+	}
+	cobraCmd := &cobra.Command{
+		Use:   "diff " + T("IDENTIFIER") + " " + T("IDENTIFIER"),
+		Short: T("Compare the configuration of two hardware servers"),
+		Long: T(`${COMMAND_NAME} sl hw diff IDENTIFIER IDENTIFIER [OPTIONS]
+
+Compares the OS, size, CPU, memory, disks, port speeds, VLANs, tags, userdata, notes and billing.
+
+EXAMPLE:
+   ${COMMAND_NAME} sl hw diff db1 db2
+   Shows the settings that are not the same on db1 and db2.
+   ${COMMAND_NAME} sl hw diff 123456 654321 --all --output JSON
+   Shows every setting of both hardware servers in JSON, with whether they are the same.`),
+		Args: metadata.TwoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return thisCmd.Run(args)
+		},
+	}
+	cobraCmd.Flags().BoolVar(&thisCmd.All, "all", false, T("Show the settings that are the same too"))
+	cobraCmd.ValidArgsFunction = client.CompleteEveryIdentifier(sl.Session, "hardware", func() ([]string, error) {
+		return managers.HardwareCompletions(thisCmd.HardwareManager)
+	})
+	thisCmd.Command = cobraCmd
+	return thisCmd
+}
+
+func (cmd *DiffCommand) Run(args []string) error {
+	servers := []datatypes.Hardware_Server{}
+	for _, identifier := range args {
+		hardwareId, err := managers.ResolveHardwareId(cmd.HardwareManager, identifier)
+		if err != nil {
+			return err
+		}
+		server, err := cmd.HardwareManager.GetHardware(hardwareId, DIFF_HARDWARE_MASK)
+		if err != nil {
+			return slErrors.NewAPIError(T("Failed to get hardware server: {{.ID}}.\n", map[string]interface{}{"ID": hardwareId}), err.Error(), 2)
+		}
+		servers = append(servers, server)
+	}
+	fields, err := cmdutils.DiffConfigs(HardwareConfig(servers[0]), HardwareConfig(servers[1]))
+	if err != nil {
+		return err
+	}
+	return cmdutils.PrintDiff(cmd.SoftlayerCommand, diffName(servers[0]), diffName(servers[1]), fields, cmd.All)
+}
+
+// The settings of server that hw diff compares
+func HardwareConfig(server datatypes.Hardware_Server) []cmdutils.ConfigValue {
+	osName, osVersion := "", ""
+	if server.OperatingSystem != nil && server.OperatingSystem.SoftwareLicense != nil && server.OperatingSystem.SoftwareLicense.SoftwareDescription != nil {
+		osName = utils.StringPointertoString(server.OperatingSystem.SoftwareLicense.SoftwareDescription.LongDescription)
+		osVersion = utils.StringPointertoString(server.OperatingSystem.SoftwareLicense.SoftwareDescription.Version)
+	}
+	size := ""
+	var billingItem *datatypes.Billing_Item
+	if server.BillingItem != nil {
+		billingItem = &server.BillingItem.Billing_Item
+		if server.BillingItem.OrderItem != nil && server.BillingItem.OrderItem.Preset != nil {
+			size = utils.StringPointertoString(server.BillingItem.OrderItem.Preset.KeyName)
+		}
+	}
+	datacenter := ""
+	if server.Datacenter != nil {
+		datacenter = utils.StringPointertoString(server.Datacenter.Name)
+	}
+	disks := []string{}
+	for _, drive := range server.HardDrives {
+		if drive.HardwareComponentModel == nil || drive.HardwareComponentModel.HardwareGenericComponentModel == nil {
+			continue
+		}
+		generic := drive.HardwareComponentModel.HardwareGenericComponentModel
+		if generic.Capacity != nil {
+			disks = append(disks, fmt.Sprintf("%.0f %s", *generic.Capacity, utils.StringPointertoString(generic.Units)))
+		}
+	}
+	publicVlans, privateVlans := []string{}, []string{}
+	for _, vlan := range server.NetworkVlans {
+		if vlan.Id == nil {
+			continue
+		}
+		if strings.ToUpper(utils.StringPointertoString(vlan.NetworkSpace)) == "PUBLIC" {
+			publicVlans = append(publicVlans, strconv.Itoa(*vlan.Id))
+		} else {
+			privateVlans = append(privateVlans, strconv.Itoa(*vlan.Id))
+		}
+	}
+	publicSpeed, privateSpeed := "", ""
+	if server.PrimaryNetworkComponent != nil {
+		publicSpeed = cmdutils.DiffInt(server.PrimaryNetworkComponent.MaxSpeed)
+	}
+	if server.PrimaryBackendNetworkComponent != nil {
+		privateSpeed = cmdutils.DiffInt(server.PrimaryBackendNetworkComponent.MaxSpeed)
+	}
+	tags := []string{}
+	for _, reference := range server.TagReferences {
+		if reference.Tag != nil && reference.Tag.Name != nil {
+			tags = append(tags, *reference.Tag.Name)
+		}
+	}
+	userData := []string{}
+	for _, attribute := range server.UserData {
+		userData = append(userData, utils.StringPointertoString(attribute.Value))
+	}
+	config := []cmdutils.ConfigValue{
+		{Key: "datacenter", Label: T("Datacenter"), Value: datacenter},
+		{Key: "os", Label: T("OS"), Value: osName},
+		{Key: "osVersion", Label: T("OS version"), Value: osVersion},
+		{Key: "osCode", Label: T("OS code"), Value: utils.StringPointertoString(server.OperatingSystemReferenceCode)},
+		{Key: "size", Label: T("Size"), Value: size},
+		{Key: "cpu", Label: T("CPU"), Value: cmdutils.DiffUint(server.ProcessorPhysicalCoreAmount)},
+		{Key: "memory", Label: T("Memory"), Value: cmdutils.DiffUint(server.MemoryCapacity)},
+		{Key: "disks", Label: T("Disks"), Value: cmdutils.DiffList(disks)},
+		{Key: "privateOnly", Label: T("Private network only"), Value: cmdutils.DiffBool(server.PrivateNetworkOnlyFlag)},
+		{Key: "publicPortSpeed", Label: T("Public port speed"), Value: publicSpeed},
+		{Key: "privatePortSpeed", Label: T("Private port speed"), Value: privateSpeed},
+		{Key: "publicVlan", Label: T("Public VLAN ID"), Value: cmdutils.DiffList(publicVlans)},
+		{Key: "privateVlan", Label: T("Private VLAN ID"), Value: cmdutils.DiffList(privateVlans)},
+		{Key: "tags", Label: T("Tags"), Value: cmdutils.DiffList(tags)},
+		{Key: "userData", Label: T("User data"), Value: cmdutils.DiffList(userData)},
+		{Key: "notes", Label: T("Notes"), Value: utils.StringPointertoString(server.Notes)},
+	}
+	return append(config, cmdutils.DiffBilling(server.HourlyBillingFlag, billingItem)...)
+}
+
+func diffName(server datatypes.Hardware_Server) string {
+	if server.FullyQualifiedDomainName != nil {
+		return *server.FullyQualifiedDomainName
+	}
+	return utils.FormatIntPointer(server.Id)
+}
@@ -0,0 +1,105 @@
+package hardware_test
+
+import (
+	"errors"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/hardware"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
+)
+
+var _ = Describe("hardware diff", func() {
+	var (
+		fakeUI        *terminal.FakeUI
+		cliCommand    *hardware.DiffCommand
+		fakeSession   *session.Session
+		slCommand     *metadata.SoftlayerCommand
+		fakeHWManager *testhelpers.FakeHardwareServerManager
+	)
+	server := func(name string, memory uint, privateVlan int, notes string) datatypes.Hardware_Server {
+		return datatypes.Hardware_Server{Hardware: datatypes.Hardware{
+			Id:                          sl.Int(1),
+			FullyQualifiedDomainName:    sl.String(name),
+			ProcessorPhysicalCoreAmount: sl.Uint(16),
+			MemoryCapacity:              sl.Uint(memory),
+			HourlyBillingFlag:           sl.Bool(false),
+			Notes:                       sl.String(notes),
+			BillingItem: &datatypes.Billing_Item_Hardware{Billing_Item: datatypes.Billing_Item{
+				RecurringFee: sl.Float(850),
+				OrderItem:    &datatypes.Billing_Order_Item{Preset: &datatypes.Product_Package_Preset{KeyName: sl.String("S1270_32GB_2X1TBSATA_NORAID")}},
+			}},
+			NetworkVlans: []datatypes.Network_Vlan{
+				{Id: sl.Int(111), NetworkSpace: sl.String("PUBLIC")},
+				{Id: sl.Int(privateVlan), NetworkSpace: sl.String("PRIVATE")},
+			},
+			PrimaryNetworkComponent: &datatypes.Network_Component{MaxSpeed: sl.Int(1000)},
+			HardDrives: []datatypes.Hardware_Component{{HardwareComponentModel: &datatypes.Hardware_Component_Model{
+				HardwareGenericComponentModel: &datatypes.Hardware_Component_Model_Generic{Capacity: sl.Float(1000), Units: sl.String("GB")},
+			}}},
+		}}
+	}
+	BeforeEach(func() {
+		fakeUI = terminal.NewFakeUI()
+		fakeSession = testhelpers.NewFakeSoftlayerSession([]string{})
+		fakeHWManager = new(testhelpers.FakeHardwareServerManager)
+		slCommand = metadata.NewSoftlayerCommand(fakeUI, fakeSession)
+		cliCommand = hardware.NewDiffCommand(slCommand)
+		cliCommand.Command.PersistentFlags().Var(cliCommand.OutputFlag, "output", "--output=JSON for json output.")
+		cliCommand.HardwareManager = fakeHWManager
+		fakeHWManager.GetHardwareReturnsOnCall(0, server("db1.example.com", 32, 222, "primary"), nil)
+		fakeHWManager.GetHardwareReturnsOnCall(1, server("db2.example.com", 64, 333, ""), nil)
+	})
+
+	Describe("hardware diff", func() {
+		It("Needs two identifiers", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "1234")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Incorrect Usage: This command requires two arguments."))
+		})
+		It("Shows the settings that differ", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "1234", "5678")
+			Expect(err).NotTo(HaveOccurred())
+			id, mask := fakeHWManager.GetHardwareArgsForCall(0)
+			Expect(id).To(Equal(1234))
+			Expect(mask).To(Equal(hardware.DIFF_HARDWARE_MASK))
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`Memory\s+32\s+64`))
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`Private VLAN ID\s+222\s+333`))
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`Notes\s+primary\s+-`))
+			Expect(fakeUI.Outputs()).NotTo(ContainSubstring("Public VLAN ID"))
+		})
+		It("Shows every setting with --all", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "1234", "5678", "--all")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`Size\s+S1270_32GB_2X1TBSATA_NORAID\s+S1270_32GB_2X1TBSATA_NORAID`))
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`Disks\s+1000 GB\s+1000 GB`))
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`Billing\s+Monthly\s+Monthly`))
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`Monthly fee\s+850.00\s+850.00`))
+		})
+		It("Prints JSON", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "1234", "5678", "--output", "JSON")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(ContainSubstring(`"field": "memory",`))
+			Expect(fakeUI.Outputs()).To(ContainSubstring(`"second": "64",`))
+		})
+		It("Prints the billing keywords in JSON", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "1234", "5678", "--all", "--output", "JSON")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`"field": "billing",\s+"first": "monthly",\s+"second": "monthly",`))
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`"field": "monthlyFee",\s+"first": "850.00",`))
+			Expect(fakeUI.Outputs()).To(ContainSubstring(`"field": "privatePortSpeed",`))
+		})
+		It("Returns the API error", func() {
+			fakeHWManager.GetHardwareReturnsOnCall(0, datatypes.Hardware_Server{}, errors.New("Internal Server Error"))
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "1234", "5678")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Failed to get hardware server: 1234."))
+		})
+	})
+})
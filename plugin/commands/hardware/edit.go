@@ -3,11 +3,11 @@ package hardware
 import (
 	"errors"
 	"io/ioutil"
-	"strconv"
 
 	bmxErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 
 	"github.com/spf13/cobra"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -51,14 +51,17 @@ func NewEditCommand(sl *metadata.SoftlayerCommand) (cmd *EditCommand) {
 	cobraCmd.Flags().IntVarP(&thisCmd.PublicSpeed, "public-speed", "p", 0, T("Public port speed, options are: 0,10,100,1000,10000"))
 	cobraCmd.Flags().IntVarP(&thisCmd.PrivateSpeed, "private-speed", "v", 0, T("Private port speed, options are: 0,10,100,1000,10000"))
 
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "hardware", func() ([]string, error) {
+		return managers.HardwareCompletions(thisCmd.HardwareManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *EditCommand) Run(args []string) error {
-	hardwareId, err := strconv.Atoi(args[0])
+	hardwareId, err := managers.ResolveHardwareId(cmd.HardwareManager, args[0])
 	if err != nil {
-		return slErrors.NewInvalidSoftlayerIdInputError("Hardware server ID")
+		return err
 	}
 	var userData, tagString string
 	var publicSpeed, privateSpeed int
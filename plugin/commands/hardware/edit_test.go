@@ -40,7 +40,7 @@ var _ = Describe("hardware edit", func() {
 			It("return error", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "abc")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Invalid input for 'Hardware server ID'. It must be a positive integer."))
+				Expect(err.Error()).To(ContainSubstring("Unable to find hardware server"))
 			})
 		})
 		Context("hardware edit with both -u and -F", func() {
@@ -23,6 +23,7 @@ func SetupCobraCommands(sl *metadata.SoftlayerCommand) *cobra.Command {
 	cobraCmd.AddCommand(NewCreateOptionsCommand(sl).Command)
 	cobraCmd.AddCommand(NewCredentialsCommand(sl).Command)
 	cobraCmd.AddCommand(NewDetailCommand(sl).Command)
+	cobraCmd.AddCommand(NewDiffCommand(sl).Command)
 	cobraCmd.AddCommand(NewEditCommand(sl).Command)
 	cobraCmd.AddCommand(NewListCommand(sl).Command)
 	cobraCmd.AddCommand(NewPowerCycleCommand(sl).Command)
@@ -28,6 +28,7 @@ var availableCommands = []string{
 	"create-options",
 	"credentials",
 	"detail",
+	"diff",
 	"edit",
 	"list",
 	"monitoring-list",
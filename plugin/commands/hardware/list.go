@@ -16,6 +16,7 @@ type ListCommand struct {
 	*metadata.SoftlayerCommand
 	HardwareManager managers.HardwareServerManager
 	Command         *cobra.Command
+	Paging          metadata.Paging
 	Cpu             int
 	Domain          string
 	Hostname        string
@@ -60,6 +61,7 @@ func NewListCommand(sl *metadata.SoftlayerCommand) (cmd *ListCommand) {
 	cobraCmd.Flags().StringVar(&thisCmd.Sortby, "sortby", "", T("Column to sort by, default:hostname, option:id,guid,hostname,domain,public_ip,private_ip,cpu,memory,os,datacenter,status,ipmi_ip,created,created_by"))
 	cobraCmd.Flags().StringSliceVar(&thisCmd.Column, "column", []string{}, T("Column to display,  options are: id,hostname,domain,public_ip,private_ip,datacenter,status,guid,cpu,memory,os,ipmi_ip,created,created_by,tags. This option can be specified multiple times"))
 
+	metadata.AddPagingFlags(cobraCmd, &thisCmd.Paging)
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
@@ -104,7 +106,7 @@ func (cmd *ListCommand) Run(args []string) error {
 
 	mask := utils.GetMask(maskMap, showColumns, sortby)
 
-	hws, err := cmd.HardwareManager.ListHardware(cmd.Tag, cmd.Cpu, cmd.Memory, cmd.Hostname, cmd.Domain, cmd.Datacenter, cmd.Network, cmd.PublicIp, cmd.PrivateIp, cmd.Owner, cmd.Order, mask)
+	hws, err := cmd.HardwareManager.ListHardware(cmd.Tag, cmd.Cpu, cmd.Memory, cmd.Hostname, cmd.Domain, cmd.Datacenter, cmd.Network, cmd.PublicIp, cmd.PrivateIp, cmd.Owner, cmd.Order, mask, cmd.Paging)
 	if err != nil {
 		return errors.NewAPIError(T("Failed to get hardware servers on your account.\n"), err.Error(), 2)
 	}
@@ -2,13 +2,11 @@ package hardware
 
 import (
 	"bytes"
-	"strconv"
 
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
 	"github.com/spf13/cobra"
 
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
-	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
@@ -36,14 +34,17 @@ func NewMonitoringListCommand(sl *metadata.SoftlayerCommand) (cmd *MonitoringLis
 		},
 	}
 
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "hardware", func() ([]string, error) {
+		return managers.HardwareCompletions(thisCmd.HardwareManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *MonitoringListCommand) Run(args []string) error {
-	hardwareId, err := strconv.Atoi(args[0])
+	hardwareId, err := managers.ResolveHardwareId(cmd.HardwareManager, args[0])
 	if err != nil {
-		return slErr.NewInvalidSoftlayerIdInputError("Hardware server ID")
+		return err
 	}
 
 	outputFormat := cmd.GetOutputFlag()
@@ -53,6 +54,9 @@ func (cmd *MonitoringListCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to get hardware server: {{.ID}}.\n", map[string]interface{}{"ID": hardwareId}), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, hardware)
+	}
 
 	table := cmd.UI.Table([]string{T("Name"), T("Value")})
 	table.Add(T("Domain"), utils.FormatStringPointer(hardware.Domain))
@@ -64,7 +68,7 @@ func (cmd *MonitoringListCommand) Run(args []string) error {
 
 	if monitors := hardware.NetworkMonitors; len(monitors) > 0 {
 		buf := new(bytes.Buffer)
-		monitorTable := terminal.NewTable(buf, []string{T("Id"), T("IpAddress"), T("Status"), T("Type"), T("Notify")})
+		monitorTable := utils.NewTable(buf, []string{T("Id"), T("IpAddress"), T("Status"), T("Type"), T("Notify")})
 		for _, monitor := range monitors {
 			monitorTable.Add(
 				utils.FormatIntPointer(monitor.Id),
@@ -78,10 +82,6 @@ func (cmd *MonitoringListCommand) Run(args []string) error {
 		table.Add("Monitors", buf.String())
 	}
 
-	if outputFormat == "JSON" {
-		table.PrintJson()
-	} else {
-		table.Print()
-	}
+	table.Print()
 	return nil
 }
@@ -43,13 +43,13 @@ var _ = Describe("hardware monitoring list", func() {
 			It("Set command with an invalid id", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "abc")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Invalid input for 'Hardware server ID'. It must be a positive integer."))
+				Expect(err.Error()).To(ContainSubstring("Unable to find hardware server"))
 			})
 
 			It("Set command with an invalid output format", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "123456", "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 		})
 
@@ -104,6 +104,13 @@ var _ = Describe("hardware monitoring list", func() {
 				Expect(fakeUI.Outputs()).To(ContainSubstring("SERVICE PING"))
 				Expect(fakeUI.Outputs()).To(ContainSubstring("Do Nothing"))
 			})
+			It("Prints the hardware as JSON", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "123456", "--output=JSON")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"domain": "domain.com"`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"networkMonitors": [`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"actionDescription": "Do Nothing"`))
+			})
 		})
 	})
 })
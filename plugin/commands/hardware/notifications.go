@@ -1,9 +1,8 @@
 package hardware
 
 import (
-	"strconv"
-
 	"github.com/spf13/cobra"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -32,14 +31,17 @@ func NewNotificationsCommand(sl *metadata.SoftlayerCommand) (cmd *NotificationsC
 		},
 	}
 
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "hardware", func() ([]string, error) {
+		return managers.HardwareCompletions(thisCmd.HardwareManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *NotificationsCommand) Run(args []string) error {
-	hardwareId, err := strconv.Atoi(args[0])
+	hardwareId, err := managers.ResolveHardwareId(cmd.HardwareManager, args[0])
 	if err != nil {
-		return slErr.NewInvalidSoftlayerIdInputError("Hardware server ID")
+		return err
 	}
 
 	outputFormat := cmd.GetOutputFlag()
@@ -48,6 +50,9 @@ func (cmd *NotificationsCommand) Run(args []string) error {
 	if err != nil {
 		return slErr.NewAPIError(T("Failed to get User Customer Notifications."), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, userCustomers)
+	}
 
 	table := cmd.UI.Table([]string{T("ID"), T("Last Name"), T("First Name"), T("Email"), T("User ID")})
 	for _, userCustomer := range userCustomers {
@@ -60,6 +65,5 @@ func (cmd *NotificationsCommand) Run(args []string) error {
 		)
 	}
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
@@ -1,10 +1,9 @@
 package hardware
 
 import (
-	"strconv"
-
+	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/spf13/cobra"
-	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
@@ -35,20 +34,24 @@ func NewNotificationsAddCommand(sl *metadata.SoftlayerCommand) (cmd *Notificatio
 
 	cobraCmd.Flags().IntSliceVar(&thisCmd.Users, "users", []int{}, T("User ID to be notified on monitoring failure, multiple occurrence allowed"))
 
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "hardware", func() ([]string, error) {
+		return managers.HardwareCompletions(thisCmd.HardwareManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *NotificationsAddCommand) Run(args []string) error {
-	hardwareId, err := strconv.Atoi(args[0])
+	hardwareId, err := managers.ResolveHardwareId(cmd.HardwareManager, args[0])
 	if err != nil {
-		return slErr.NewInvalidSoftlayerIdInputError("Hardware server ID")
+		return err
 	}
 
 	outputFormat := cmd.GetOutputFlag()
 
 	userIds := cmd.Users
 	printTable := false
+	notifications := []datatypes.User_Customer_Notification_Hardware{}
 
 	table := cmd.UI.Table([]string{T("Id"), T("Hostname"), T("Username"), T("Email"), T("First Name"), T("Last Name")})
 	for _, userId := range userIds {
@@ -59,6 +62,7 @@ func (cmd *NotificationsAddCommand) Run(args []string) error {
 			cmd.UI.Failed(T("Failed to create User Customer Notification with user ID: {{.userID}}."), userIdMap)
 		} else {
 			printTable = true
+			notifications = append(notifications, UserCustomerNotification)
 			table.Add(
 				utils.FormatIntPointer(UserCustomerNotification.Id),
 				utils.FormatStringPointer(UserCustomerNotification.Hardware.FullyQualifiedDomainName),
@@ -70,8 +74,13 @@ func (cmd *NotificationsAddCommand) Run(args []string) error {
 		}
 	}
 
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, notifications)
+	}
 	if printTable {
-		utils.PrintTable(cmd.UI, table, outputFormat)
+		if err := utils.PrintTable(cmd.UI, table, outputFormat); err != nil {
+			return err
+		}
 	}
 	return nil
 }
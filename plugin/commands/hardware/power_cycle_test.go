@@ -43,7 +43,7 @@ var _ = Describe("hardware powercycle", func() {
 			It("return error", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "abc")
 				Expect(err).To(HaveOccurred())
-				Expect(strings.Contains(err.Error(), "Invalid input for 'Hardware server ID'. It must be a positive integer.")).To(BeTrue())
+				Expect(strings.Contains(err.Error(), "Unable to find hardware server")).To(BeTrue())
 			})
 		})
 
@@ -1,11 +1,10 @@
 package hardware
 
 import (
-	"strconv"
-
 	"github.com/spf13/cobra"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/cmdutils"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
-	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
@@ -16,6 +15,7 @@ type PowerOffCommand struct {
 	HardwareManager managers.HardwareServerManager
 	Command         *cobra.Command
 	ForceFlag       bool
+	Selector        cmdutils.ServerSelector
 }
 
 func NewPowerOffCommand(sl *metadata.SoftlayerCommand) (cmd *PowerOffCommand) {
@@ -25,24 +25,39 @@ func NewPowerOffCommand(sl *metadata.SoftlayerCommand) (cmd *PowerOffCommand) {
 	}
 
 	cobraCmd := &cobra.Command{
-		Use:   "power-off " + T("IDENTIFIER"),
+		Use:   "power-off " + T("IDENTIFIER") + "...",
 		Short: T("Power off an active server"),
-		Args:  metadata.OneArgs,
+		Args:  cmdutils.ServerSelectorArgs(&thisCmd.Selector),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return thisCmd.Run(args)
 		},
 	}
 
 	cobraCmd.Flags().BoolVarP(&thisCmd.ForceFlag, "force", "f", false, T("Force operation without confirmation"))
+	cmdutils.AddServerSelectorFlags(cobraCmd, &thisCmd.Selector)
 
+	cobraCmd.ValidArgsFunction = client.CompleteEveryIdentifier(sl.Session, "hardware", func() ([]string, error) {
+		return managers.HardwareCompletions(thisCmd.HardwareManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *PowerOffCommand) Run(args []string) error {
-	hardwareId, err := strconv.Atoi(args[0])
+	if cmd.Selector.IsBulk(args) {
+		servers, err := selectHardwareServers(cmd.HardwareManager, args, cmd.Selector)
+		if err != nil {
+			return err
+		}
+		question := T("This will power off {{.COUNT}} hardware servers. Continue?", map[string]interface{}{"COUNT": len(servers)})
+		return cmdutils.RunBulk(cmd.SoftlayerCommand, servers, cmd.ForceFlag, question, func(id int) error {
+			return cmd.HardwareManager.PowerOff(id)
+		})
+	}
+
+	hardwareId, err := managers.ResolveHardwareId(cmd.HardwareManager, args[0])
 	if err != nil {
-		return slErr.NewInvalidSoftlayerIdInputError("Hardware server ID")
+		return err
 	}
 
 	if !cmd.ForceFlag {
@@ -1,11 +1,10 @@
 package hardware
 
 import (
-	"strconv"
-
 	"github.com/spf13/cobra"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/cmdutils"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
-	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
@@ -18,6 +17,7 @@ type RebootCommand struct {
 	Hard            bool
 	Soft            bool
 	ForceFlag       bool
+	Selector        cmdutils.ServerSelector
 }
 
 func NewRebootCommand(sl *metadata.SoftlayerCommand) (cmd *RebootCommand) {
@@ -28,9 +28,9 @@ func NewRebootCommand(sl *metadata.SoftlayerCommand) (cmd *RebootCommand) {
 	}
 
 	cobraCmd := &cobra.Command{
-		Use:   "reboot " + T("IDENTIFIER"),
+		Use:   "reboot " + T("IDENTIFIER") + "...",
 		Short: T("Reboot an active server"),
-		Args:  metadata.OneArgs,
+		Args:  cmdutils.ServerSelectorArgs(&thisCmd.Selector),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return thisCmd.Run(args)
 		},
@@ -39,20 +39,34 @@ func NewRebootCommand(sl *metadata.SoftlayerCommand) (cmd *RebootCommand) {
 	cobraCmd.Flags().BoolVar(&thisCmd.Hard, "hard", false, T("Perform a hard reboot"))
 	cobraCmd.Flags().BoolVar(&thisCmd.Soft, "soft", false, T("Perform a soft reboot"))
 	cobraCmd.Flags().BoolVarP(&thisCmd.ForceFlag, "force", "f", false, T("Force operation without confirmation"))
+	cmdutils.AddServerSelectorFlags(cobraCmd, &thisCmd.Selector)
 
+	cobraCmd.ValidArgsFunction = client.CompleteEveryIdentifier(sl.Session, "hardware", func() ([]string, error) {
+		return managers.HardwareCompletions(thisCmd.HardwareManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *RebootCommand) Run(args []string) error {
-	hardwareId, err := strconv.Atoi(args[0])
-	if err != nil {
-		return slErr.NewInvalidSoftlayerIdInputError("Hardware server ID")
-	}
-
 	if cmd.Hard && cmd.Soft {
 		return errors.NewInvalidUsageError(T("Can only specify either --hard or --soft."))
 	}
+	if cmd.Selector.IsBulk(args) {
+		servers, err := selectHardwareServers(cmd.HardwareManager, args, cmd.Selector)
+		if err != nil {
+			return err
+		}
+		question := T("This will reboot {{.COUNT}} hardware servers. Continue?", map[string]interface{}{"COUNT": len(servers)})
+		return cmdutils.RunBulk(cmd.SoftlayerCommand, servers, cmd.ForceFlag, question, func(id int) error {
+			return cmd.HardwareManager.Reboot(id, cmd.Soft, cmd.Hard)
+		})
+	}
+
+	hardwareId, err := managers.ResolveHardwareId(cmd.HardwareManager, args[0])
+	if err != nil {
+		return err
+	}
 
 	if !cmd.ForceFlag {
 		confirm, err := cmd.UI.Confirm(T("This will reboot hardware server: {{.ID}}. Continue?", map[string]interface{}{"ID": hardwareId}))
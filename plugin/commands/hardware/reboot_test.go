@@ -2,11 +2,17 @@ package hardware_test
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
 
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+	"github.com/spf13/cobra"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/hardware"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
@@ -42,7 +48,7 @@ var _ = Describe("hardware reboot", func() {
 			It("return error", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "abc")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Invalid input for 'Hardware server ID'. It must be a positive integer."))
+				Expect(err.Error()).To(ContainSubstring("Unable to find hardware server"))
 			})
 		})
 
@@ -121,4 +127,59 @@ var _ = Describe("hardware reboot", func() {
 			})
 		})
 	})
+	Describe("hardware reboot with several servers", func() {
+		It("Reboots the servers in a file", func() {
+			fileName := filepath.Join(GinkgoT().TempDir(), "servers.txt")
+			Expect(os.WriteFile(fileName, []byte("1234\n5678\n"), 0600)).To(Succeed())
+			fakeUI.Inputs("Yes")
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "--from-file", fileName, "--hard")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(ContainSubstring("This will reboot 2 hardware servers. Continue?"))
+			Expect(fakeHardwareManager.RebootCallCount()).To(Equal(2))
+			_, soft, hard := fakeHardwareManager.RebootArgsForCall(0)
+			Expect(soft).To(BeFalse())
+			Expect(hard).To(BeTrue())
+		})
+		It("Selects servers with the filters", func() {
+			fakeHardwareManager.ListHardwareReturns([]datatypes.Hardware_Server{
+				{Hardware: datatypes.Hardware{Id: sl.Int(1234), Hostname: sl.String("bare1"), Domain: sl.String("example.com")}},
+			}, nil)
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "--datacenter", "dal13", "-f")
+			Expect(err).NotTo(HaveOccurred())
+			_, _, _, _, _, datacenter, _, _, _, _, _, _, _ := fakeHardwareManager.ListHardwareArgsForCall(0)
+			Expect(datacenter).To(Equal("dal13"))
+			id, _, _ := fakeHardwareManager.RebootArgsForCall(0)
+			Expect(id).To(Equal(1234))
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`1234\s+bare1.example.com\s+Done`))
+		})
+	})
+	Describe("hardware reboot completion", func() {
+		BeforeEach(func() {
+			os.Setenv(client.ENV_SL_CACHE_DIR, GinkgoT().TempDir())
+			fakeHardwareManager.ListHardwareReturns([]datatypes.Hardware_Server{
+				{Hardware: datatypes.Hardware{Id: sl.Int(1234), Hostname: sl.String("bare1"), Domain: sl.String("example.com")}},
+			}, nil)
+		})
+		AfterEach(func() {
+			os.Unsetenv(client.ENV_SL_CACHE_DIR)
+		})
+		It("Completes hardware server IDs", func() {
+			completions, directive := cliCommand.Command.ValidArgsFunction(cliCommand.Command, []string{}, "")
+			Expect(completions).To(Equal([]string{"1234\tbare1.example.com"}))
+			Expect(directive).To(Equal(cobra.ShellCompDirectiveNoFileComp))
+		})
+		It("Completes nothing when the list fails", func() {
+			fakeHardwareManager.ListHardwareReturns(nil, errors.New("Internal Server Error"))
+			completions, _ := cliCommand.Command.ValidArgsFunction(cliCommand.Command, []string{}, "")
+			Expect(completions).To(BeEmpty())
+		})
+		It("Completes every argument without the servers already given", func() {
+			fakeHardwareManager.ListHardwareReturns([]datatypes.Hardware_Server{
+				{Hardware: datatypes.Hardware{Id: sl.Int(1234), Hostname: sl.String("bare1"), Domain: sl.String("example.com")}},
+				{Hardware: datatypes.Hardware{Id: sl.Int(5678), Hostname: sl.String("bare2"), Domain: sl.String("example.com")}},
+			}, nil)
+			completions, _ := cliCommand.Command.ValidArgsFunction(cliCommand.Command, []string{"1234"}, "")
+			Expect(completions).To(Equal([]string{"5678\tbare2.example.com"}))
+		})
+	})
 })
@@ -1,11 +1,10 @@
 package hardware
 
 import (
-	"strconv"
-
 	"github.com/spf13/cobra"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/cmdutils"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
-	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
@@ -20,6 +19,7 @@ type ReloadCommand struct {
 	UpgradeBios     bool
 	UpgradeFirmware bool
 	ForceFlag       bool
+	Wait            cmdutils.WaitOptions
 }
 
 func NewReloadCommand(sl *metadata.SoftlayerCommand) (cmd *ReloadCommand) {
@@ -42,15 +42,19 @@ func NewReloadCommand(sl *metadata.SoftlayerCommand) (cmd *ReloadCommand) {
 	cobraCmd.Flags().BoolVarP(&thisCmd.UpgradeBios, "upgrade-bios", "b", false, T("Upgrade BIOS"))
 	cobraCmd.Flags().BoolVarP(&thisCmd.UpgradeFirmware, "upgrade-firmware", "w", false, T("Upgrade all hard drives' firmware"))
 	cobraCmd.Flags().BoolVarP(&thisCmd.ForceFlag, "force", "f", false, T("Force operation without confirmation"))
+	cmdutils.AddWaitFlags(cobraCmd, &thisCmd.Wait, T("the reload is finished"))
 
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "hardware", func() ([]string, error) {
+		return managers.HardwareCompletions(thisCmd.HardwareManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *ReloadCommand) Run(args []string) error {
-	hardwareId, err := strconv.Atoi(args[0])
+	hardwareId, err := managers.ResolveHardwareId(cmd.HardwareManager, args[0])
 	if err != nil {
-		return slErr.NewInvalidSoftlayerIdInputError("Hardware server ID")
+		return err
 	}
 	if !cmd.ForceFlag {
 		confirm, err := cmd.UI.Confirm(T("This will reload operating system for hardware server: {{.ID}}. Continue?", map[string]interface{}{"ID": hardwareId}))
@@ -62,11 +66,26 @@ func (cmd *ReloadCommand) Run(args []string) error {
 			return nil
 		}
 	}
+	lastTransactionId := 0
+	if cmd.Wait.Enabled() {
+		lastTransactionId, err = managers.HardwareLastTransactionId(cmd.HardwareManager, hardwareId)
+		if err != nil {
+			return errors.NewAPIError(T("Failed to get hardware server: {{.ID}}.\n", map[string]interface{}{"ID": hardwareId}), err.Error(), 2)
+		}
+	}
 	err = cmd.HardwareManager.Reload(hardwareId, cmd.Postinstall, cmd.Key, cmd.UpgradeBios, cmd.UpgradeFirmware)
 	if err != nil {
 		return errors.NewAPIError(T("Failed to reload operating system for hardware server: {{.ID}}.\n", map[string]interface{}{"ID": hardwareId}), err.Error(), 2)
 	}
 	cmd.UI.Ok()
-	cmd.UI.Print(T("Started to reload operating system for hardware server: {{.ID}}.", map[string]interface{}{"ID": hardwareId}))
+	subs := map[string]interface{}{"ID": hardwareId}
+	cmd.UI.Print(T("Started to reload operating system for hardware server: {{.ID}}.", subs))
+	if cmd.Wait.Enabled() {
+		err = cmdutils.WaitFor(cmd.SoftlayerCommand, cmd.Wait, T("hardware server {{.ID}}", subs), managers.HardwareOperationCheck(cmd.HardwareManager, hardwareId, lastTransactionId))
+		if err != nil {
+			return err
+		}
+		cmd.UI.Print(T("Hardware server {{.ID}} is ready.", subs))
+	}
 	return nil
 }
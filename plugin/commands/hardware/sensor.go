@@ -1,11 +1,10 @@
 package hardware
 
 import (
-	"strconv"
-
+	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/spf13/cobra"
-	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -37,6 +36,9 @@ func NewSensorCommand(sl *metadata.SoftlayerCommand) (cmd *SensorCommand) {
 
 	cobraCmd.Flags().BoolVar(&thisCmd.Discrete, "discrete", false, T("Show discrete units associated hardware sensor"))
 
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "hardware", func() ([]string, error) {
+		return managers.HardwareCompletions(thisCmd.HardwareManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
@@ -44,9 +46,9 @@ func NewSensorCommand(sl *metadata.SoftlayerCommand) (cmd *SensorCommand) {
 func (cmd *SensorCommand) Run(args []string) error {
 	outputFormat := cmd.GetOutputFlag()
 
-	hardwareId, err := strconv.Atoi(args[0])
+	hardwareId, err := managers.ResolveHardwareId(cmd.HardwareManager, args[0])
 	if err != nil {
-		return slErr.NewInvalidSoftlayerIdInputError("Hardware ID")
+		return err
 	}
 
 	displayDiscrateTable := false
@@ -59,6 +61,16 @@ func (cmd *SensorCommand) Run(args []string) error {
 		return errors.NewAPIError(T("Failed to get hardware sensor data.\n"), err.Error(), 2)
 	}
 
+	if outputFormat == "JSON" {
+		sensors := []datatypes.Container_RemoteManagement_SensorReading{}
+		for _, sensor := range sensorsData {
+			if displayDiscrateTable || sensor.SensorUnits == nil || *sensor.SensorUnits != "discrete" {
+				sensors = append(sensors, sensor)
+			}
+		}
+		return utils.PrintPrettyJSON(cmd.UI, sensors)
+	}
+
 	temperatureTable := cmd.UI.Table([]string{T("Temperature (°C) Sensor"), T("Status"), T("Reading"), T("Critical Min"), T("Min"), T("Max"), T("Critical Max")})
 	voltsTable := cmd.UI.Table([]string{T("Volts Sensor"), T("Status"), T("Reading"), T("Critical Min"), T("Min"), T("Max"), T("Critical Max")})
 	wattsTable := cmd.UI.Table([]string{T("Watts Sensor"), T("Status"), T("Reading"), T("Critical Min"), T("Min"), T("Max"), T("Critical Max")})
@@ -125,16 +137,26 @@ func (cmd *SensorCommand) Run(args []string) error {
 		}
 	}
 
-	utils.PrintTable(cmd.UI, temperatureTable, outputFormat)
+	if err := utils.PrintTable(cmd.UI, temperatureTable, outputFormat); err != nil {
+		return err
+	}
 	cmd.UI.Print("\n")
-	utils.PrintTable(cmd.UI, voltsTable, outputFormat)
+	if err := utils.PrintTable(cmd.UI, voltsTable, outputFormat); err != nil {
+		return err
+	}
 	cmd.UI.Print("\n")
-	utils.PrintTable(cmd.UI, wattsTable, outputFormat)
+	if err := utils.PrintTable(cmd.UI, wattsTable, outputFormat); err != nil {
+		return err
+	}
 	cmd.UI.Print("\n")
-	utils.PrintTable(cmd.UI, rpmTable, outputFormat)
+	if err := utils.PrintTable(cmd.UI, rpmTable, outputFormat); err != nil {
+		return err
+	}
 	if displayDiscrateTable {
 		cmd.UI.Print("\n")
-		utils.PrintTable(cmd.UI, discreteTable, outputFormat)
+		if err := utils.PrintTable(cmd.UI, discreteTable, outputFormat); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -2,11 +2,10 @@ package hardware
 
 import (
 	"fmt"
-	"strconv"
 
 	"github.com/spf13/cobra"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
-	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
@@ -34,14 +33,17 @@ func NewStorageCommand(sl *metadata.SoftlayerCommand) (cmd *StorageCommand) {
 		},
 	}
 
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "hardware", func() ([]string, error) {
+		return managers.HardwareCompletions(thisCmd.HardwareManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *StorageCommand) Run(args []string) error {
-	hardwareID, err := strconv.Atoi(args[0])
+	hardwareID, err := managers.ResolveHardwareId(cmd.HardwareManager, args[0])
 	if err != nil {
-		return slErr.NewInvalidSoftlayerIdInputError("Hardware server ID")
+		return err
 	}
 
 	outputFormat := cmd.GetOutputFlag()
@@ -66,6 +68,15 @@ func (cmd *StorageCommand) Run(args []string) error {
 		return errors.NewAPIError(T("Failed to get the hard drives detail for the hardware server {{.ID}}.\n", map[string]interface{}{"ID": hardwareID}), err.Error(), 2)
 	}
 
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, map[string]interface{}{
+			"credentials": storageCredentials,
+			"iscsi":       iscsiStorageData,
+			"nas":         nasStorageData,
+			"hardDrives":  hardDrives,
+		})
+	}
+
 	cmd.UI.Print("Block Storage Details\niSCSI")
 	tableCredentials := cmd.UI.Table([]string{T("Username"), T("Password"), T("IQN")})
 	if storageCredentials.Credential != nil && storageCredentials.Credential.Password != nil {
@@ -74,7 +85,9 @@ func (cmd *StorageCommand) Run(args []string) error {
 			*storageCredentials.Credential.Password,
 			*storageCredentials.Name)
 	}
-	utils.PrintTable(cmd.UI, tableCredentials, outputFormat)
+	if err := utils.PrintTable(cmd.UI, tableCredentials, outputFormat); err != nil {
+		return err
+	}
 
 	tableIscsi := cmd.UI.Table([]string{T("\nLUN name"), T("capacity"), T("Target address"), T("Location"), T("Notes")})
 	for _, iscsi := range iscsiStorageData {
@@ -85,7 +98,9 @@ func (cmd *StorageCommand) Run(args []string) error {
 			*iscsi.AllowedHardware[0].Datacenter.LongName,
 			*iscsi.Notes)
 	}
-	utils.PrintTable(cmd.UI, tableIscsi, outputFormat)
+	if err := utils.PrintTable(cmd.UI, tableIscsi, outputFormat); err != nil {
+		return err
+	}
 
 	cmd.UI.Print("\nFile Storage Details")
 	tableNas := cmd.UI.Table([]string{T("Volume name"), T("capacity"), T("Hostname"), T("Location"), T("Notes")})
@@ -97,7 +112,9 @@ func (cmd *StorageCommand) Run(args []string) error {
 			*nas.AllowedHardware[0].Datacenter.LongName,
 			*nas.Notes)
 	}
-	utils.PrintTable(cmd.UI, tableNas, outputFormat)
+	if err := utils.PrintTable(cmd.UI, tableNas, outputFormat); err != nil {
+		return err
+	}
 
 	cmd.UI.Print("\nOther storage details")
 	tableHardDrives := cmd.UI.Table([]string{T("Type"), T("Name"), T("Capacity"), T("Serial #")})
@@ -109,7 +126,9 @@ func (cmd *StorageCommand) Run(args []string) error {
 
 		tableHardDrives.Add(*typeDrive, name, capacity, *serial)
 	}
-	utils.PrintTable(cmd.UI, tableHardDrives, outputFormat)
+	if err := utils.PrintTable(cmd.UI, tableHardDrives, outputFormat); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -43,7 +43,7 @@ var _ = Describe("TOGGLE-IPMI Tests", func() {
 			It("Bad HardwareID", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "asdf", "--enable")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Invalid input for 'Hardware server ID'."))
+				Expect(err.Error()).To(ContainSubstring("asdf matches more than one hardware server"))
 			})
 			It("Both enable and disble", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "12345", "--enable", "--disable")
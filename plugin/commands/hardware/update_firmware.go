@@ -2,11 +2,10 @@ package hardware
 
 import (
 	"github.com/spf13/cobra"
-	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
-	"strconv"
 )
 
 type UpdateFirmwareCommand struct {
@@ -44,14 +43,17 @@ func NewUpdateFirmwareCommand(sl *metadata.SoftlayerCommand) (cmd *UpdateFirmwar
 	cobraCmd.Flags().BoolVarP(&thisCmd.HDFlag, "harddrive", "d", false, T("Update Hard Drive firmware"))
 	cobraCmd.Flags().BoolVarP(&thisCmd.NetworkFlag, "network", "n", false, T("Update Network Card firmware"))
 
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "hardware", func() ([]string, error) {
+		return managers.HardwareCompletions(thisCmd.HardwareManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *UpdateFirmwareCommand) Run(args []string) error {
-	hardwareId, err := strconv.Atoi(args[0])
+	hardwareId, err := managers.ResolveHardwareId(cmd.HardwareManager, args[0])
 	if err != nil {
-		return errors.NewInvalidSoftlayerIdInputError("Hardware server ID")
+		return err
 	}
 
 	// No options specified, set them all to true
@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/softlayer/softlayer-go/datatypes"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -39,14 +40,17 @@ VLANS is the ID of the VLANs. Multiple vlans can be added at the same time.`),
 		},
 	}
 
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "hardware", func() ([]string, error) {
+		return managers.HardwareCompletions(thisCmd.HardwareManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *VlanAddCommand) Run(args []string) error {
-	hardwareId, err := strconv.Atoi(args[0])
+	hardwareId, err := managers.ResolveHardwareId(cmd.HardwareManager, args[0])
 	if err != nil {
-		return slErr.NewInvalidSoftlayerIdInputError("Hardware server ID")
+		return err
 	}
 
 	outputFormat := cmd.GetOutputFlag()
@@ -66,6 +70,7 @@ func (cmd *VlanAddCommand) Run(args []string) error {
 	// API will return an error if you try to add a public vlan to a private network component
 	pub_vlans := []datatypes.Network_Vlan{}
 	pri_vlans := []datatypes.Network_Vlan{}
+	trunkedVlans := []datatypes.Network_Vlan{}
 	table := cmd.UI.Table([]string{T("Id"), T("VLAN"), T("Network")})
 	for i := 1; i < len(args); i++ {
 		vlan_id, err := strconv.Atoi(args[i])
@@ -92,6 +97,7 @@ func (cmd *VlanAddCommand) Run(args []string) error {
 				if err != nil {
 					return err
 				}
+				trunkedVlans = append(trunkedVlans, added_vlans...)
 				for _, v := range added_vlans {
 					table.Add(
 						utils.FormatIntPointer(v.Id),
@@ -110,6 +116,7 @@ func (cmd *VlanAddCommand) Run(args []string) error {
 				if err != nil {
 					return err
 				}
+				trunkedVlans = append(trunkedVlans, added_vlans...)
 				for _, v := range added_vlans {
 					table.Add(
 						utils.FormatIntPointer(v.Id),
@@ -122,6 +129,8 @@ func (cmd *VlanAddCommand) Run(args []string) error {
 		}
 	}
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, trunkedVlans)
+	}
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
@@ -43,7 +43,7 @@ var _ = Describe("VLAN-REMOVE Tests", func() {
 			It("Bad HardwareID", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "asdf", "12345")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Invalid input for 'Hardware server ID'."))
+				Expect(err.Error()).To(ContainSubstring("asdf matches more than one hardware server"))
 			})
 			It("Bad VlanId", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "12345", "zzzz")
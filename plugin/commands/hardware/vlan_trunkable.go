@@ -1,11 +1,12 @@
 package hardware
 
 import (
-	"strconv"
 
 	// "github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
+	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/spf13/cobra"
 
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -37,14 +38,17 @@ func NewVlanTrunkableCommand(sl *metadata.SoftlayerCommand) (cmd *VlanTrunkableC
 		},
 	}
 
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "hardware", func() ([]string, error) {
+		return managers.HardwareCompletions(thisCmd.HardwareManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *VlanTrunkableCommand) Run(args []string) error {
-	hardwareId, err := strconv.Atoi(args[0])
+	hardwareId, err := managers.ResolveHardwareId(cmd.HardwareManager, args[0])
 	if err != nil {
-		return slErr.NewInvalidSoftlayerIdInputError("Hardware server ID")
+		return err
 	}
 
 	outputFormat := cmd.GetOutputFlag()
@@ -60,19 +64,24 @@ func (cmd *VlanTrunkableCommand) Run(args []string) error {
 		return slErr.NewAPIError(T("Failed to get hardware server: {{.ID}}.\n", subs), err.Error(), 2)
 	}
 
-	table := cmd.UI.Table([]string{T("Id"), T("Fully qualified name"), T("Name"), T("Network")})
+	vlans := []datatypes.Network_Vlan{}
 	for _, component := range hardware.NetworkComponents {
 		if component.PrimaryIpAddress != nil {
-			for _, vlan := range component.NetworkVlansTrunkable {
-				table.Add(
-					utils.FormatIntPointer(vlan.Id),
-					utils.FormatStringPointer(vlan.FullyQualifiedName),
-					utils.FormatStringPointer(vlan.Name),
-					utils.FormatStringPointer(vlan.NetworkSpace),
-				)
-			}
+			vlans = append(vlans, component.NetworkVlansTrunkable...)
 		}
 	}
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, vlans)
+	}
+
+	table := cmd.UI.Table([]string{T("Id"), T("Fully qualified name"), T("Name"), T("Network")})
+	for _, vlan := range vlans {
+		table.Add(
+			utils.FormatIntPointer(vlan.Id),
+			utils.FormatStringPointer(vlan.FullyQualifiedName),
+			utils.FormatStringPointer(vlan.Name),
+			utils.FormatStringPointer(vlan.NetworkSpace),
+		)
+	}
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
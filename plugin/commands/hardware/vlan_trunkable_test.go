@@ -43,7 +43,7 @@ var _ = Describe("VLAN-TRUNKABLE Tests", func() {
 			It("Bad HardwareID", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "asdf")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Invalid input for 'Hardware server ID'."))
+				Expect(err.Error()).To(ContainSubstring("asdf matches more than one hardware server"))
 			})
 		})
 		Context("API Errors", func() {
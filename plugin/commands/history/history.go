@@ -0,0 +1,145 @@
+package history
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
+)
+
+// Used instead of time.Now to work out --since durations
+var Now = time.Now
+
+type HistoryCommand struct {
+	*metadata.SoftlayerCommand
+	HistoryManager managers.HistoryManager
+	Command        *cobra.Command
+	Service        string
+	Method         string
+	Id             int
+	Since          string
+	Failed         bool
+	Paging         metadata.Paging
+}
+
+func NewHistoryCommand(sl *metadata.SoftlayerCommand) *HistoryCommand {
+	thisCmd := &HistoryCommand{
+		SoftlayerCommand: sl,
+		HistoryManager:   managers.NewHistoryManager(sl.Session),
+	}
+	cobraCmd := &cobra.Command{
+		Use:   "history",
+		Short: T("List the API calls that changed something, from the local journal"),
+		Long: T(`${COMMAND_NAME} sl history [OPTIONS]
+
+Every API call that isn't a read (like createObject, placeOrder or reloadOperatingSystem) is written to a local journal
+when the SoftlayerJournal plugin config is true, or SL_JOURNAL_FILE is set to the file to write it to.
+Passwords, tokens, keys and other secrets are replaced with REDACTED before they are written.
+
+EXAMPLE:
+   ${COMMAND_NAME} sl config set SoftlayerJournal true
+   Turns the journal on.
+   ${COMMAND_NAME} sl history --service Virtual_Guest --since 24h
+   Lists the changes made to virtual servers in the last day.
+   ${COMMAND_NAME} sl history --failed --limit 10
+   Lists the last 10 API calls that failed.`),
+		Args: metadata.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return thisCmd.Run(args)
+		},
+	}
+	cobraCmd.Flags().StringVar(&thisCmd.Service, "service", "", T("Only calls to services with this in their name, like Virtual_Guest"))
+	cobraCmd.Flags().StringVar(&thisCmd.Method, "method", "", T("Only calls to methods with this in their name, like placeOrder"))
+	cobraCmd.Flags().IntVar(&thisCmd.Id, "id", 0, T("Only calls made on the object with this ID"))
+	cobraCmd.Flags().StringVar(&thisCmd.Since, "since", "", T("Only calls made after this, a duration like 90m or 7d, or a date like 2006-01-02 or 2006-01-02T15:04:05Z"))
+	cobraCmd.Flags().BoolVar(&thisCmd.Failed, "failed", false, T("Only calls that failed"))
+	metadata.AddPagingFlags(cobraCmd, &thisCmd.Paging)
+	thisCmd.Command = cobraCmd
+	return thisCmd
+}
+
+func (cmd *HistoryCommand) Run(args []string) error {
+	outputFormat := cmd.GetOutputFlag()
+	filter := managers.HistoryFilter{
+		Service:    cmd.Service,
+		Method:     cmd.Method,
+		Id:         cmd.Id,
+		FailedOnly: cmd.Failed,
+		Paging:     cmd.Paging,
+	}
+	if cmd.Since != "" {
+		since, err := parseSince(cmd.Since)
+		if err != nil {
+			return err
+		}
+		filter.Since = since
+	}
+
+	entries, err := cmd.HistoryManager.ListHistory(filter)
+	if err != nil {
+		subs := map[string]interface{}{"FILE": cmd.HistoryManager.JournalFile()}
+		return slErr.NewAPIError(T("Failed to read the journal {{.FILE}}.\n", subs), err.Error(), 2)
+	}
+
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, entries)
+	}
+	if len(entries) == 0 {
+		cmd.UI.Print(T("No API calls found in the journal {{.FILE}}.", map[string]interface{}{"FILE": cmd.HistoryManager.JournalFile()}))
+		return nil
+	}
+	table := cmd.UI.Table([]string{T("Time"), T("User"), T("Command"), T("Method"), T("ID"), T("Status")})
+	for _, entry := range entries {
+		id := utils.EMPTY_VALUE
+		if entry.Id != nil {
+			id = strconv.Itoa(*entry.Id)
+		}
+		status := T("Done")
+		if !entry.Success {
+			status = T("Failed: {{.ERROR}}", map[string]interface{}{"ERROR": entry.Error})
+		}
+		table.Add(
+			entry.Time.UTC().Format(time.RFC3339),
+			historyValue(entry.User),
+			historyValue(entry.CommandLine),
+			fmt.Sprintf("%s::%s", entry.Service, entry.Method),
+			id,
+			status,
+		)
+	}
+	table.Print()
+	return nil
+}
+
+// since is a duration before now (time.ParseDuration, plus days like 7d), or a date with or without the time
+func parseSince(since string) (time.Time, error) {
+	if days, found := strings.CutSuffix(since, "d"); found {
+		if number, err := strconv.Atoi(days); err == nil && number >= 0 {
+			return Now().AddDate(0, 0, -number), nil
+		}
+	}
+	if duration, err := time.ParseDuration(since); err == nil && duration >= 0 {
+		return Now().Add(-duration), nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02"} {
+		if date, err := time.Parse(layout, since); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, slErr.NewInvalidUsageError(T("Invalid input for '{{.Name}}'. It must be a duration like 90m or 7d, or a date like 2006-01-02.", map[string]interface{}{"Name": "--since"}))
+}
+
+func historyValue(value string) string {
+	if value == "" {
+		return utils.EMPTY_VALUE
+	}
+	return value
+}
@@ -0,0 +1,126 @@
+package history_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/session"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/history"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
+)
+
+func TestHistory(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "History Suite")
+}
+
+var _ = Describe("history", func() {
+	var (
+		fakeUI             *terminal.FakeUI
+		cliCommand         *history.HistoryCommand
+		fakeSession        *session.Session
+		slCommand          *metadata.SoftlayerCommand
+		fakeHistoryManager *testhelpers.FakeHistoryManager
+		now                time.Time
+	)
+	BeforeEach(func() {
+		fakeUI = terminal.NewFakeUI()
+		fakeSession = testhelpers.NewFakeSoftlayerSession(nil)
+		fakeHistoryManager = new(testhelpers.FakeHistoryManager)
+		fakeHistoryManager.JournalFileReturns("/tmp/journal.jsonl")
+		slCommand = metadata.NewSoftlayerCommand(fakeUI, fakeSession)
+		cliCommand = history.NewHistoryCommand(slCommand)
+		cliCommand.Command.PersistentFlags().Var(cliCommand.OutputFlag, "output", "--output=JSON for json output.")
+		cliCommand.HistoryManager = fakeHistoryManager
+		now = time.Date(2024, 1, 8, 12, 0, 0, 0, time.UTC)
+		history.Now = func() time.Time { return now }
+	})
+	AfterEach(func() {
+		history.Now = time.Now
+	})
+
+	Context("Invalid Usage", func() {
+		It("Errors with arguments", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "123")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid argument 123 for history"))
+		})
+		It("Errors with a bad --since", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "--since", "yesterday")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Invalid input for '--since'"))
+		})
+		It("Errors with a negative --limit", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "--limit", "-1")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("The value must be 0 or a positive integer."))
+		})
+	})
+
+	Context("Filters", func() {
+		It("Passes the flags to the manager", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "--service", "Virtual_Guest", "--method", "power",
+				"--id", "1234", "--failed", "--limit", "5", "--offset", "10", "--since", "7d")
+			Expect(err).NotTo(HaveOccurred())
+			filter := fakeHistoryManager.ListHistoryArgsForCall(0)
+			Expect(filter.Service).To(Equal("Virtual_Guest"))
+			Expect(filter.Method).To(Equal("power"))
+			Expect(filter.Id).To(Equal(1234))
+			Expect(filter.FailedOnly).To(BeTrue())
+			Expect(filter.Paging).To(Equal(metadata.Paging{Limit: 5, Offset: 10}))
+			Expect(filter.Since).To(Equal(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)))
+		})
+		It("Takes durations and dates for --since", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "--since", "90m")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeHistoryManager.ListHistoryArgsForCall(0).Since).To(Equal(now.Add(-90 * time.Minute)))
+			err = testhelpers.RunCobraCommand(cliCommand.Command, "--since", "2024-01-02")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeHistoryManager.ListHistoryArgsForCall(1).Since).To(Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)))
+		})
+	})
+
+	Context("Output", func() {
+		BeforeEach(func() {
+			id := 1234
+			fakeHistoryManager.ListHistoryReturns([]client.JournalEntry{
+				{Time: now, User: "user@example.com", CommandLine: "sl vs power-off 1234", Service: "SoftLayer_Virtual_Guest", Method: "powerOff", Id: &id, Success: true},
+				{Time: now, Service: "SoftLayer_Product_Order", Method: "placeOrder", Success: false, Error: "SoftLayer_Exception_Order_InvalidLocation"},
+			}, nil)
+		})
+		It("Prints a table", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(ContainSubstring("2024-01-08T12:00:00Z"))
+			Expect(fakeUI.Outputs()).To(ContainSubstring("sl vs power-off 1234"))
+			Expect(fakeUI.Outputs()).To(ContainSubstring("SoftLayer_Virtual_Guest::powerOff"))
+			Expect(fakeUI.Outputs()).To(ContainSubstring("1234"))
+			Expect(fakeUI.Outputs()).To(ContainSubstring("Failed: SoftLayer_Exception_Order_InvalidLocation"))
+		})
+		It("Prints JSON", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "--output", "JSON")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(ContainSubstring(`"method": "powerOff"`))
+			Expect(fakeUI.Outputs()).To(ContainSubstring(`"success": false`))
+		})
+		It("Says when nothing was found", func() {
+			fakeHistoryManager.ListHistoryReturns([]client.JournalEntry{}, nil)
+			err := testhelpers.RunCobraCommand(cliCommand.Command)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(ContainSubstring("No API calls found in the journal /tmp/journal.jsonl."))
+		})
+		It("Returns an error when the journal can't be read", func() {
+			fakeHistoryManager.ListHistoryReturns(nil, errors.New("permission denied"))
+			err := testhelpers.RunCobraCommand(cliCommand.Command)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Failed to read the journal /tmp/journal.jsonl."))
+		})
+	})
+})
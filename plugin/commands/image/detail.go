@@ -99,16 +99,28 @@ func (cmd *DetailCommand) Run(args []string) error {
 		}
 	}
 	table.Add(T("os"), os)
-	table.Add(T("datacenters"), getDatacenters(image.Children, cmd.UI, outputFormat))
-	table.Add(T("virtual disks"), getVirtualDisks(image.Children, cmd.UI, outputFormat))
-	table.Add(T("share image"), getShareImages(image, cmd.UI, outputFormat))
+	datacenters, err := getDatacenters(image.Children, cmd.UI, outputFormat)
+	if err != nil {
+		return err
+	}
+	table.Add(T("datacenters"), datacenters)
+	virtualDisks, err := getVirtualDisks(image.Children, cmd.UI, outputFormat)
+	if err != nil {
+		return err
+	}
+	table.Add(T("virtual disks"), virtualDisks)
+	shareImages, err := getShareImages(image, cmd.UI, outputFormat)
+	if err != nil {
+		return err
+	}
+	table.Add(T("share image"), shareImages)
 	table.Print()
 	return nil
 }
 
-func getDatacenters(childrens []datatypes.Virtual_Guest_Block_Device_Template_Group, ui terminal.UI, outputFormat string) string {
+func getDatacenters(childrens []datatypes.Virtual_Guest_Block_Device_Template_Group, ui terminal.UI, outputFormat string) (string, error) {
 	bufTable := new(bytes.Buffer)
-	table := terminal.NewTable(bufTable, []string{
+	table := utils.NewTable(bufTable, []string{
 		T("Data Center"),
 		T("Size"),
 	})
@@ -118,13 +130,13 @@ func getDatacenters(childrens []datatypes.Virtual_Guest_Block_Device_Template_Gr
 			utils.B2GB(int(*child.BlockDevicesDiskSpaceTotal)),
 		)
 	}
-	utils.PrintTable(ui, table, outputFormat)
-	return bufTable.String()
+	err := utils.PrintTable(ui, table, outputFormat)
+	return bufTable.String(), err
 }
 
-func getVirtualDisks(childrens []datatypes.Virtual_Guest_Block_Device_Template_Group, ui terminal.UI, outputFormat string) string {
+func getVirtualDisks(childrens []datatypes.Virtual_Guest_Block_Device_Template_Group, ui terminal.UI, outputFormat string) (string, error) {
 	bufTable := new(bytes.Buffer)
-	table := terminal.NewTable(bufTable, []string{
+	table := utils.NewTable(bufTable, []string{
 		T("Device"),
 		T("Capacity"),
 		T("Size On Disk"),
@@ -148,13 +160,13 @@ func getVirtualDisks(childrens []datatypes.Virtual_Guest_Block_Device_Template_G
 			)
 		}
 	}
-	utils.PrintTable(ui, table, outputFormat)
-	return bufTable.String()
+	err := utils.PrintTable(ui, table, outputFormat)
+	return bufTable.String(), err
 }
 
-func getShareImages(image datatypes.Virtual_Guest_Block_Device_Template_Group, ui terminal.UI, outputFormat string) string {
+func getShareImages(image datatypes.Virtual_Guest_Block_Device_Template_Group, ui terminal.UI, outputFormat string) (string, error) {
 	bufTable := new(bytes.Buffer)
-	table := terminal.NewTable(bufTable, []string{
+	table := utils.NewTable(bufTable, []string{
 		T("Account"),
 		T("Shared on"),
 	})
@@ -167,6 +179,6 @@ func getShareImages(image datatypes.Virtual_Guest_Block_Device_Template_Group, u
 		}
 
 	}
-	utils.PrintTable(ui, table, outputFormat)
-	return bufTable.String()
+	err := utils.PrintTable(ui, table, outputFormat)
+	return bufTable.String(), err
 }
@@ -3,6 +3,7 @@ package image
 import (
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/spf13/cobra"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/cmdutils"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
@@ -20,6 +21,7 @@ type ImportCommand struct {
 	CloudInit    bool
 	Byol         bool
 	IsEncrypted  bool
+	Wait         cmdutils.WaitOptions
 }
 
 func NewImportCommand(sl *metadata.SoftlayerCommand) (cmd *ImportCommand) {
@@ -48,6 +50,7 @@ func NewImportCommand(sl *metadata.SoftlayerCommand) (cmd *ImportCommand) {
 	cobraCmd.Flags().BoolVar(&thisCmd.CloudInit, "cloud-init", false, T("Specifies if image is cloud-init"))
 	cobraCmd.Flags().BoolVar(&thisCmd.Byol, "byol", false, T("Specifies if image is bring your own license"))
 	cobraCmd.Flags().BoolVar(&thisCmd.IsEncrypted, "is-encrypted", false, T("Specifies if image is encrypted"))
+	cmdutils.AddWaitFlags(cobraCmd, &thisCmd.Wait, T("the image is imported"))
 
 	thisCmd.Command = cobraCmd
 	return thisCmd
@@ -112,5 +115,13 @@ func (cmd *ImportCommand) Run(args []string) error {
 	table.Add(T("Created Date"), utils.FormatSLTimePointer(resp.CreateDate))
 	table.Add(T("GUID"), utils.FormatStringPointer(resp.GlobalIdentifier))
 	table.Print()
+	if cmd.Wait.Enabled() && resp.Id != nil {
+		subs := map[string]interface{}{"ImageId": *resp.Id}
+		err = cmdutils.WaitFor(cmd.SoftlayerCommand, cmd.Wait, T("image {{.ImageId}}", subs), managers.ImageReadyCheck(cmd.ImageManager, *resp.Id))
+		if err != nil {
+			return err
+		}
+		cmd.UI.Print(T("Image {{.ImageId}} is ready.", subs))
+	}
 	return nil
 }
@@ -14,6 +14,7 @@ type ListCommand struct {
 	*metadata.SoftlayerCommand
 	ImageManager managers.ImageManager
 	Command      *cobra.Command
+	Paging       metadata.Paging
 	Name         string
 	Public       bool
 	Private      bool
@@ -38,6 +39,7 @@ func NewListCommand(sl *metadata.SoftlayerCommand) (cmd *ListCommand) {
 	cobraCmd.Flags().BoolVar(&thisCmd.Public, "public", false, T("Display only public images"))
 	cobraCmd.Flags().BoolVar(&thisCmd.Private, "private", false, T("Display only private images"))
 
+	metadata.AddPagingFlags(cobraCmd, &thisCmd.Paging)
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
@@ -59,21 +61,21 @@ func (cmd *ListCommand) Run(args []string) error {
 	outputFormat := cmd.GetOutputFlag()
 
 	if cmd.Public && !cmd.Private {
-		publicImages, err = cmd.ImageManager.ListPublicImages(cmd.Name, mask)
+		publicImages, err = cmd.ImageManager.ListPublicImages(cmd.Name, mask, cmd.Paging)
 		if err != nil {
 			return bmxErr.NewAPIError(T("Failed to list public images."), err.Error(), 2)
 		}
 	} else if cmd.Private && !cmd.Public {
-		privateImages, err = cmd.ImageManager.ListPrivateImages(cmd.Name, mask)
+		privateImages, err = cmd.ImageManager.ListPrivateImages(cmd.Name, mask, cmd.Paging)
 		if err != nil {
 			return bmxErr.NewAPIError(T("Failed to list private images."), err.Error(), 2)
 		}
 	} else {
-		publicImages, err = cmd.ImageManager.ListPublicImages(cmd.Name, mask)
+		publicImages, err = cmd.ImageManager.ListPublicImages(cmd.Name, mask, metadata.Paging{})
 		if err != nil {
 			return bmxErr.NewAPIError(T("Failed to list public images."), err.Error(), 2)
 		}
-		privateImages, err = cmd.ImageManager.ListPrivateImages(cmd.Name, mask)
+		privateImages, err = cmd.ImageManager.ListPrivateImages(cmd.Name, mask, metadata.Paging{})
 		if err != nil {
 			return bmxErr.NewAPIError(T("Failed to list private images."), err.Error(), 2)
 		}
@@ -88,6 +90,10 @@ func (cmd *ListCommand) Run(args []string) error {
 		image := Image{T("Private"), priImage}
 		allImages = append(allImages, image)
 	}
+	// Public and private images are listed separately, so together they can only be paged here
+	if !cmd.Public && !cmd.Private {
+		allImages = managers.PageOf(allImages, cmd.Paging)
+	}
 
 	if outputFormat == "JSON" {
 		return utils.PrintPrettyJSON(cmd.UI, allImages)
@@ -0,0 +1,220 @@
+package inventory
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
+)
+
+type AnsibleCommand struct {
+	*metadata.SoftlayerCommand
+	VirtualServerManager  managers.VirtualServerManager
+	HardwareServerManager managers.HardwareServerManager
+	Command               *cobra.Command
+	Filter                ServerFilter
+	List                  bool
+	Host                  string
+	Private               bool
+}
+
+// A group of an Ansible dynamic inventory
+type AnsibleGroup struct {
+	Hosts    []string `json:"hosts,omitempty"`
+	Children []string `json:"children,omitempty"`
+}
+
+func NewAnsibleCommand(sl *metadata.SoftlayerCommand) *AnsibleCommand {
+	thisCmd := &AnsibleCommand{
+		SoftlayerCommand:      sl,
+		VirtualServerManager:  managers.NewVirtualServerManager(sl.Session),
+		HardwareServerManager: managers.NewHardwareServerManager(sl.Session),
+	}
+	cobraCmd := &cobra.Command{
+		Use:   "ansible",
+		Short: T("Print an Ansible dynamic inventory of the virtual and hardware servers"),
+		Long: T(`${COMMAND_NAME} sl inventory ansible (--list | --host NAME) [OPTIONS]
+
+Prints the JSON of an Ansible dynamic inventory. Every server is in the groups datacenter_NAME, domain_NAME, power_STATE and tag_NAME for each of its tags, with the characters Ansible doesn't allow in a group name changed to _. Hardware servers are in the group of their hardware status, like power_active, since the API doesn't list the power state of hardware servers.
+The host variables are ansible_host, ansible_user when the account has the OS credentials, and sl_id, sl_type, sl_public_ip, sl_private_ip, sl_datacenter, sl_domain, sl_cpu, sl_memory (in MB), sl_os, sl_os_version, sl_os_code, sl_power_state and sl_tags.
+
+EXAMPLE:
+   ${COMMAND_NAME} sl inventory ansible --list --tag web
+   Prints the inventory of the servers tagged web.
+   ${COMMAND_NAME} sl inventory ansible --host web1.example.com
+   Prints the host variables of web1.example.com.
+   ansible-playbook -i inventory.sh site.yml
+   Uses the inventory in a playbook run, where inventory.sh is an executable script that runs '${COMMAND_NAME} sl inventory ansible "$@"'.`),
+		Args: metadata.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return thisCmd.Run(args)
+		},
+	}
+	AddServerFlags(cobraCmd, &thisCmd.Filter)
+	cobraCmd.Flags().BoolVar(&thisCmd.List, "list", false, T("Print the whole inventory"))
+	cobraCmd.Flags().StringVar(&thisCmd.Host, "host", "", T("Print the variables of this host, by its fully qualified domain name or hostname"))
+	cobraCmd.Flags().BoolVar(&thisCmd.Private, "private", false, T("Set ansible_host to the private IP of every server"))
+	thisCmd.Command = cobraCmd
+	return thisCmd
+}
+
+func (cmd *AnsibleCommand) Run(args []string) error {
+	if cmd.List == (cmd.Host != "") {
+		return slErrors.NewInvalidUsageError(T("Either '--list' or '--host' is required, but not both."))
+	}
+	servers, err := ListServers(cmd.VirtualServerManager, cmd.HardwareServerManager, cmd.Filter)
+	if err != nil {
+		return err
+	}
+	if cmd.Host != "" {
+		server, found, err := findServer(servers, cmd.Host)
+		if err != nil {
+			return err
+		}
+		// Ansible expects an empty object, not a failure, for a host the inventory doesn't have
+		if !found {
+			return utils.PrintPrettyJSON(cmd.UI, map[string]interface{}{})
+		}
+		return utils.PrintPrettyJSON(cmd.UI, AnsibleHostVars(server, cmd.Private))
+	}
+	inventory, err := AnsibleInventory(servers, cmd.Private)
+	if err != nil {
+		return err
+	}
+	return utils.PrintPrettyJSON(cmd.UI, inventory)
+}
+
+// The inventory of servers in the format of `--list`, with the host variables in _meta so Ansible doesn't run `--host` for each one.
+// Errors when two servers have the same FQDN, since Ansible names the hosts by it.
+func AnsibleInventory(servers []Server, private bool) (map[string]interface{}, error) {
+	groups := map[string][]string{}
+	hostVars := map[string]map[string]interface{}{}
+	hosts := []string{}
+	for i, server := range servers {
+		// ListServers sorts by FQDN, so the server with the same one is the previous
+		if _, ok := hostVars[server.FQDN]; ok {
+			return nil, duplicateFQDNError(servers[i-1], server)
+		}
+		hosts = append(hosts, server.FQDN)
+		hostVars[server.FQDN] = AnsibleHostVars(server, private)
+		names := []string{}
+		if server.Datacenter != "" {
+			names = append(names, AnsibleGroupName("datacenter", server.Datacenter))
+		}
+		if server.Domain != "" {
+			names = append(names, AnsibleGroupName("domain", server.Domain))
+		}
+		if server.PowerState != "" {
+			names = append(names, AnsibleGroupName("power", server.PowerState))
+		}
+		for _, tag := range server.Tags {
+			names = append(names, AnsibleGroupName("tag", tag))
+		}
+		for _, name := range names {
+			if utils.StringInSlice(server.FQDN, groups[name]) == -1 {
+				groups[name] = append(groups[name], server.FQDN)
+			}
+		}
+	}
+	children := []string{}
+	for name := range groups {
+		children = append(children, name)
+	}
+	sort.Strings(children)
+
+	inventory := map[string]interface{}{
+		"_meta": map[string]interface{}{"hostvars": hostVars},
+		"all":   AnsibleGroup{Hosts: hosts, Children: children},
+	}
+	for name, members := range groups {
+		inventory[name] = AnsibleGroup{Hosts: members}
+	}
+	return inventory, nil
+}
+
+// The host variables of server
+func AnsibleHostVars(server Server, private bool) map[string]interface{} {
+	vars := map[string]interface{}{
+		"sl_id":          server.Id,
+		"sl_type":        server.Type,
+		"sl_datacenter":  server.Datacenter,
+		"sl_domain":      server.Domain,
+		"sl_cpu":         server.CPU,
+		"sl_memory":      server.Memory,
+		"sl_os":          server.OS,
+		"sl_os_version":  server.OSVersion,
+		"sl_os_code":     server.OSCode,
+		"sl_power_state": server.PowerState,
+		"sl_tags":        server.Tags,
+	}
+	if server.PublicIP != "" {
+		vars["sl_public_ip"] = server.PublicIP
+	}
+	if server.PrivateIP != "" {
+		vars["sl_private_ip"] = server.PrivateIP
+	}
+	host := server.PublicIP
+	if private || host == "" {
+		host = server.PrivateIP
+	}
+	if host != "" {
+		vars["ansible_host"] = host
+	}
+	if server.User != "" {
+		vars["ansible_user"] = server.User
+	}
+	return vars
+}
+
+var ansibleGroupInvalid = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// prefix_value, with what Ansible doesn't allow in a group name changed to _
+func AnsibleGroupName(prefix string, value string) string {
+	return prefix + "_" + ansibleGroupInvalid.ReplaceAllString(strings.ToLower(value), "_")
+}
+
+// The server named host, by its FQDN or else by its hostname when only one server has it. False when no server has the name.
+func findServer(servers []Server, host string) (Server, bool, error) {
+	byFQDN := []Server{}
+	byHostname := []Server{}
+	for _, server := range servers {
+		if server.FQDN == host {
+			byFQDN = append(byFQDN, server)
+		} else if server.Hostname == host {
+			byHostname = append(byHostname, server)
+		}
+	}
+	if len(byFQDN) > 1 {
+		return Server{}, false, duplicateFQDNError(byFQDN[0], byFQDN[1])
+	}
+	if len(byFQDN) == 1 {
+		return byFQDN[0], true, nil
+	}
+	if len(byHostname) > 1 {
+		subs := map[string]interface{}{"HOST": host}
+		return Server{}, false, slErrors.New(T("More than one server is named {{.HOST}}, use the fully qualified domain name.", subs))
+	}
+	if len(byHostname) == 1 {
+		return byHostname[0], true, nil
+	}
+	return Server{}, false, nil
+}
+
+// The error for two servers with the same FQDN, which would be the same host of the inventory
+func duplicateFQDNError(first Server, second Server) error {
+	subs := map[string]interface{}{
+		"FQDN":        first.FQDN,
+		"FIRST_TYPE":  first.Type,
+		"FIRST_ID":    first.Id,
+		"SECOND_TYPE": second.Type,
+		"SECOND_ID":   second.Id,
+	}
+	return slErrors.New(T("The {{.FIRST_TYPE}} server {{.FIRST_ID}} and the {{.SECOND_TYPE}} server {{.SECOND_ID}} have the same fully qualified domain name {{.FQDN}}, rename one of them or use --tag, --datacenter or --domain to leave one out.", subs))
+}
@@ -0,0 +1,202 @@
+package inventory_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/inventory"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
+)
+
+var _ = Describe("inventory ansible", func() {
+	var (
+		fakeUI        *terminal.FakeUI
+		cliCommand    *inventory.AnsibleCommand
+		fakeSession   *session.Session
+		slCommand     *metadata.SoftlayerCommand
+		fakeVSManager *testhelpers.FakeVirtualServerManager
+		fakeHWManager *testhelpers.FakeHardwareServerManager
+	)
+	ubuntu := &datatypes.Software_Component_OperatingSystem{Software_Component: datatypes.Software_Component{
+		Passwords: []datatypes.Software_Component_Password{{Username: sl.String("root")}},
+		SoftwareLicense: &datatypes.Software_License{SoftwareDescription: &datatypes.Software_Description{
+			Name:          sl.String("Ubuntu"),
+			Version:       sl.String("22.04-64 Minimal for VSI"),
+			ReferenceCode: sl.String("UBUNTU_22_64"),
+		}},
+	}}
+	BeforeEach(func() {
+		fakeUI = terminal.NewFakeUI()
+		fakeSession = testhelpers.NewFakeSoftlayerSession([]string{})
+		fakeVSManager = new(testhelpers.FakeVirtualServerManager)
+		fakeHWManager = new(testhelpers.FakeHardwareServerManager)
+		slCommand = metadata.NewSoftlayerCommand(fakeUI, fakeSession)
+		cliCommand = inventory.NewAnsibleCommand(slCommand)
+		cliCommand.VirtualServerManager = fakeVSManager
+		cliCommand.HardwareServerManager = fakeHWManager
+		fakeVSManager.ListInstancesReturns([]datatypes.Virtual_Guest{
+			{
+				Id:                       sl.Int(100),
+				Hostname:                 sl.String("web1"),
+				Domain:                   sl.String("example.com"),
+				FullyQualifiedDomainName: sl.String("web1.example.com"),
+				PrimaryIpAddress:         sl.String("169.1.1.1"),
+				PrimaryBackendIpAddress:  sl.String("10.1.1.1"),
+				Datacenter:               &datatypes.Location{Name: sl.String("dal13")},
+				MaxCpu:                   sl.Int(2),
+				MaxMemory:                sl.Int(4096),
+				PowerState:               &datatypes.Virtual_Guest_Power_State{KeyName: sl.String("RUNNING")},
+				OperatingSystem:          ubuntu,
+				TagReferences:            []datatypes.Tag_Reference{{Tag: &datatypes.Tag{Name: sl.String("web-prod")}}},
+			},
+			{
+				Id:                       sl.Int(101),
+				Hostname:                 sl.String("web2"),
+				Domain:                   sl.String("example.com"),
+				FullyQualifiedDomainName: sl.String("web2.example.com"),
+				PrimaryBackendIpAddress:  sl.String("10.1.1.2"),
+				Datacenter:               &datatypes.Location{Name: sl.String("dal13")},
+				PowerState:               &datatypes.Virtual_Guest_Power_State{KeyName: sl.String("HALTED")},
+				TagReferences:            []datatypes.Tag_Reference{{Tag: &datatypes.Tag{Name: sl.String("web-prod")}}},
+			},
+		}, nil)
+		fakeHWManager.ListHardwareReturns([]datatypes.Hardware_Server{
+			{Hardware: datatypes.Hardware{
+				Id:                          sl.Int(200),
+				Hostname:                    sl.String("db1"),
+				Domain:                      sl.String("example.org"),
+				FullyQualifiedDomainName:    sl.String("db1.example.org"),
+				PrimaryIpAddress:            sl.String("169.2.2.2"),
+				PrimaryBackendIpAddress:     sl.String("10.2.2.2"),
+				Datacenter:                  &datatypes.Location{Name: sl.String("wdc07")},
+				ProcessorPhysicalCoreAmount: sl.Uint(16),
+				MemoryCapacity:              sl.Uint(64),
+				HardwareStatus:              &datatypes.Hardware_Status{Status: sl.String("ACTIVE")},
+			}},
+		}, nil)
+	})
+
+	Describe("inventory ansible", func() {
+		It("Needs --list or --host", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Either '--list' or '--host' is required, but not both."))
+			err = testhelpers.RunCobraCommand(cliCommand.Command, "--list", "--host", "web1")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Either '--list' or '--host' is required, but not both."))
+		})
+		It("Prints the inventory", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "--list")
+			Expect(err).NotTo(HaveOccurred())
+			result := map[string]json.RawMessage{}
+			Expect(json.Unmarshal([]byte(fakeUI.Outputs()), &result)).To(Succeed())
+			groups := map[string]inventory.AnsibleGroup{}
+			for name, value := range result {
+				if name == "_meta" {
+					continue
+				}
+				group := inventory.AnsibleGroup{}
+				Expect(json.Unmarshal(value, &group)).To(Succeed())
+				groups[name] = group
+			}
+			Expect(groups["all"].Hosts).To(Equal([]string{"db1.example.org", "web1.example.com", "web2.example.com"}))
+			Expect(groups["all"].Children).To(ContainElements("datacenter_dal13", "tag_web_prod", "power_active"))
+			Expect(groups["datacenter_dal13"].Hosts).To(Equal([]string{"web1.example.com", "web2.example.com"}))
+			Expect(groups["datacenter_wdc07"].Hosts).To(Equal([]string{"db1.example.org"}))
+			Expect(groups["domain_example_com"].Hosts).To(Equal([]string{"web1.example.com", "web2.example.com"}))
+			Expect(groups["tag_web_prod"].Hosts).To(Equal([]string{"web1.example.com", "web2.example.com"}))
+			Expect(groups["power_running"].Hosts).To(Equal([]string{"web1.example.com"}))
+			Expect(groups["power_halted"].Hosts).To(Equal([]string{"web2.example.com"}))
+			Expect(groups["power_active"].Hosts).To(Equal([]string{"db1.example.org"}))
+
+			meta := map[string]map[string]map[string]interface{}{}
+			Expect(json.Unmarshal(result["_meta"], &meta)).To(Succeed())
+			web1 := meta["hostvars"]["web1.example.com"]
+			Expect(web1["ansible_host"]).To(Equal("169.1.1.1"))
+			Expect(web1["ansible_user"]).To(Equal("root"))
+			Expect(web1["sl_cpu"]).To(BeNumerically("==", 2))
+			Expect(web1["sl_memory"]).To(BeNumerically("==", 4096))
+			Expect(web1["sl_os"]).To(Equal("Ubuntu"))
+			Expect(web1["sl_os_code"]).To(Equal("UBUNTU_22_64"))
+			Expect(web1["sl_type"]).To(Equal("virtual"))
+			Expect(meta["hostvars"]["web2.example.com"]["ansible_host"]).To(Equal("10.1.1.2"))
+			db1 := meta["hostvars"]["db1.example.org"]
+			Expect(db1["sl_cpu"]).To(BeNumerically("==", 16))
+			Expect(db1["sl_memory"]).To(BeNumerically("==", 65536))
+			Expect(db1["sl_type"]).To(Equal("hardware"))
+			Expect(db1).NotTo(HaveKey("ansible_user"))
+		})
+		It("Passes the filters to both managers", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "--list", "--tag", "web", "-d", "dal13")
+			Expect(err).NotTo(HaveOccurred())
+			_, _, _, _, datacenter, _, _, _, _, _, _, _, tags, _, _ := fakeVSManager.ListInstancesArgsForCall(0)
+			Expect(datacenter).To(Equal("dal13"))
+			Expect(tags).To(Equal([]string{"web"}))
+			hwTags, _, _, _, _, hwDatacenter, _, _, _, _, _, _, _ := fakeHWManager.ListHardwareArgsForCall(0)
+			Expect(hwTags).To(Equal([]string{"web"}))
+			Expect(hwDatacenter).To(Equal("dal13"))
+		})
+		It("Prints the variables of a host", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "--host", "db1", "--private")
+			Expect(err).NotTo(HaveOccurred())
+			vars := map[string]interface{}{}
+			Expect(json.Unmarshal([]byte(fakeUI.Outputs()), &vars)).To(Succeed())
+			Expect(vars["ansible_host"]).To(Equal("10.2.2.2"))
+			Expect(vars["sl_public_ip"]).To(Equal("169.2.2.2"))
+			Expect(vars["sl_power_state"]).To(Equal("ACTIVE"))
+		})
+		It("Prints an empty object when no server has the name", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "--host", "db9")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(Equal("{}\n"))
+		})
+		Context("Two servers have the same FQDN", func() {
+			BeforeEach(func() {
+				fakeHWManager.ListHardwareReturns([]datatypes.Hardware_Server{
+					{Hardware: datatypes.Hardware{
+						Id:                       sl.Int(200),
+						Hostname:                 sl.String("web1"),
+						Domain:                   sl.String("example.com"),
+						FullyQualifiedDomainName: sl.String("web1.example.com"),
+					}},
+				}, nil)
+			})
+			It("Errors for the inventory", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--list")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("The virtual server 100 and the hardware server 200 have the same fully qualified domain name web1.example.com"))
+			})
+			It("Errors for the variables of that host", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--host", "web1.example.com")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("The virtual server 100 and the hardware server 200 have the same fully qualified domain name web1.example.com"))
+			})
+			It("Prints the variables of another host", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--host", "web2.example.com")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"sl_id": 101`))
+			})
+		})
+		It("Returns an error when the servers can't be listed", func() {
+			fakeVSManager.ListInstancesReturns(nil, errors.New("Internal Server Error"))
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "--list")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Failed to list virtual server instances on your account."))
+		})
+	})
+
+	Describe("AnsibleGroupName", func() {
+		It("Changes what Ansible doesn't allow to _", func() {
+			Expect(inventory.AnsibleGroupName("tag", "Web Prod.v2")).To(Equal("tag_web_prod_v2"))
+			Expect(inventory.AnsibleGroupName("domain", "example.com")).To(Equal("domain_example_com"))
+		})
+	})
+})
@@ -0,0 +1,29 @@
+package inventory
+
+import (
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/plugin"
+	"github.com/spf13/cobra"
+
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+)
+
+func SetupCobraCommands(sl *metadata.SoftlayerCommand) *cobra.Command {
+	cobraCmd := &cobra.Command{
+		Use:   "inventory",
+		Short: T("Generate inventories of the servers on the account"),
+		RunE:  nil,
+	}
+
+	cobraCmd.AddCommand(NewAnsibleCommand(sl).Command)
+	cobraCmd.AddCommand(NewSshConfigCommand(sl).Command)
+	return cobraCmd
+}
+
+func InventoryNamespace() plugin.Namespace {
+	return plugin.Namespace{
+		ParentName:  "sl",
+		Name:        "inventory",
+		Description: T("Generate inventories of the servers on the account"),
+	}
+}
@@ -0,0 +1,65 @@
+package inventory_test
+
+import (
+	"testing"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/inventory"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
+)
+
+func TestManagers(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Inventory Suite")
+}
+
+var availableCommands = []string{
+	"ansible",
+	"ssh-config",
+}
+
+// This test suite exists to make sure commands don't get accidently removed from the SetupCobraCommands
+var _ = Describe("Test inventory commands", func() {
+	fakeUI := terminal.NewFakeUI()
+	fakeSession := testhelpers.NewFakeSoftlayerSession(nil)
+	slMeta := metadata.NewSoftlayerCommand(fakeUI, fakeSession)
+
+	Context("New commands testable", func() {
+		commands := inventory.SetupCobraCommands(slMeta)
+
+		var arrayCommands = []string{}
+		for _, command := range commands.Commands() {
+			commandName := command.Name()
+			arrayCommands = append(arrayCommands, commandName)
+			It("available commands "+commands.Name(), func() {
+				available := false
+				if utils.StringInSlice(commandName, availableCommands) != -1 {
+					available = true
+				}
+				Expect(available).To(BeTrue(), commandName+" not found in array available Commands")
+			})
+		}
+		for _, command := range availableCommands {
+			commandName := command
+			It("ibmcloud sl "+commands.Name(), func() {
+				available := false
+				if utils.StringInSlice(commandName, arrayCommands) != -1 {
+					available = true
+				}
+				Expect(available).To(BeTrue(), commandName+" not found in ibmcloud sl "+commands.Name())
+			})
+		}
+	})
+
+	Context("Inventory Namespace", func() {
+		It("Inventory Name Space", func() {
+			Expect(inventory.InventoryNamespace().ParentName).To(ContainSubstring("sl"))
+			Expect(inventory.InventoryNamespace().Name).To(ContainSubstring("inventory"))
+			Expect(inventory.InventoryNamespace().Description).To(ContainSubstring("inventories of the servers"))
+		})
+	})
+})
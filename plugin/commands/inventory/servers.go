@@ -0,0 +1,184 @@
+package inventory
+
+import (
+	"sort"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/spf13/cobra"
+
+	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
+)
+
+const (
+	SERVER_TYPE_VIRTUAL  = "virtual"
+	SERVER_TYPE_HARDWARE = "hardware"
+)
+
+const inventoryOsMask = "operatingSystem[passwords.username,softwareLicense.softwareDescription[name,version,referenceCode]]"
+
+const INVENTORY_VIRTUAL_GUEST_MASK = "id,hostname,domain,fullyQualifiedDomainName,primaryIpAddress,primaryBackendIpAddress," +
+	"privateNetworkOnlyFlag,datacenter.name,tagReferences.tag.name,maxCpu,maxMemory,powerState.keyName," + inventoryOsMask
+
+const INVENTORY_HARDWARE_MASK = "id,hostname,domain,fullyQualifiedDomainName,primaryIpAddress,primaryBackendIpAddress," +
+	"privateNetworkOnlyFlag,datacenter.name,tagReferences.tag.name,processorPhysicalCoreAmount,memoryCapacity,hardwareStatus.status," + inventoryOsMask
+
+// A virtual or hardware server of an inventory
+type Server struct {
+	Type       string   `json:"type"`
+	Id         int      `json:"id"`
+	Hostname   string   `json:"hostname"`
+	Domain     string   `json:"domain"`
+	FQDN       string   `json:"fqdn"`
+	PublicIP   string   `json:"publicIp,omitempty"`
+	PrivateIP  string   `json:"privateIp,omitempty"`
+	Datacenter string   `json:"datacenter"`
+	Tags       []string `json:"tags"`
+	CPU        int      `json:"cpu"`
+	// In MB for both virtual and hardware servers
+	Memory    int    `json:"memory"`
+	OS        string `json:"os,omitempty"`
+	OSVersion string `json:"osVersion,omitempty"`
+	OSCode    string `json:"osCode,omitempty"`
+	// The power state of a virtual server, like RUNNING or HALTED. Hardware servers have their hardware status
+	// instead, like ACTIVE, since the API only gives the power state of one hardware server at a time.
+	PowerState string `json:"powerState,omitempty"`
+	// The OS user the account has credentials for, root when there is one
+	User string `json:"user,omitempty"`
+}
+
+// Which servers an inventory has, set by the flags of AddServerFlags
+type ServerFilter struct {
+	Tags       []string
+	Datacenter string
+	Domain     string
+}
+
+// The filter flags every inventory command has
+func AddServerFlags(cobraCmd *cobra.Command, filter *ServerFilter) {
+	cobraCmd.Flags().StringSliceVar(&filter.Tags, "tag", []string{}, T("Only servers with this tag. This flag can be specified multiple times, servers with any of the tags are included"))
+	cobraCmd.Flags().StringVarP(&filter.Datacenter, "datacenter", "d", "", T("Only servers in this datacenter"))
+	cobraCmd.Flags().StringVarP(&filter.Domain, "domain", "D", "", T("Only servers in this domain"))
+}
+
+// The virtual and hardware servers that match filter, sorted by FQDN
+func ListServers(vsManager managers.VirtualServerManager, hwManager managers.HardwareServerManager, filter ServerFilter) ([]Server, error) {
+	guests, err := vsManager.ListInstances(false, false, filter.Domain, "", filter.Datacenter, "", "", "", 0, 0, 0, 0, filter.Tags, INVENTORY_VIRTUAL_GUEST_MASK, metadata.Paging{})
+	if err != nil {
+		return nil, slErrors.NewAPIError(T("Failed to list virtual server instances on your account.\n"), err.Error(), 2)
+	}
+	hardware, err := hwManager.ListHardware(filter.Tags, 0, 0, "", filter.Domain, filter.Datacenter, 0, "", "", "", 0, INVENTORY_HARDWARE_MASK, metadata.Paging{})
+	if err != nil {
+		return nil, slErrors.NewAPIError(T("Failed to list hardware servers on your account.\n"), err.Error(), 2)
+	}
+	servers := []Server{}
+	for _, guest := range guests {
+		server := Server{
+			Type:      SERVER_TYPE_VIRTUAL,
+			Id:        utils.IntPointertoInt(guest.Id),
+			Hostname:  utils.StringPointertoString(guest.Hostname),
+			Domain:    utils.StringPointertoString(guest.Domain),
+			FQDN:      utils.StringPointertoString(guest.FullyQualifiedDomainName),
+			PublicIP:  utils.StringPointertoString(guest.PrimaryIpAddress),
+			PrivateIP: utils.StringPointertoString(guest.PrimaryBackendIpAddress),
+			Tags:      tagNames(guest.TagReferences),
+			CPU:       utils.IntPointertoInt(guest.MaxCpu),
+			Memory:    utils.IntPointertoInt(guest.MaxMemory),
+			User:      osUser(guest.OperatingSystem),
+		}
+		if guest.Datacenter != nil {
+			server.Datacenter = utils.StringPointertoString(guest.Datacenter.Name)
+		}
+		if guest.PowerState != nil {
+			server.PowerState = utils.StringPointertoString(guest.PowerState.KeyName)
+		}
+		server.OS, server.OSVersion, server.OSCode = osDescription(guest.OperatingSystem)
+		if utils.BoolPointertoBool(guest.PrivateNetworkOnlyFlag) {
+			server.PublicIP = ""
+		}
+		servers = append(servers, server)
+	}
+	for _, hw := range hardware {
+		server := Server{
+			Type:      SERVER_TYPE_HARDWARE,
+			Id:        utils.IntPointertoInt(hw.Id),
+			Hostname:  utils.StringPointertoString(hw.Hostname),
+			Domain:    utils.StringPointertoString(hw.Domain),
+			FQDN:      utils.StringPointertoString(hw.FullyQualifiedDomainName),
+			PublicIP:  utils.StringPointertoString(hw.PrimaryIpAddress),
+			PrivateIP: utils.StringPointertoString(hw.PrimaryBackendIpAddress),
+			Tags:      tagNames(hw.TagReferences),
+			User:      osUser(hw.OperatingSystem),
+		}
+		if hw.ProcessorPhysicalCoreAmount != nil {
+			server.CPU = int(*hw.ProcessorPhysicalCoreAmount)
+		}
+		if hw.MemoryCapacity != nil {
+			server.Memory = int(*hw.MemoryCapacity) * 1024
+		}
+		if hw.Datacenter != nil {
+			server.Datacenter = utils.StringPointertoString(hw.Datacenter.Name)
+		}
+		if hw.HardwareStatus != nil {
+			server.PowerState = utils.StringPointertoString(hw.HardwareStatus.Status)
+		}
+		server.OS, server.OSVersion, server.OSCode = osDescription(hw.OperatingSystem)
+		if utils.BoolPointertoBool(hw.PrivateNetworkOnlyFlag) {
+			server.PublicIP = ""
+		}
+		servers = append(servers, server)
+	}
+	for i := range servers {
+		if servers[i].FQDN == "" {
+			servers[i].FQDN = servers[i].Hostname
+			if servers[i].Domain != "" {
+				servers[i].FQDN = servers[i].Hostname + "." + servers[i].Domain
+			}
+		}
+	}
+	sort.SliceStable(servers, func(i, j int) bool {
+		return servers[i].FQDN < servers[j].FQDN
+	})
+	return servers, nil
+}
+
+func tagNames(references []datatypes.Tag_Reference) []string {
+	tags := []string{}
+	for _, reference := range references {
+		if reference.Tag != nil && reference.Tag.Name != nil {
+			tags = append(tags, *reference.Tag.Name)
+		}
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// The user of the OS credentials, root if the account has its password, otherwise the first user
+func osUser(os *datatypes.Software_Component_OperatingSystem) string {
+	if os == nil {
+		return ""
+	}
+	user := ""
+	for _, password := range os.Passwords {
+		username := utils.StringPointertoString(password.Username)
+		if username == "root" || username == "Administrator" {
+			return username
+		}
+		if user == "" {
+			user = username
+		}
+	}
+	return user
+}
+
+// The name, version and reference code of an OS
+func osDescription(os *datatypes.Software_Component_OperatingSystem) (string, string, string) {
+	if os == nil || os.SoftwareLicense == nil || os.SoftwareLicense.SoftwareDescription == nil {
+		return "", "", ""
+	}
+	description := os.SoftwareLicense.SoftwareDescription
+	return utils.StringPointertoString(description.Name), utils.StringPointertoString(description.Version), utils.StringPointertoString(description.ReferenceCode)
+}
@@ -0,0 +1,253 @@
+package inventory
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
+)
+
+// The lines around the part of a file that `sl inventory ssh-config --merge` manages
+const (
+	SSH_CONFIG_BEGIN = "# BEGIN sl inventory ssh-config"
+	SSH_CONFIG_END   = "# END sl inventory ssh-config"
+)
+
+type SshConfigCommand struct {
+	*metadata.SoftlayerCommand
+	VirtualServerManager  managers.VirtualServerManager
+	HardwareServerManager managers.HardwareServerManager
+	Command               *cobra.Command
+	Filter                ServerFilter
+	Private               bool
+	ProxyJump             string
+	User                  string
+	IdentityFile          string
+	Merge                 string
+}
+
+// One Host block of an SSH config file
+type SshHost struct {
+	Host         []string `json:"host"`
+	HostName     string   `json:"hostName"`
+	User         string   `json:"user,omitempty"`
+	ProxyJump    string   `json:"proxyJump,omitempty"`
+	IdentityFile string   `json:"identityFile,omitempty"`
+	// Says which server the block is for
+	Comment string `json:"-"`
+}
+
+func NewSshConfigCommand(sl *metadata.SoftlayerCommand) *SshConfigCommand {
+	thisCmd := &SshConfigCommand{
+		SoftlayerCommand:      sl,
+		VirtualServerManager:  managers.NewVirtualServerManager(sl.Session),
+		HardwareServerManager: managers.NewHardwareServerManager(sl.Session),
+	}
+	cobraCmd := &cobra.Command{
+		Use:   "ssh-config",
+		Short: T("Print an SSH config with a Host for every virtual and hardware server"),
+		Long: T(`${COMMAND_NAME} sl inventory ssh-config [OPTIONS]
+
+Every server gets a Host with its fully qualified domain name, and its hostname too when no other server has the same one. HostName is the public IP, or the private IP for servers on the private network only and with --private. User is the OS user the account has the password of, root when there is one.
+
+EXAMPLE:
+   ${COMMAND_NAME} sl inventory ssh-config --tag web
+   Prints an SSH config for the servers tagged web.
+   ${COMMAND_NAME} sl inventory ssh-config -d dal13 --private --proxy-jump bastion.example.com --merge ~/.ssh/config
+   Connects to the servers in dal13 over the private network through bastion.example.com, and updates the sl inventory section of ~/.ssh/config.`),
+		Args: metadata.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return thisCmd.Run(args)
+		},
+	}
+	AddServerFlags(cobraCmd, &thisCmd.Filter)
+	cobraCmd.Flags().BoolVar(&thisCmd.Private, "private", false, T("Use the private IP of every server"))
+	cobraCmd.Flags().StringVar(&thisCmd.ProxyJump, "proxy-jump", "", T("Set ProxyJump to this host for the servers that are reached by their private IP"))
+	cobraCmd.Flags().StringVarP(&thisCmd.User, "user", "u", "", T("Set User to this user instead of the one from the OS credentials"))
+	cobraCmd.Flags().StringVarP(&thisCmd.IdentityFile, "identity-file", "i", "", T("Set IdentityFile to this file"))
+	cobraCmd.Flags().StringVar(&thisCmd.Merge, "merge", "", T("Replace the sl inventory section of this SSH config file instead of printing the config, the rest of the file is not changed"))
+	thisCmd.Command = cobraCmd
+	return thisCmd
+}
+
+func (cmd *SshConfigCommand) Run(args []string) error {
+	outputFormat := cmd.GetOutputFlag()
+	if cmd.Merge != "" && outputFormat == "JSON" {
+		return slErrors.NewInvalidUsageError(T("'--merge' and '--output JSON' can't be used together."))
+	}
+	servers, err := ListServers(cmd.VirtualServerManager, cmd.HardwareServerManager, cmd.Filter)
+	if err != nil {
+		return err
+	}
+	hosts := cmd.sshHosts(servers)
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, hosts)
+	}
+	if cmd.Merge == "" {
+		cmd.UI.Print(strings.TrimSuffix(FormatSshConfig(hosts), "\n"))
+		return nil
+	}
+	err = MergeSshConfig(cmd.Merge, hosts)
+	if err != nil {
+		return err
+	}
+	cmd.UI.Ok()
+	cmd.UI.Print(T("Wrote {{.COUNT}} hosts to {{.FILE}}.", map[string]interface{}{"COUNT": len(hosts), "FILE": cmd.Merge}))
+	return nil
+}
+
+// The Host of every server that has an IP to connect to
+func (cmd *SshConfigCommand) sshHosts(servers []Server) []SshHost {
+	hostnames := map[string]int{}
+	for _, server := range servers {
+		hostnames[server.Hostname]++
+	}
+	hosts := []SshHost{}
+	for _, server := range servers {
+		ip, private := server.PublicIP, false
+		if cmd.Private || ip == "" {
+			ip, private = server.PrivateIP, true
+		}
+		if ip == "" {
+			continue
+		}
+		host := SshHost{
+			Host:         []string{server.FQDN},
+			HostName:     ip,
+			User:         server.User,
+			IdentityFile: cmd.IdentityFile,
+			Comment:      fmt.Sprintf("%s %d %s", server.Type, server.Id, server.Datacenter),
+		}
+		if server.Hostname != "" && server.Hostname != server.FQDN && hostnames[server.Hostname] == 1 {
+			host.Host = append(host.Host, server.Hostname)
+		}
+		if cmd.User != "" {
+			host.User = cmd.User
+		}
+		if private {
+			host.ProxyJump = cmd.ProxyJump
+		}
+		hosts = append(hosts, host)
+	}
+	return hosts
+}
+
+// The Host blocks of hosts, in the format of ssh_config(5)
+func FormatSshConfig(hosts []SshHost) string {
+	var config strings.Builder
+	for i, host := range hosts {
+		if i > 0 {
+			config.WriteString("\n")
+		}
+		if host.Comment != "" {
+			config.WriteString("# " + strings.TrimSpace(host.Comment) + "\n")
+		}
+		config.WriteString("Host " + strings.Join(host.Host, " ") + "\n")
+		config.WriteString("    HostName " + host.HostName + "\n")
+		if host.User != "" {
+			config.WriteString("    User " + host.User + "\n")
+		}
+		if host.ProxyJump != "" {
+			config.WriteString("    ProxyJump " + host.ProxyJump + "\n")
+		}
+		if host.IdentityFile != "" {
+			config.WriteString("    IdentityFile " + host.IdentityFile + "\n")
+		}
+	}
+	return config.String()
+}
+
+// Puts hosts between the SSH_CONFIG_BEGIN and SSH_CONFIG_END lines of file, replacing what was there before.
+// The section is added to the end of the file when it doesn't have one yet, and the file is made if it doesn't exist.
+func MergeSshConfig(file string, hosts []SshHost) error {
+	subs := map[string]interface{}{"FILE": file}
+	// The file a symlinked config points to is the one to replace, not the link
+	if target, err := filepath.EvalSymlinks(file); err == nil {
+		file = target
+	}
+	mode := os.FileMode(0600)
+	content := ""
+	info, err := os.Stat(file)
+	switch {
+	case err == nil:
+		mode = info.Mode().Perm()
+		data, err := os.ReadFile(file) // #nosec
+		if err != nil {
+			subs["ERROR"] = err.Error()
+			return slErrors.New(T("Failed to read {{.FILE}}: {{.ERROR}}", subs))
+		}
+		content = string(data)
+	case os.IsNotExist(err):
+		err = os.MkdirAll(filepath.Dir(file), 0700)
+		if err != nil {
+			subs["ERROR"] = err.Error()
+			return slErrors.New(T("Failed to write {{.FILE}}: {{.ERROR}}", subs))
+		}
+	default:
+		subs["ERROR"] = err.Error()
+		return slErrors.New(T("Failed to read {{.FILE}}: {{.ERROR}}", subs))
+	}
+
+	section := SSH_CONFIG_BEGIN + "\n" + FormatSshConfig(hosts) + SSH_CONFIG_END + "\n"
+	begin := strings.Index(content, SSH_CONFIG_BEGIN+"\n")
+	if begin > 0 && content[begin-1] != '\n' {
+		begin = -1
+	}
+	if begin == -1 {
+		if content != "" && !strings.HasSuffix(content, "\n") {
+			content = content + "\n"
+		}
+		if content != "" {
+			content = content + "\n"
+		}
+		content = content + section
+	} else {
+		end := strings.Index(content[begin:], "\n"+SSH_CONFIG_END)
+		if end == -1 {
+			return slErrors.New(T("{{.FILE}} has a line '{{.BEGIN}}' without a line '{{.END}}' after it.",
+				map[string]interface{}{"FILE": file, "BEGIN": SSH_CONFIG_BEGIN, "END": SSH_CONFIG_END}))
+		}
+		rest := content[begin+end+len("\n"+SSH_CONFIG_END):]
+		rest = strings.TrimPrefix(rest, "\n")
+		content = content[:begin] + section + rest
+	}
+	err = replaceFile(file, []byte(content), mode)
+	if err != nil {
+		subs["ERROR"] = err.Error()
+		return slErrors.New(T("Failed to write {{.FILE}}: {{.ERROR}}", subs))
+	}
+	return nil
+}
+
+// Writes data to a new file next to file and renames it to file, so a write that fails half way leaves file as it was
+func replaceFile(file string, data []byte, mode os.FileMode) error {
+	temp, err := os.CreateTemp(filepath.Dir(file), "."+filepath.Base(file)+".*")
+	if err != nil {
+		return err
+	}
+	// Only there to remove when something failed, after the rename it is already gone
+	defer os.Remove(temp.Name()) // #nosec
+	_, err = temp.Write(data)
+	if err == nil {
+		err = temp.Sync()
+	}
+	closeErr := temp.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(temp.Name(), mode)
+	}
+	if err == nil {
+		err = os.Rename(temp.Name(), file)
+	}
+	return err
+}
@@ -0,0 +1,210 @@
+package inventory_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/inventory"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
+)
+
+var _ = Describe("inventory ssh-config", func() {
+	var (
+		fakeUI        *terminal.FakeUI
+		cliCommand    *inventory.SshConfigCommand
+		fakeSession   *session.Session
+		slCommand     *metadata.SoftlayerCommand
+		fakeVSManager *testhelpers.FakeVirtualServerManager
+		fakeHWManager *testhelpers.FakeHardwareServerManager
+	)
+	BeforeEach(func() {
+		fakeUI = terminal.NewFakeUI()
+		fakeSession = testhelpers.NewFakeSoftlayerSession([]string{})
+		fakeVSManager = new(testhelpers.FakeVirtualServerManager)
+		fakeHWManager = new(testhelpers.FakeHardwareServerManager)
+		slCommand = metadata.NewSoftlayerCommand(fakeUI, fakeSession)
+		cliCommand = inventory.NewSshConfigCommand(slCommand)
+		cliCommand.Command.PersistentFlags().Var(cliCommand.OutputFlag, "output", "--output=JSON for json output.")
+		cliCommand.VirtualServerManager = fakeVSManager
+		cliCommand.HardwareServerManager = fakeHWManager
+		fakeVSManager.ListInstancesReturns([]datatypes.Virtual_Guest{
+			{
+				Id:                       sl.Int(100),
+				Hostname:                 sl.String("web1"),
+				Domain:                   sl.String("example.com"),
+				FullyQualifiedDomainName: sl.String("web1.example.com"),
+				PrimaryIpAddress:         sl.String("169.1.1.1"),
+				PrimaryBackendIpAddress:  sl.String("10.1.1.1"),
+				Datacenter:               &datatypes.Location{Name: sl.String("dal13")},
+				OperatingSystem: &datatypes.Software_Component_OperatingSystem{Software_Component: datatypes.Software_Component{
+					Passwords: []datatypes.Software_Component_Password{{Username: sl.String("admin")}, {Username: sl.String("root")}},
+				}},
+			},
+			{
+				Id:                       sl.Int(101),
+				Hostname:                 sl.String("app1"),
+				Domain:                   sl.String("example.com"),
+				FullyQualifiedDomainName: sl.String("app1.example.com"),
+				PrimaryBackendIpAddress:  sl.String("10.1.1.2"),
+				PrivateNetworkOnlyFlag:   sl.Bool(true),
+				Datacenter:               &datatypes.Location{Name: sl.String("dal13")},
+			},
+		}, nil)
+		fakeHWManager.ListHardwareReturns([]datatypes.Hardware_Server{
+			{Hardware: datatypes.Hardware{
+				Id:                       sl.Int(200),
+				Hostname:                 sl.String("web1"),
+				Domain:                   sl.String("example.org"),
+				FullyQualifiedDomainName: sl.String("web1.example.org"),
+				PrimaryIpAddress:         sl.String("169.2.2.2"),
+				PrimaryBackendIpAddress:  sl.String("10.2.2.2"),
+				Datacenter:               &datatypes.Location{Name: sl.String("wdc07")},
+			}},
+			{Hardware: datatypes.Hardware{
+				Id:                       sl.Int(201),
+				Hostname:                 sl.String("noip"),
+				Domain:                   sl.String("example.org"),
+				FullyQualifiedDomainName: sl.String("noip.example.org"),
+			}},
+		}, nil)
+	})
+
+	Describe("inventory ssh-config", func() {
+		It("Errors with arguments", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "abc")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid argument abc for"))
+		})
+		It("Prints a Host for every server", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(ContainSubstring("# virtual 100 dal13\nHost web1.example.com\n    HostName 169.1.1.1\n    User root\n"))
+			Expect(fakeUI.Outputs()).To(ContainSubstring("# virtual 101 dal13\nHost app1.example.com app1\n    HostName 10.1.1.2\n"))
+			Expect(fakeUI.Outputs()).To(ContainSubstring("# hardware 200 wdc07\nHost web1.example.org\n    HostName 169.2.2.2\n"))
+			Expect(fakeUI.Outputs()).NotTo(ContainSubstring("noip"))
+			Expect(fakeUI.Outputs()).NotTo(ContainSubstring("ProxyJump"))
+		})
+		It("Passes the filters to both managers", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "--tag", "web", "--tag", "db", "-d", "dal13", "-D", "example.com")
+			Expect(err).NotTo(HaveOccurred())
+			_, _, domain, _, datacenter, _, _, _, _, _, _, _, tags, mask, _ := fakeVSManager.ListInstancesArgsForCall(0)
+			Expect(domain).To(Equal("example.com"))
+			Expect(datacenter).To(Equal("dal13"))
+			Expect(tags).To(Equal([]string{"web", "db"}))
+			Expect(mask).To(Equal(inventory.INVENTORY_VIRTUAL_GUEST_MASK))
+			hwTags, _, _, _, hwDomain, hwDatacenter, _, _, _, _, _, hwMask, _ := fakeHWManager.ListHardwareArgsForCall(0)
+			Expect(hwTags).To(Equal([]string{"web", "db"}))
+			Expect(hwDomain).To(Equal("example.com"))
+			Expect(hwDatacenter).To(Equal("dal13"))
+			Expect(hwMask).To(Equal(inventory.INVENTORY_HARDWARE_MASK))
+		})
+		It("Uses private IPs and the proxy jump host", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "--private", "--proxy-jump", "bastion", "-u", "deploy", "-i", "~/.ssh/sl")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(ContainSubstring("Host web1.example.com\n    HostName 10.1.1.1\n    User deploy\n    ProxyJump bastion\n    IdentityFile ~/.ssh/sl\n"))
+		})
+		It("Only jumps to private only servers", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "--proxy-jump", "bastion")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(ContainSubstring("Host app1.example.com app1\n    HostName 10.1.1.2\n    ProxyJump bastion\n"))
+			Expect(fakeUI.Outputs()).To(ContainSubstring("Host web1.example.com\n    HostName 169.1.1.1\n    User root\n\n"))
+		})
+		It("Prints JSON", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "--output", "JSON")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(ContainSubstring(`"hostName": "169.1.1.1"`))
+			Expect(fakeUI.Outputs()).To(ContainSubstring(`"user": "root"`))
+		})
+		It("Returns an error when the servers can't be listed", func() {
+			fakeHWManager.ListHardwareReturns(nil, errors.New("Internal Server Error"))
+			err := testhelpers.RunCobraCommand(cliCommand.Command)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Failed to list hardware servers on your account."))
+			Expect(err.Error()).To(ContainSubstring("Internal Server Error"))
+		})
+	})
+
+	Describe("inventory ssh-config --merge", func() {
+		var file string
+		BeforeEach(func() {
+			file = filepath.Join(GinkgoT().TempDir(), "config")
+		})
+		It("Makes the file", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "--merge", file)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(ContainSubstring("Wrote 3 hosts to " + file + "."))
+			content, err := os.ReadFile(file)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(HavePrefix(inventory.SSH_CONFIG_BEGIN + "\n# virtual 101 dal13\n"))
+			Expect(string(content)).To(HaveSuffix("HostName 169.2.2.2\n" + inventory.SSH_CONFIG_END + "\n"))
+			info, err := os.Stat(file)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		})
+		It("Adds the section to the end of the file", func() {
+			Expect(os.WriteFile(file, []byte("Host github.com\n    User git"), 0644)).To(Succeed())
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "--merge", file)
+			Expect(err).NotTo(HaveOccurred())
+			content, err := os.ReadFile(file)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(HavePrefix("Host github.com\n    User git\n\n" + inventory.SSH_CONFIG_BEGIN + "\n"))
+			info, err := os.Stat(file)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0644)))
+		})
+		It("Replaces the section and keeps the rest of the file", func() {
+			old := "Host a\n    User a\n\n" + inventory.SSH_CONFIG_BEGIN + "\nHost old\n    HostName 1.1.1.1\n" + inventory.SSH_CONFIG_END + "\n\nHost b\n    User b\n"
+			Expect(os.WriteFile(file, []byte(old), 0600)).To(Succeed())
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "--merge", file, "--tag", "web")
+			Expect(err).NotTo(HaveOccurred())
+			content, err := os.ReadFile(file)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(HavePrefix("Host a\n    User a\n\n" + inventory.SSH_CONFIG_BEGIN + "\n# virtual 101 dal13\n"))
+			Expect(string(content)).To(HaveSuffix(inventory.SSH_CONFIG_END + "\n\nHost b\n    User b\n"))
+			Expect(string(content)).NotTo(ContainSubstring("Host old"))
+		})
+		It("Replaces the file without leaving temporary files next to it", func() {
+			Expect(os.WriteFile(file, []byte("Host a\n    User a\n"), 0600)).To(Succeed())
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "--merge", file)
+			Expect(err).NotTo(HaveOccurred())
+			entries, err := os.ReadDir(filepath.Dir(file))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].Name()).To(Equal("config"))
+		})
+		It("Writes to the file a symlinked config points to", func() {
+			target := filepath.Join(GinkgoT().TempDir(), "dotfiles-ssh-config")
+			Expect(os.WriteFile(target, []byte("Host a\n    User a\n"), 0600)).To(Succeed())
+			Expect(os.Symlink(target, file)).To(Succeed())
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "--merge", file)
+			Expect(err).NotTo(HaveOccurred())
+			info, err := os.Lstat(file)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode() & os.ModeSymlink).NotTo(BeZero())
+			content, err := os.ReadFile(target)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(ContainSubstring(inventory.SSH_CONFIG_BEGIN))
+		})
+		It("Errors when the section has no end", func() {
+			Expect(os.WriteFile(file, []byte(inventory.SSH_CONFIG_BEGIN+"\nHost old\n"), 0600)).To(Succeed())
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "--merge", file)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("without a line '" + inventory.SSH_CONFIG_END + "' after it."))
+		})
+		It("Can't be used with JSON output", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "--merge", file, "--output", "JSON")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("'--merge' and '--output JSON' can't be used together."))
+			Expect(fakeVSManager.ListInstancesCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -32,6 +32,7 @@ func NewCreateCommand(sl *metadata.SoftlayerCommand) *CreateCommand {
 	}
 	cobraCmd.Flags().StringVar(&thisCmd.Key, "key", "", T("The VMware License Key. To get the required package you can use the command sl licenses create-options Package. E.g VMWARE_VSAN_ENTERPRISE_TIER_III_65_124_TB_6_X_2  [required]"))
 	cobraCmd.Flags().StringVar(&thisCmd.Datacenter, "datacenter", "", T("Datacenter shortname  [required]"))
+	metadata.UseProfileDatacenter(cobraCmd, "datacenter")
 
 	thisCmd.Command = cobraCmd
 	return thisCmd
@@ -50,9 +51,11 @@ func (cmd *CreateCommand) Run(args []string) error {
 	if err != nil {
 		return slErr.NewAPIError(T("Failed to create the license."), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, orderLicense)
+	}
 	table.Add("Id", utils.FormatIntPointer(orderLicense.OrderId))
 	table.Add("Created", utils.FormatSLTimePointer(orderLicense.OrderDate))
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
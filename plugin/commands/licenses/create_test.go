@@ -46,7 +46,7 @@ var _ = Describe("Licenses list Create", func() {
 			It("Set command with an invalid output option", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 			It("Set command without any datacenter and keyName", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command)
@@ -66,14 +66,8 @@ var _ = Describe("Licenses list Create", func() {
 			It("return licenses create in format json", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--datacenter", "dal05", "--key", "XXX_XXX_XXX", "--output", "json")
 				Expect(err).NotTo(HaveOccurred())
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Name": "Id",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Value": "123456"`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Name": "Created",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Value": "2017-11-08T00:00:00Z"`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`[`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`{`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`}`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`]`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"orderId": 123456`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"orderDate": "2017-11-08T00:00:00Z",`))
 			})
 		})
 		Context("Licenses errors", func() {
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"fmt"
 
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/spf13/cobra"
 
@@ -81,7 +80,7 @@ func (cmd *OptionsCommand) Run(args []string) error {
 
 			table := cmd.UI.Table([]string{T("Prices:"), T("Private Subnets")})
 			bufPrice := new(bytes.Buffer)
-			tblPrice := terminal.NewTable(bufPrice, []string{T("Key Name"), T("Cost")})
+			tblPrice := utils.NewTable(bufPrice, []string{T("Key Name"), T("Cost")})
 			var prices []Price
 			for _, item := range pkgs[len(pkgs)-1].Items {
 				var iPrice Price
@@ -108,13 +107,13 @@ func (cmd *OptionsCommand) Run(args []string) error {
 			}
 			tblPrice.Print()
 
-			subnets, err := cmd.NetworkManager.ListSubnets("", dcName, 0, "", "PRIVATE", 0, "networkVlan,podName,addressSpace")
+			subnets, err := cmd.NetworkManager.ListSubnets("", dcName, 0, "", "PRIVATE", 0, "networkVlan,podName,addressSpace", metadata.Paging{})
 			if err != nil {
 				table.Add(T("Private Subnets"), T("Failed to get subnets.")+err.Error())
 			} else {
 				if len(subnets) > 0 {
 					bufSubnet := new(bytes.Buffer)
-					tblSubnet := terminal.NewTable(bufSubnet, []string{T("ID"), T("Subnet"), T("Vlan")})
+					tblSubnet := utils.NewTable(bufSubnet, []string{T("ID"), T("Subnet"), T("Vlan")})
 					for _, subnet := range subnets {
 						if subnet.SubnetType != nil && *subnet.SubnetType != "PRIMARY" && *subnet.SubnetType != "ADDITIONAL_PRIMARY" {
 							continue
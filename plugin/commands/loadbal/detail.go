@@ -78,7 +78,7 @@ func PrintLoadbalancer(loadbal datatypes.Network_LBaaS_LoadBalancer, ui terminal
 	if len(loadbal.Listeners) > 0 {
 		pools := make(map[string]string)
 		bufListener := new(bytes.Buffer)
-		tblListener := terminal.NewTable(bufListener, []string{
+		tblListener := utils.NewTable(bufListener, []string{
 			"ID",
 			"UUID",
 			"Mapping",
@@ -125,7 +125,7 @@ func PrintLoadbalancer(loadbal datatypes.Network_LBaaS_LoadBalancer, ui terminal
 			"Modify",
 			"Active",
 		}
-		tblMember := terminal.NewTable(bufMember, memCol)
+		tblMember := utils.NewTable(bufMember, memCol)
 		for _, member := range loadbal.Members {
 			row := []string{
 				utils.FormatIntPointer(member.Id),
@@ -145,7 +145,7 @@ func PrintLoadbalancer(loadbal datatypes.Network_LBaaS_LoadBalancer, ui terminal
 
 	if len(loadbal.HealthMonitors) > 0 {
 		bufHealth := new(bytes.Buffer)
-		tblHealth := terminal.NewTable(bufHealth, []string{
+		tblHealth := utils.NewTable(bufHealth, []string{
 			"ID",
 			"UUID",
 			"Protocol",
@@ -177,7 +177,7 @@ func PrintLoadbalancer(loadbal datatypes.Network_LBaaS_LoadBalancer, ui terminal
 
 	if len(loadbal.L7Pools) > 0 {
 		bufL7 := new(bytes.Buffer)
-		tblL7 := terminal.NewTable(bufL7, []string{
+		tblL7 := utils.NewTable(bufL7, []string{
 			"ID",
 			"UUID",
 			"Name",
@@ -86,7 +86,7 @@ func printL7Pool(l7pool datatypes.Network_LBaaS_L7Pool, l7Members []datatypes.Ne
 	}
 
 	bufHealth := new(bytes.Buffer)
-	tblHealth := terminal.NewTable(bufHealth, []string{
+	tblHealth := utils.NewTable(bufHealth, []string{
 		"Interval",
 		"Retries",
 		"Type",
@@ -116,7 +116,7 @@ func printL7Pool(l7pool datatypes.Network_LBaaS_L7Pool, l7Members []datatypes.Ne
 		"Modify",
 		"Active",
 	}
-	tblMember := terminal.NewTable(bufMember, memCol)
+	tblMember := utils.NewTable(bufMember, memCol)
 	for _, member := range l7Members {
 		row := []string{
 			utils.FormatIntPointer(member.Id),
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
 	"github.com/spf13/cobra"
 
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
@@ -51,6 +50,9 @@ func (cmd *NetscalerDetailCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to get netscaler {{.ID}} on your account.", map[string]interface{}{"ID": netscalerID}), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, ns)
+	}
 
 	table := cmd.UI.Table([]string{T("Name"), T("Value")})
 	table.Add("ID", utils.FormatIntPointer(ns.Id))
@@ -75,7 +77,7 @@ func (cmd *NetscalerDetailCommand) Run(args []string) error {
 	table.Add("License Expiration", utils.FormatSLTimePointer(ns.LicenseExpirationDate))
 
 	bufSubnet := new(bytes.Buffer)
-	tblSubnet := terminal.NewTable(bufSubnet, []string{
+	tblSubnet := utils.NewTable(bufSubnet, []string{
 		"ID",
 		"Subnet",
 		"Type",
@@ -88,7 +90,7 @@ func (cmd *NetscalerDetailCommand) Run(args []string) error {
 	table.Add("Subnet", bufSubnet.String())
 
 	bufVlan := new(bytes.Buffer)
-	tblVlan := terminal.NewTable(bufVlan, []string{
+	tblVlan := utils.NewTable(bufVlan, []string{
 		"ID",
 		"Number",
 	})
@@ -98,6 +100,5 @@ func (cmd *NetscalerDetailCommand) Run(args []string) error {
 	tblVlan.Print()
 	table.Add("Vlans", bufVlan.String())
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
@@ -90,7 +90,7 @@ var _ = Describe("Load balancer edit policies", func() {
 			It("Set command with an invalid output option", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "123465", "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 		})
 
@@ -116,15 +116,11 @@ var _ = Describe("Load balancer edit policies", func() {
 			It("with correct id in output json", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "123", "--output=json")
 				Expect(err).NotTo(HaveOccurred())
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Value": "123"`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Value": "Netscaler name"`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Value": "11.11.11.11"`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Value": "abcde123456"`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Value": "2016-12-29T00:00:00Z"`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`{`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`}`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`[`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`]`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"id": 123,`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"name": "Netscaler name",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"managementIpAddress": "11.11.11.11",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"password": "abcde123456"`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"licenseExpirationDate": "2016-12-29T00:00:00Z",`))
 			})
 		})
 
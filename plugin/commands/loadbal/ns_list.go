@@ -41,6 +41,9 @@ func (cmd *NetscalerListCommand) Run(args []string) error {
 	}
 
 	outputFormat := cmd.GetOutputFlag()
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, netscalers)
+	}
 
 	if len(netscalers) == 0 {
 		cmd.UI.Say(T("No netscalers was found."))
@@ -62,7 +65,9 @@ func (cmd *NetscalerListCommand) Run(args []string) error {
 				utils.FormatSLTimePointer(ns.CreateDate),
 			)
 		}
-		utils.PrintTable(cmd.UI, table, outputFormat)
+		if err := utils.PrintTable(cmd.UI, table, outputFormat); err != nil {
+			return err
+		}
 	}
 	return nil
 }
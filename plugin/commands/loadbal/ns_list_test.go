@@ -67,7 +67,7 @@ var _ = Describe("Load balancer edit policies", func() {
 			It("Set command with an invalid output option", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 		})
 
@@ -83,16 +83,12 @@ var _ = Describe("Load balancer edit policies", func() {
 			It("list all netscalers in output json", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output=json")
 				Expect(err).NotTo(HaveOccurred())
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"ID": "123",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Location": "dal01",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Name": "Netscaler Name",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Description": "Description Netscaler",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"IP Address": "10.10.10.10",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Create Date": "2016-12-29T00:00:00Z"`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`{`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`}`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`[`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`]`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"id": 123,`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"longName": "dal01"`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"name": "Netscaler Name",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"description": "Description Netscaler",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"primaryIpAddress": "10.10.10.10"`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"createDate": "2016-12-29T00:00:00Z",`))
 
 			})
 		})
@@ -49,6 +49,9 @@ func (cmd *CredentialsCommand) Run(args []string) error {
 	if err != nil {
 		return slErr.NewAPIError(T("Failed to get NAS Network Storage."), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, nasNetworkStorage)
+	}
 
 	table := cmd.UI.Table([]string{T("Username"), T("Password")})
 
@@ -61,6 +64,5 @@ func (cmd *CredentialsCommand) Run(args []string) error {
 		password,
 	)
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
@@ -42,6 +42,9 @@ func (cmd *ListCommand) Run(args []string) error {
 	if err != nil {
 		return slErr.NewAPIError(T("Failed to get NAS Network Storages."), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, nasNetworkStorages)
+	}
 
 	table := cmd.UI.Table([]string{T("Id"), T("Datacenter"), T("Size"), T("Server")})
 	for _, nasNetworkStorage := range nasNetworkStorages {
@@ -57,6 +60,5 @@ func (cmd *ListCommand) Run(args []string) error {
 		)
 	}
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
@@ -52,11 +52,13 @@ func (cmd *AccountsCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to get account’s associated Virtual Storage volumes."), err.Error(), 2)
 	}
-	PrintAccounts(accounts, cmd.UI, outputFormat)
-	return nil
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, accounts)
+	}
+	return PrintAccounts(accounts, cmd.UI, outputFormat)
 }
 
-func PrintAccounts(accounts []datatypes.Network_Storage, ui terminal.UI, outputFormat string) {
+func PrintAccounts(accounts []datatypes.Network_Storage, ui terminal.UI, outputFormat string) error {
 	table := ui.Table([]string{
 		T("Id"),
 		T("Name"),
@@ -78,5 +80,5 @@ func PrintAccounts(accounts []datatypes.Network_Storage, ui terminal.UI, outputF
 			utils.FormatStringPointerName(&apiType),
 		)
 	}
-	utils.PrintTable(ui, table, outputFormat)
+	return utils.PrintTable(ui, table, outputFormat)
 }
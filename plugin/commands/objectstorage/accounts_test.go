@@ -31,7 +31,7 @@ var _ = Describe("Object Storage list Object Storages", func() {
 			It("Set command with an invalid output option", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 		})
 
@@ -45,13 +45,9 @@ var _ = Describe("Object Storage list Object Storages", func() {
 			It("return objectstorage accounts in format json", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output", "json")
 				Expect(err).NotTo(HaveOccurred())
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Id": "123456789",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Name": "SLUSER",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"ApiType": "S3"`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`[`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`{`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`}`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`]`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"id": 123456789,`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"username": "SLUSER"`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"type": "CLEVERSAFE"`))
 			})
 		})
 	})
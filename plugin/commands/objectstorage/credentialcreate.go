@@ -57,11 +57,14 @@ func (cmd *CredentialCreateCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to create credential. "), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, credentialCreate)
+	}
 	PrintCredentialCreated(credentialCreate, cmd.UI, outputFormat)
 	return nil
 }
 
-func PrintCredentialCreated(credentialCreate []datatypes.Network_Storage_Credential, ui terminal.UI, outputFormat string) {
+func PrintCredentialCreated(credentialCreate []datatypes.Network_Storage_Credential, ui terminal.UI, outputFormat string) error {
 	table := ui.Table([]string{
 		T("Id"),
 		T("Password"),
@@ -77,5 +80,5 @@ func PrintCredentialCreated(credentialCreate []datatypes.Network_Storage_Credent
 			utils.FormatStringPointerName(credential.Type.Name),
 		)
 	}
-	utils.PrintTable(ui, table, outputFormat)
+	return utils.PrintTable(ui, table, outputFormat)
 }
@@ -41,7 +41,7 @@ var _ = Describe("Object Storage create Object Storages", func() {
 			It("Set command with an invalid output option", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "123", "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 		})
 
@@ -55,14 +55,10 @@ var _ = Describe("Object Storage create Object Storages", func() {
 			It("return objectstorage endpoints in format json", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "123", "--output", "json")
 				Expect(err).NotTo(HaveOccurred())
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Id": "17987654",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Password": "abcdefghijklmnopqrstuvwxyz",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Username": "123456mnopqrstuvwxyz",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Type Name": "S3 Compatible Signature"`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`[`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`{`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`}`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`]`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"id": 17987654,`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"password": "abcdefghijklmnopqrstuvwxyz",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"username": "123456mnopqrstuvwxyz"`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"name": "S3 Compatible Signature"`))
 			})
 		})
 	})
@@ -53,11 +53,14 @@ func (cmd *CredentialLimitCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to get credential limit. "), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, credentialLimit)
+	}
 	PrintCredentialLimit(credentialLimit, cmd.UI, outputFormat)
 	return nil
 }
 
-func PrintCredentialLimit(credentialLimit int, ui terminal.UI, outputFormat string) {
+func PrintCredentialLimit(credentialLimit int, ui terminal.UI, outputFormat string) error {
 	table := ui.Table([]string{
 		T("Limit"),
 	})
@@ -65,5 +68,5 @@ func PrintCredentialLimit(credentialLimit int, ui terminal.UI, outputFormat stri
 	table.Add(
 		utils.FormatIntPointer(&credentialLimit),
 	)
-	utils.PrintTable(ui, table, outputFormat)
+	return utils.PrintTable(ui, table, outputFormat)
 }
@@ -53,11 +53,14 @@ func (cmd *CredentialListCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to list credentials. "), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, credentialList)
+	}
 	PrintCredentialList(credentialList, cmd.UI, outputFormat)
 	return nil
 }
 
-func PrintCredentialList(credentialList []datatypes.Network_Storage_Credential, ui terminal.UI, outputFormat string) {
+func PrintCredentialList(credentialList []datatypes.Network_Storage_Credential, ui terminal.UI, outputFormat string) error {
 	table := ui.Table([]string{
 		T("Id"),
 		T("Password"),
@@ -73,5 +76,5 @@ func PrintCredentialList(credentialList []datatypes.Network_Storage_Credential,
 			utils.FormatStringPointerName(credential.Type.Name),
 		)
 	}
-	utils.PrintTable(ui, table, outputFormat)
+	return utils.PrintTable(ui, table, outputFormat)
 }
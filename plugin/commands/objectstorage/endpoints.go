@@ -58,11 +58,13 @@ func (cmd *EndpointsCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to get list object storage endpoints."), err.Error(), 2)
 	}
-	PrintEndpoints(endpoints, cmd.UI, outputFormat)
-	return nil
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, endpoints)
+	}
+	return PrintEndpoints(endpoints, cmd.UI, outputFormat)
 }
 
-func PrintEndpoints(endpoints []datatypes.Container_Network_Storage_Hub_ObjectStorage_Endpoint, ui terminal.UI, outputFormat string) {
+func PrintEndpoints(endpoints []datatypes.Container_Network_Storage_Hub_ObjectStorage_Endpoint, ui terminal.UI, outputFormat string) error {
 	table := ui.Table([]string{
 		T("Location/Region"),
 		T("Url"),
@@ -93,7 +95,7 @@ func PrintEndpoints(endpoints []datatypes.Container_Network_Storage_Hub_ObjectSt
 			array.Legacy,
 		)
 	}
-	utils.PrintTable(ui, table, outputFormat)
+	return utils.PrintTable(ui, table, outputFormat)
 }
 
 func LegacyReturn(data bool) string {
@@ -41,7 +41,7 @@ var _ = Describe("Object Storage list Object Storages", func() {
 			It("Set command with an invalid output option", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "123", "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 		})
 
@@ -55,15 +55,11 @@ var _ = Describe("Object Storage list Object Storages", func() {
 			It("return objectstorage endpoints in format json", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "123", "--output", "json")
 				Expect(err).NotTo(HaveOccurred())
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Location/Region": "us-geo",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Url": "s3.us.cloud-object-storage.appdomain.cloud",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"EndPoint Type": "Cross Region",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Public/Private": "Public",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Legacy": "False"`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`[`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`{`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`}`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`]`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"region": "us-geo",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"url": "s3.us.cloud-object-storage.appdomain.cloud"`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"type": "public",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"location": "Dallas",`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"legacy": false,`))
 			})
 		})
 	})
@@ -44,12 +44,14 @@ func (cmd *CancelationCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to list all item cancelations."), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, items)
+	}
 
-	PrintItemsCancelation(items, cmd.UI, outputFormat)
-	return nil
+	return PrintItemsCancelation(items, cmd.UI, outputFormat)
 }
 
-func PrintItemsCancelation(items []datatypes.Billing_Item_Cancellation_Request, ui terminal.UI, outputFormat string) {
+func PrintItemsCancelation(items []datatypes.Billing_Item_Cancellation_Request, ui terminal.UI, outputFormat string) error {
 	table := ui.Table([]string{
 		T("Case Number"),
 		T("Number Of Items Cancelled"),
@@ -68,5 +70,5 @@ func PrintItemsCancelation(items []datatypes.Billing_Item_Cancellation_Request,
 			requestedBy,
 		)
 	}
-	utils.PrintTable(ui, table, outputFormat)
+	return utils.PrintTable(ui, table, outputFormat)
 }
@@ -32,7 +32,7 @@ var _ = Describe("order cancelation", func() {
 			It("Set invalid output", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 		})
 
@@ -53,14 +53,10 @@ var _ = Describe("order cancelation", func() {
 			It("List order cancelations in json format", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output=json")
 				Expect(err).NotTo(HaveOccurred())
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Case Number": "153572280",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Number Of Items Cancelled": "1",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Status": "Approved",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Requested by": "UserTest UserLastName"`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`[`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`{`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`}`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`]`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"ticketId": 153572280,`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"itemCount": 1,`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"name": "Approved"`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"firstName": "UserTest2",`))
 			})
 		})
 
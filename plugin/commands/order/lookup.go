@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
 	"github.com/spf13/cobra"
 
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
@@ -55,6 +54,9 @@ func (cmd *LookupCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to get Order."), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, order)
+	}
 
 	table := cmd.UI.Table([]string{T("Name"), T("Value")})
 	table.Add(T("ID"), utils.FormatIntPointer(order.Id))
@@ -77,7 +79,7 @@ func (cmd *LookupCommand) Run(args []string) error {
 	}
 
 	buf := new(bytes.Buffer)
-	itemsTable := terminal.NewTable(buf, []string{T("Item Description")})
+	itemsTable := utils.NewTable(buf, []string{T("Item Description")})
 	for _, item := range order.Items {
 		itemsTable.Add(utils.FormatStringPointer(item.Description))
 	}
@@ -85,7 +87,7 @@ func (cmd *LookupCommand) Run(args []string) error {
 	table.Add(T("Items"), buf.String())
 
 	buf = new(bytes.Buffer)
-	invoiceTable := terminal.NewTable(buf, []string{
+	invoiceTable := utils.NewTable(buf, []string{
 		T("Item Id"),
 		T("Category"),
 		T("Description"),
@@ -133,6 +135,5 @@ func (cmd *LookupCommand) Run(args []string) error {
 	invoiceTable.Print()
 	table.Add(T("Initial Invoice"), buf.String())
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
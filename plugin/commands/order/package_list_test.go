@@ -36,12 +36,12 @@ var _ = Describe("Order package-list", func() {
 
 		Context("Return error", func() {
 			BeforeEach(func() {
-				fakeOrderManager.ListPackageReturns([]datatypes.Product_Package{}, errors.New("Invalid output format, only JSON is supported now."))
+				fakeOrderManager.ListPackageReturns([]datatypes.Product_Package{}, errors.New("Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 			It("Invalid output is set", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 		})
 
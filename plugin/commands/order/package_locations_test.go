@@ -57,12 +57,12 @@ var _ = Describe("Order package-locations", func() {
 
 		Context("Return error", func() {
 			BeforeEach(func() {
-				fakeOrderManager.PackageLocationReturns([]datatypes.Location_Region{}, errors.New("Invalid output format, only JSON is supported now."))
+				fakeOrderManager.PackageLocationReturns([]datatypes.Location_Region{}, errors.New("Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 			It("Invalid output is set", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "BARE_METAL_SERVER", "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 		})
 
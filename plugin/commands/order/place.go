@@ -10,6 +10,7 @@ import (
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/spf13/cobra"
 
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/cmdutils"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -28,6 +29,7 @@ type PlaceCommand struct {
 	ComplexType  string
 	Extras       string
 	ForceFlag    bool
+	Wait         cmdutils.WaitOptions
 }
 
 func NewPlaceCommand(sl *metadata.SoftlayerCommand) (cmd *PlaceCommand) {
@@ -55,6 +57,7 @@ func NewPlaceCommand(sl *metadata.SoftlayerCommand) (cmd *PlaceCommand) {
 	cobraCmd.Flags().StringVar(&thisCmd.ComplexType, "complex-type", "", T("The complex type of the order. The type begins with 'SoftLayer_Container_Product_Order_'"))
 	cobraCmd.Flags().StringVar(&thisCmd.Extras, "extras", "", T("JSON string that denotes extra data needs to be sent with the order"))
 	cobraCmd.Flags().BoolVarP(&thisCmd.ForceFlag, "force", "f", false, T("Force operation without confirmation"))
+	cmdutils.AddWaitFlags(cobraCmd, &thisCmd.Wait, T("everything on the order is provisioned"))
 
 	thisCmd.Command = cobraCmd
 	return thisCmd
@@ -133,10 +136,22 @@ func (cmd *PlaceCommand) Run(args []string) error {
 		if err != nil {
 			return err
 		}
+		if outputFormat != "JSON" {
+			cmd.PrintOrder(orderPlace)
+		}
+		if cmd.Wait.Enabled() && orderPlace.OrderId != nil {
+			subs := map[string]interface{}{"OrderID": *orderPlace.OrderId}
+			err = cmdutils.WaitFor(cmd.SoftlayerCommand, cmd.Wait, T("order {{.OrderID}}", subs), managers.OrderReadyCheck(cmd.OrderManager, *orderPlace.OrderId))
+			if err != nil {
+				return err
+			}
+			if outputFormat != "JSON" {
+				cmd.UI.Print(T("Order {{.OrderID}} is ready.", subs))
+			}
+		}
 		if outputFormat == "JSON" {
 			return utils.PrintPrettyJSON(cmd.UI, orderPlace)
 		}
-		cmd.PrintOrder(orderPlace)
 	}
 	return nil
 }
@@ -1,12 +1,15 @@
 package order_test
 
 import (
+	"time"
+
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/cmdutils"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
@@ -169,7 +172,7 @@ var _ = Describe("Place", func() {
 					cliCommand.Command, "CLOUD_SERVER", "dal13", "EVAULT_100_GB,CITRIX_VDC", "--verify",
 					"--complex-type=SoftLayer_Container_Product_Order_Virtual_Guest", "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 
 			It("Invalid output is set with more of three arguments", func() {
@@ -177,7 +180,7 @@ var _ = Describe("Place", func() {
 					cliCommand.Command, "CLOUD_SERVER", "dal13", "EVAULT_100_GB", "CITRIX_VDC", "--verify",
 					"--complex-type=SoftLayer_Container_Product_Order_Virtual_Guest", "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 		})
 		Context("Handle User Input", func() {
@@ -211,6 +214,35 @@ var _ = Describe("Place", func() {
 				Expect(fakeUI.Outputs()).To(ContainSubstring("11493593"))
 			})
 		})
+		Context("Place order with --wait", func() {
+			var now time.Time
+			BeforeEach(func() {
+				fakeHandler.SetFileNames([]string{"getDatacenters_1", "getObject_pending"})
+				now = time.Now()
+				cmdutils.WaitNow = func() time.Time { return now }
+				cmdutils.WaitSleep = func(wait time.Duration) { now = now.Add(wait) }
+			})
+			AfterEach(func() {
+				cmdutils.WaitNow = time.Now
+				cmdutils.WaitSleep = time.Sleep
+			})
+			It("Checks the order until the timeout", func() {
+				err := testhelpers.RunCobraCommand(
+					cliCommand.Command, "CLOUD_SERVER", "dal13", "EVAULT_100_GB,CITRIX_VDC", "-f",
+					"--complex-type=SoftLayer_Container_Product_Order_Virtual_Guest", "--wait=30", "--wait-interval=10")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Stopped waiting after 30 seconds"))
+				Expect(fakeUI.Outputs()).To(ContainSubstring("11493593"))
+				Expect(fakeUI.Errors()).To(ContainSubstring("Waiting for order 11493593: PENDING_AUTO_APPROVAL"))
+				getOrderCalls := 0
+				for _, call := range fakeHandler.ApiCallLogs {
+					if call.Service == "SoftLayer_Billing_Order" && call.Method == "getObject" {
+						getOrderCalls++
+					}
+				}
+				Expect(getOrderCalls).To(Equal(4))
+			})
+		})
 	})
 
 	Describe("softlayer-cli/issues/863", func() {
@@ -107,6 +107,9 @@ func (cmd *QuoteCommand) Run(args []string) error {
 		if err != nil {
 			return errors.NewAPIError(T("Failed to verify Quote.\n"), err.Error(), 2)
 		}
+		if outputFormat == "JSON" {
+			return utils.PrintPrettyJSON(cmd.UI, order)
+		}
 
 		table = cmd.UI.Table([]string{T("KeyName"), T("Description"), T("Cost")})
 		for _, price := range order.Prices {
@@ -134,6 +137,9 @@ func (cmd *QuoteCommand) Run(args []string) error {
 		if err != nil {
 			return errors.NewAPIError(T("Failed to order Quote.\n"), err.Error(), 2)
 		}
+		if outputFormat == "JSON" {
+			return utils.PrintPrettyJSON(cmd.UI, order)
+		}
 
 		table = cmd.UI.Table([]string{T("Name"), T("Value")})
 		table.Add("Id", utils.FormatIntPointer(order.OrderId))
@@ -141,8 +147,7 @@ func (cmd *QuoteCommand) Run(args []string) error {
 		table.Add("Status", utils.FormatStringPointer(order.PlacedOrder.Status))
 	}
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
 
 func setArguments(cmd *QuoteCommand, recalculatedOrderContainer datatypes.Container_Product_Order) (datatypes.Container_Product_Order, error) {
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"strconv"
 
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
 	"github.com/spf13/cobra"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
@@ -50,6 +49,9 @@ func (cmd *QuoteDetailCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to get Quote\n"), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, quote)
+	}
 
 	table := cmd.UI.Table([]string{T("Name"), T("Value")})
 	table.Add(T("Id"), utils.FormatIntPointer(quote.Id))
@@ -57,7 +59,7 @@ func (cmd *QuoteDetailCommand) Run(args []string) error {
 	table.Add(T("Package"), utils.FormatStringPointer(quote.Order.Items[0].Package.KeyName))
 
 	buf := new(bytes.Buffer)
-	itemsTable := terminal.NewTable(buf, []string{T("Category"), T("Description"), T("Quantity"), T("Recurring"), T("One Time")})
+	itemsTable := utils.NewTable(buf, []string{T("Category"), T("Description"), T("Quantity"), T("Recurring"), T("One Time")})
 	for _, item := range quote.Order.Items {
 		itemsTable.Add(
 			utils.FormatStringPointer(item.CategoryCode),
@@ -70,6 +72,5 @@ func (cmd *QuoteDetailCommand) Run(args []string) error {
 	itemsTable.Print()
 	table.Add(T("Items"), buf.String())
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
@@ -42,6 +42,9 @@ func (cmd *QuoteListCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to get Quotes.\n"), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, quotes)
+	}
 
 	table := cmd.UI.Table([]string{T("Id"), T("Name"), T("Created"), T("Expiration"), T("Status"), T("Package Name"), T("Package Id")})
 	for _, quote := range quotes {
@@ -55,6 +58,5 @@ func (cmd *QuoteListCommand) Run(args []string) error {
 			utils.FormatIntPointer(quote.Order.Items[0].Package.Id),
 		)
 	}
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
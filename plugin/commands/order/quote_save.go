@@ -48,6 +48,9 @@ func (cmd *QuoteSaveCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to save Quote.\n"), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, quote)
+	}
 
 	table := cmd.UI.Table([]string{T("Id"), T("Name"), T("Created"), T("Modified"), T("Status")})
 	table.Add(
@@ -58,6 +61,5 @@ func (cmd *QuoteSaveCommand) Run(args []string) error {
 		utils.FormatStringPointer(quote.Status),
 	)
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
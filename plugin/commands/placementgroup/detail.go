@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"strconv"
 
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
 	"github.com/spf13/cobra"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
@@ -73,7 +72,7 @@ func (cmd *PlacementGroupDetailCommand) Run(args []string) error {
 
 	if len(PlaceGroup.Guests) > 0 {
 		buf := new(bytes.Buffer)
-		guestTable := terminal.NewTable(buf, []string{T("ID"), T("FQDN"), T("Primary IP"), T("Backend IP"), T("CPU"), T("Memory"), T("Provisioned")})
+		guestTable := utils.NewTable(buf, []string{T("ID"), T("FQDN"), T("Primary IP"), T("Backend IP"), T("CPU"), T("Memory"), T("Provisioned")})
 		for _, guest := range PlaceGroup.Guests {
 			guestTable.Add(
 				utils.FormatIntPointer(guest.Id),
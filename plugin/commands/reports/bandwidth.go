@@ -50,6 +50,9 @@ func (cmd *BandwidthCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to get bandwidth summary"), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, bandwidths)
+	}
 	table := cmd.UI.Table([]string{
 		T("Id"),
 		T("Device name"),
@@ -126,8 +129,7 @@ func (cmd *BandwidthCommand) Run(args []string) error {
 		}
 	}
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
 
 func cleanDatas(id string, deviceName string, location string, allocation string, dataIn string, dataOut string, pool string, tags string) []string {
@@ -37,7 +37,7 @@ var _ = Describe("reports bandwidth", func() {
 			It("Set invalid output", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 		})
 
@@ -81,10 +81,10 @@ var _ = Describe("reports bandwidth", func() {
 			It("return bandwidth summary in format json", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output=json")
 				Expect(err).NotTo(HaveOccurred())
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Id": "100250634",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Allocation": "250.00 GB",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Pool": "Virtual Private Rack",`))
-				Expect(fakeUI.Outputs()).To(ContainSubstring(`"Tags": ""`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"id": 100250634,`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"bandwidthAllocation": 250,`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"name": "tag test"`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"resourceType": "SoftLayer_Virtual_Guest"`))
 
 			})
 		})
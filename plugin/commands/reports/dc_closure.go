@@ -33,6 +33,12 @@ type Resource_Object struct {
 	CancelDate   string
 }
 
+// What --output=JSON prints for each closing pod, the VLANs have the resources on them
+type PodClosure struct {
+	Pod   datatypes.Network_Pod    `json:"pod"`
+	Vlans []datatypes.Network_Vlan `json:"vlans"`
+}
+
 type DCClosuresCommand struct {
 	*metadata.SoftlayerCommand
 	Command *cobra.Command
@@ -86,6 +92,7 @@ backendRouterName, frontendRouterName]`
 	table := cmd.UI.Table(
 		[]string{"Id", "Name", "Public VLAN", "Private VLAN", "Type", "Datacenter", "POD", "Cancellation Date"},
 	)
+	closures := []PodClosure{}
 	for _, pod := range closing_pods {
 		resourceCollection := make(map[int]Resource_Object)
 		search_string := fmt.Sprintf(resource_search, *pod.BackendRouterName, *pod.FrontendRouterName)
@@ -97,9 +104,14 @@ backendRouterName, frontendRouterName]`
 		}
 
 		// Iterate through the vlans looking for resources and formatting them nicely.
+		closure := PodClosure{Pod: pod, Vlans: []datatypes.Network_Vlan{}}
 		for _, vlan := range vlans {
 			ProcessVlan(vlan.Resource, resourceCollection)
+			if vlan.Resource != nil {
+				closure.Vlans = append(closure.Vlans, *vlan.Resource)
+			}
 		}
+		closures = append(closures, closure)
 
 		// Add the resources to a table
 		for _, resource := range resourceCollection {
@@ -117,11 +129,9 @@ backendRouterName, frontendRouterName]`
 	}
 
 	if outputFormat == "JSON" {
-		table.PrintJson()
-	} else {
-		table.Print()
+		return utils.PrintPrettyJSON(cmd.UI, closures)
 	}
-
+	table.Print()
 	return nil
 }
 
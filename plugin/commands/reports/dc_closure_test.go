@@ -45,7 +45,8 @@ var _ = Describe("Reports Datacenter-Closures", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output=JSON")
 				Expect(err).NotTo(HaveOccurred())
 				outputs := fakeUI.Outputs()
-				Expect(outputs).To(ContainSubstring("\"Name\": \"imageTest.ibmtest.com\""))
+				Expect(outputs).To(ContainSubstring(`"fullyQualifiedDomainName": "imageTest.ibmtest.com"`))
+				Expect(outputs).To(ContainSubstring(`"pod": {`))
 			})
 		})
 		Context("Error Handling", func() {
@@ -65,7 +66,7 @@ var _ = Describe("Reports Datacenter-Closures", func() {
 			It("Outputs NOT JSON", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output=boson")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 			AfterEach(func() {
 				fakeHandler.ClearErrors()
@@ -5,8 +5,6 @@ import (
 	"github.com/spf13/cobra"
 	"strconv"
 
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
-
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
@@ -51,7 +49,7 @@ func (cmd *SearchTypesCommand) Run(args []string) error {
 	table := cmd.UI.Table([]string{T("Name"), T("Properties")})
 	for _, search_type := range type_results {
 		sub_buf := new(bytes.Buffer)
-		sub_table := terminal.NewTable(sub_buf, []string{T("Property"), T("Sortable"), T("Type")})
+		sub_table := utils.NewTable(sub_buf, []string{T("Property"), T("Sortable"), T("Type")})
 		for _, t_prop := range search_type.Properties {
 			sub_table.Add(*t_prop.Name, strconv.FormatBool(*t_prop.SortableFlag), *t_prop.Type)
 		}
@@ -43,7 +43,7 @@ var _ = Describe("Certificate add", func() {
 		})
 		Context("Certificate add without key", func() {
 			It("return error", func() {
-				file, _ := os.Create("wilma.org.crt")
+				file, _ := os.Create(os.TempDir() + "/wilma.org.crt")
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--crt", file.Name())
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: '--key' is required"))
@@ -54,8 +54,8 @@ var _ = Describe("Certificate add", func() {
 				fakeSecurityManager.AddCertificateReturns(datatypes.Security_Certificate{}, errors.New("Internal Server Error"))
 			})
 			It("return error", func() {
-				crtFile, _ := os.Create("wilma.org.crt")
-				keyFile, _ := os.Create("wilma.org.key")
+				crtFile, _ := os.Create(os.TempDir() + "/wilma.org.crt")
+				keyFile, _ := os.Create(os.TempDir() + "/wilma.org.key")
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--crt", crtFile.Name(), "--key", keyFile.Name())
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("Failed to add certificate."))
@@ -115,8 +115,8 @@ var _ = Describe("Certificate add", func() {
 			})
 		})
 		AfterEach(func() {
-			os.Remove(os.TempDir() + "wilma.org.crt")
-			os.Remove(os.TempDir() + "wilma.org.key")
+			os.Remove(os.TempDir() + "/wilma.org.crt")
+			os.Remove(os.TempDir() + "/wilma.org.key")
 		})
 		Context("Check bad output format", func() {
 			It("Error", func() {
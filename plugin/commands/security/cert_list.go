@@ -71,6 +71,10 @@ func (cmd *CertListCommand) Run(args []string) error {
 		sort.Sort(utils.CertByNotes(certs))
 	}
 
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, certs)
+	}
+
 	table := cmd.UI.Table([]string{T("ID"), T("common_name"), T("days_until_expire"), T("note")})
 	for _, cert := range certs {
 		table.Add(utils.FormatIntPointer(cert.Id),
@@ -78,6 +82,5 @@ func (cmd *CertListCommand) Run(args []string) error {
 			utils.FormatIntPointer(cert.ValidityDays),
 			utils.FormatStringPointer(cert.Notes))
 	}
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
@@ -65,6 +65,10 @@ func (cmd *KeyListCommand) Run(args []string) error {
 			map[string]interface{}{"Column": sortby}))
 	}
 
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, keys)
+	}
+
 	table := cmd.UI.Table([]string{T("ID"), T("label"), T("fingerprint"), T("note")})
 	for _, k := range keys {
 		table.Add(utils.FormatIntPointer(k.Id),
@@ -72,6 +76,5 @@ func (cmd *KeyListCommand) Run(args []string) error {
 			utils.FormatStringPointer(k.Fingerprint),
 			utils.FormatStringPointer(k.Notes))
 	}
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
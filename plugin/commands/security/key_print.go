@@ -64,10 +64,13 @@ func (cmd *KeyPrintCommand) Run(args []string) error {
 				map[string]interface{}{"File": file}), err.Error(), 1)
 		}
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, key)
+	}
+
 	table := cmd.UI.Table([]string{T("Name"), T("Value")})
 	table.Add(T("ID"), utils.FormatIntPointer(key.Id))
 	table.Add(T("Label"), utils.FormatStringPointer(key.Label))
 	table.Add(T("Notes"), utils.FormatStringPointer(key.Notes))
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"strconv"
 
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
 	"github.com/spf13/cobra"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
@@ -61,7 +60,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 	table.Add(T("Name"), utils.FormatStringPointer(group.Name))
 	table.Add(T("Description"), utils.FormatStringPointer(group.Description))
 	buf := new(bytes.Buffer)
-	ruleTable := terminal.NewTable(buf, []string{T("ID"), T("Remote IP"), T("Remote Group ID"), T("Direction"), T("Ether Type"), T("Port Range Min"), T("Port Range Max"), T("Protocol")})
+	ruleTable := utils.NewTable(buf, []string{T("ID"), T("Remote IP"), T("Remote Group ID"), T("Direction"), T("Ether Type"), T("Port Range Min"), T("Port Range Max"), T("Protocol")})
 	for _, rule := range group.Rules {
 		ruleTable.Add(utils.FormatIntPointer(rule.Id),
 			utils.FormatStringPointer(rule.RemoteIp),
@@ -77,7 +76,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 	table.Add(T("Rules"), buf.String())
 
 	buf = new(bytes.Buffer)
-	serverTable := terminal.NewTable(buf, []string{T("ID"), T("Hostname"), T("Interface"), T("IP address")})
+	serverTable := utils.NewTable(buf, []string{T("ID"), T("Hostname"), T("Interface"), T("IP address")})
 	for _, component := range group.NetworkComponentBindings {
 		if component.NetworkComponent != nil && component.NetworkComponent.Guest != nil {
 			var networkInterface, ipaddress string
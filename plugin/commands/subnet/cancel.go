@@ -1,12 +1,12 @@
 package subnet
 
 import (
-	"strconv"
 	"strings"
 
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 
 	"github.com/spf13/cobra"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
@@ -38,15 +38,18 @@ EXAMPLE:
 		},
 	}
 	cobraCmd.Flags().BoolVarP(&thisCmd.Force, "force", "f", false, T("Force operation without confirmation"))
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "subnet", func() ([]string, error) {
+		return managers.SubnetCompletions(thisCmd.NetworkManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *CancelCommand) Run(args []string) error {
 
-	subnetID, err := strconv.Atoi(args[0])
+	subnetID, err := managers.ResolveSubnetId(cmd.NetworkManager, args[0])
 	if err != nil {
-		return errors.NewInvalidSoftlayerIdInputError("Subnet ID")
+		return err
 	}
 	if !cmd.Force {
 		confirm, err := cmd.UI.Confirm(T("This will cancel the subnet: {{.ID}} and cannot be undone. Continue?", map[string]interface{}{"ID": subnetID}))
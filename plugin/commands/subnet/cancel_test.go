@@ -43,7 +43,7 @@ var _ = Describe("Subnet cancel", func() {
 			It("return error", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "abc")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Invalid input for 'Subnet ID'. It must be a positive integer."))
+				Expect(err.Error()).To(ContainSubstring("Invalid input for 'Subnet ID'. It must be an ID, a CIDR or a network address."))
 			})
 		})
 
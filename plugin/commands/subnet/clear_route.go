@@ -1,11 +1,9 @@
 package subnet
 
 import (
-	"strconv"
-
 	"github.com/spf13/cobra"
 
-	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
@@ -30,14 +28,17 @@ func NewClearRouteCommand(sl *metadata.SoftlayerCommand) *ClearRouteCommand {
 			return thisCmd.Run(args)
 		},
 	}
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "subnet", func() ([]string, error) {
+		return managers.SubnetCompletions(thisCmd.NetworkManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *ClearRouteCommand) Run(args []string) error {
-	subnetID, err := strconv.Atoi(args[0])
+	subnetID, err := managers.ResolveSubnetId(cmd.NetworkManager, args[0])
 	if err != nil {
-		return slErr.NewInvalidSoftlayerIdInputError("Subnet ID")
+		return err
 	}
 
 	_, err = cmd.NetworkManager.ClearRoute(subnetID)
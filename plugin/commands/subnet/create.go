@@ -59,9 +59,9 @@ func (cmd *CreateCommand) Run(args []string) error {
 	if err != nil {
 		return slErr.NewInvalidSoftlayerIdInputError("QUANTITY")
 	}
-	vlanID, err := utils.ResolveVlanId(args[2])
+	vlanID, err := managers.ResolveVlanId(cmd.NetworkManager, args[2])
 	if err != nil {
-		return slErr.NewInvalidSoftlayerIdInputError("VLAN ID")
+		return err
 	}
 	version := 4
 	if cmd.Ipv6 {
@@ -62,7 +62,7 @@ var _ = Describe("Subnet create", func() {
 			It("return error", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "public", "8", "abc")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Invalid input for 'VLAN ID'. It must be a positive integer."))
+				Expect(err.Error()).To(ContainSubstring("Unable to find VLAN"))
 			})
 		})
 
@@ -5,12 +5,11 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/sl"
 	"github.com/spf13/cobra"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
-	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
@@ -49,6 +48,9 @@ EXAMPLE:
 	cobraCmd.Flags().BoolVar(&thisCmd.NoHardware, "no-hardware", false, T("Hide hardware listing"))
 	cobraCmd.Flags().BoolVar(&thisCmd.NoIp, "no-ip", false, T("Hide IP address listing"))
 	cobraCmd.Flags().BoolVar(&thisCmd.NoTag, "no-Tag", false, T("Hide Tag listing"))
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "subnet", func() ([]string, error) {
+		return managers.SubnetCompletions(thisCmd.NetworkManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
@@ -57,9 +59,9 @@ func (cmd *DetailCommand) Run(args []string) error {
 
 	outputFormat := cmd.GetOutputFlag()
 
-	subnetID, err := utils.ResolveSubnetId(args[0])
+	subnetID, err := managers.ResolveSubnetId(cmd.NetworkManager, args[0])
 	if err != nil {
-		return slErr.NewInvalidSoftlayerIdInputError("Subnet ID")
+		return err
 	}
 
 	mask := "mask[endPointIpAddress[virtualGuest,hardware],ipAddresses[id, ipAddress,note,hardware,virtualGuest], datacenter, virtualGuests, hardware,networkVlan[networkSpace], tagReferences]"
@@ -94,7 +96,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 			table.Add(T("IP address"), T("none"))
 		} else {
 			buf := new(bytes.Buffer)
-			ipTable := terminal.NewTable(buf, []string{T("ID"), T("IP address"), T("Description"), T("Note")})
+			ipTable := utils.NewTable(buf, []string{T("ID"), T("IP address"), T("Description"), T("Note")})
 			endPointIpAddressDescription := "-"
 			if subnet.EndPointIpAddress != nil {
 				routedSubnet := fmt.Sprintf(
@@ -143,7 +145,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 			table.Add(T("virtual guests"), T("none"))
 		} else {
 			buf := new(bytes.Buffer)
-			vsTable := terminal.NewTable(buf, []string{T("Hostname"), T("domain"), T("public_ip"), T("private_ip")})
+			vsTable := utils.NewTable(buf, []string{T("Hostname"), T("domain"), T("public_ip"), T("private_ip")})
 			for _, vs := range subnet.VirtualGuests {
 				vsTable.Add(utils.FormatStringPointer(vs.Hostname),
 					utils.FormatStringPointer(vs.Domain),
@@ -159,7 +161,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 			table.Add(T("hardware"), T("none"))
 		} else {
 			buf := new(bytes.Buffer)
-			hwTable := terminal.NewTable(buf, []string{T("Hostname"), T("domain"), T("public_ip"), T("private_ip")})
+			hwTable := utils.NewTable(buf, []string{T("Hostname"), T("domain"), T("public_ip"), T("private_ip")})
 			for _, hw := range subnet.Hardware {
 				hwTable.Add(utils.FormatStringPointer(hw.Hostname),
 					utils.FormatStringPointer(hw.Domain),
@@ -176,7 +178,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 			table.Add(T("Tag"), T("none"))
 		} else {
 			buf := new(bytes.Buffer)
-			vsTable := terminal.NewTable(buf, []string{T("ID")})
+			vsTable := utils.NewTable(buf, []string{T("ID")})
 			for _, tag := range subnet.TagReferences {
 				vsTable.Add(utils.FormatIntPointer(tag.TagId))
 			}
@@ -184,6 +186,5 @@ func (cmd *DetailCommand) Run(args []string) error {
 			table.Add(T("Tag"), buf.String())
 		}
 	}
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
@@ -2,11 +2,11 @@ package subnet
 
 import (
 	"github.com/spf13/cobra"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
-	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
 )
 
 type EditCommand struct {
@@ -37,14 +37,17 @@ EXAMPLE:
 	}
 	cobraCmd.Flags().StringVar(&thisCmd.Note, "note", "", T("The note"))
 	cobraCmd.Flags().StringVar(&thisCmd.Tags, "tags", "", T("Comma separated list of tags, enclosed in quotes. 'tag1, tag2'"))
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "subnet", func() ([]string, error) {
+		return managers.SubnetCompletions(thisCmd.NetworkManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *EditCommand) Run(args []string) error {
-	subnetID, err := utils.ResolveSubnetId(args[0])
+	subnetID, err := managers.ResolveSubnetId(cmd.NetworkManager, args[0])
 	if err != nil {
-		return errors.NewInvalidSoftlayerIdInputError("Subnet ID")
+		return err
 	}
 
 	if cmd.Tags == "" && cmd.Note == "" {
@@ -43,7 +43,7 @@ var _ = Describe("subnet edit", func() {
 			It("Set command with an invalid Id", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "abcde")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Invalid input for 'Subnet ID'. It must be a positive integer."))
+				Expect(err.Error()).To(ContainSubstring("Invalid input for 'Subnet ID'. It must be an ID, a CIDR or a network address."))
 			})
 
 			It("Set command without option", func() {
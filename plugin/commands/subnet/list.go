@@ -18,6 +18,7 @@ type ListCommand struct {
 	*metadata.SoftlayerCommand
 	NetworkManager managers.NetworkManager
 	Command        *cobra.Command
+	Paging         metadata.Paging
 	Sortby         string
 	Datacenter     string
 	Identifier     string
@@ -54,6 +55,7 @@ EXAMPLE:
 	cobraCmd.Flags().BoolVarP(&thisCmd.Ipv4, "ipv4", "4", false, T("Display IPv4 subnets only"))
 	cobraCmd.Flags().BoolVarP(&thisCmd.Ipv6, "ipv6", "6", false, T("Display IPv6 subnets only"))
 	cobraCmd.Flags().IntVar(&thisCmd.Order, "order", 0, T("Filter by the ID of order that purchased the subnets"))
+	metadata.AddPagingFlags(cobraCmd, &thisCmd.Paging)
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
@@ -69,7 +71,7 @@ func (cmd *ListCommand) Run(args []string) error {
 	outputFormat := cmd.GetOutputFlag()
 
 	mask := "hardware,datacenter,ipAddressCount,virtualGuests,networkVlan[id,networkSpace,fullyQualifiedName],subnetType,id,networkIdentifier,addressSpace,endPointIpAddress,note,tagReferences[tag]"
-	subnets, err := cmd.NetworkManager.ListSubnets(cmd.Identifier, cmd.Datacenter, version, cmd.SubnetType, cmd.NetworkSpace, cmd.Order, mask)
+	subnets, err := cmd.NetworkManager.ListSubnets(cmd.Identifier, cmd.Datacenter, version, cmd.SubnetType, cmd.NetworkSpace, cmd.Order, mask, cmd.Paging)
 	if err != nil {
 		return errors.NewAPIError(T("Failed to list subnets on your account.\n"), err.Error(), 2)
 	}
@@ -96,6 +98,10 @@ func (cmd *ListCommand) Run(args []string) error {
 		return errors.NewInvalidUsageError(T("--sortby {{.Column}} is not supported.", map[string]interface{}{"Column": sortby}))
 	}
 
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, subnets)
+	}
+
 	headers := []string{T("ID"), T("Identifier"), T("Network"), T("Type"), T("VLAN"), T("Location"), T("Target"), T("IPs"), T("Hardware"), T("Vs"), T("Tags"), T("Note")}
 	table := cmd.UI.Table(headers)
 	for _, subnet := range subnets {
@@ -153,6 +159,5 @@ func (cmd *ListCommand) Run(args []string) error {
 			utils.FormatStringPointer(subnet.Note),
 		)
 	}
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"fmt"
 
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
 	"github.com/spf13/cobra"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
@@ -56,6 +55,10 @@ func (cmd *LookupCommand) Run(args []string) error {
 		return nil
 	}
 
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, ipAddressRecord)
+	}
+
 	table := cmd.UI.Table([]string{T("Name"), T("Value")})
 	table.Add(T("ID"), utils.FormatIntPointer(ipAddressRecord.Id))
 	table.Add(T("ipAddress"), utils.FormatStringPointer(ipAddressRecord.IpAddress))
@@ -63,7 +66,7 @@ func (cmd *LookupCommand) Run(args []string) error {
 		table.Add(T("subnet"), T("none"))
 	} else {
 		buf := new(bytes.Buffer)
-		subnetTable := terminal.NewTable(buf, []string{T("name"), T("value")})
+		subnetTable := utils.NewTable(buf, []string{T("name"), T("value")})
 		subnetTable.Add(T("ID"), utils.FormatIntPointer(ipAddressRecord.Subnet.Id))
 		subnetTable.Add(T("identifier"), fmt.Sprintf("%s/%s", utils.FormatStringPointer(ipAddressRecord.Subnet.NetworkIdentifier), utils.FormatIntPointer(ipAddressRecord.Subnet.Cidr)))
 		subnetTable.Add(T("netmask"), utils.FormatStringPointer(ipAddressRecord.Subnet.Netmask))
@@ -77,7 +80,7 @@ func (cmd *LookupCommand) Run(args []string) error {
 		table.Add(T("device"), T("none"))
 	} else {
 		buf := new(bytes.Buffer)
-		deviceTable := terminal.NewTable(buf, []string{T("ID"), T("FQDN"), T("type")})
+		deviceTable := utils.NewTable(buf, []string{T("ID"), T("FQDN"), T("type")})
 		var deviceID, deviceType, FQDN string
 		if ipAddressRecord.VirtualGuest != nil {
 			deviceID = utils.FormatIntPointer(ipAddressRecord.VirtualGuest.Id)
@@ -92,6 +95,5 @@ func (cmd *LookupCommand) Run(args []string) error {
 		deviceTable.Print()
 		table.Add(T("device"), buf.String())
 	}
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
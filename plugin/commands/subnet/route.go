@@ -1,9 +1,8 @@
 package subnet
 
 import (
-	"strconv"
-
 	"github.com/spf13/cobra"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -60,6 +59,9 @@ fully-qualified domain name in the format 'hostname<domain>' where < and > are l
 		T(`A Network_Vlan.id value of the desired VLAN or A semantic VLAN identifier of the form <data center short name>.<router>.<vlan number>,
 eg. dal13.fcr01.1234 - the router name may optionally contain the 'a' or 'b' redundancy qualifier `))
 	cobraCmd.MarkFlagsMutuallyExclusive("ip", "server", "vsi", "vlan")
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "subnet", func() ([]string, error) {
+		return managers.SubnetCompletions(thisCmd.NetworkManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
@@ -67,9 +69,9 @@ eg. dal13.fcr01.1234 - the router name may optionally contain the 'a' or 'b' red
 func (cmd *RouteCommand) Run(args []string) error {
 
 	var err error
-	subnetId, err := strconv.Atoi(args[0])
+	subnetId, err := managers.ResolveSubnetId(cmd.NetworkManager, args[0])
 	if err != nil {
-		return slErr.NewInvalidSoftlayerIdInputError("Subnet ID")
+		return err
 	}
 	if cmd.Ip+cmd.Server+cmd.Vsi+cmd.Vlan == "" {
 		return slErr.NewMissingInputError("--ip, --server, --vsi or --vlan")
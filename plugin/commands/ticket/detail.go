@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"strconv"
 
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
 	"github.com/spf13/cobra"
 
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
@@ -112,7 +111,7 @@ func (cmd *DetailTicketCommand) Run(args []string) error {
 		for i, col := range columnsList {
 			row[i] = values[col]
 		}
-		tableUpdate := terminal.NewTable(buf, columnsList)
+		tableUpdate := utils.NewTable(buf, columnsList)
 		tableUpdate.Add(row...)
 		tableUpdate.Print()
 		table.Add("Update "+strconv.Itoa(num+1), buf.String())
@@ -17,6 +17,7 @@ type ListTicketCommand struct {
 	*metadata.SoftlayerCommand
 	TicketManager managers.TicketManager
 	Command       *cobra.Command
+	Paging        metadata.Paging
 	Open          bool
 	Closed        bool
 }
@@ -36,6 +37,7 @@ func NewListTicketCommand(sl *metadata.SoftlayerCommand) *ListTicketCommand {
 	}
 	cobraCmd.Flags().BoolVar(&thisCmd.Open, "open", false, T("Display only open tickets"))
 	cobraCmd.Flags().BoolVar(&thisCmd.Closed, "closed", false, T("Display only closed tickets"))
+	metadata.AddPagingFlags(cobraCmd, &thisCmd.Paging)
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
@@ -45,13 +47,13 @@ func (cmd *ListTicketCommand) Run(args []string) error {
 	var tickets, ticketsOpen, ticketsClose []datatypes.Ticket
 
 	if cmd.Open && cmd.Closed {
-		ticketsOpen, err = cmd.TicketManager.ListOpenTickets()
-		ticketsClose, err = cmd.TicketManager.ListCloseTickets()
-		tickets = append(ticketsOpen, ticketsClose...)
+		ticketsOpen, err = cmd.TicketManager.ListOpenTickets(metadata.Paging{})
+		ticketsClose, err = cmd.TicketManager.ListCloseTickets(metadata.Paging{})
+		tickets = managers.PageOf(append(ticketsOpen, ticketsClose...), cmd.Paging)
 	} else if !cmd.Open && cmd.Closed {
-		tickets, err = cmd.TicketManager.ListCloseTickets()
+		tickets, err = cmd.TicketManager.ListCloseTickets(cmd.Paging)
 	} else {
-		tickets, err = cmd.TicketManager.ListOpenTickets()
+		tickets, err = cmd.TicketManager.ListOpenTickets(cmd.Paging)
 	}
 
 	if err != nil {
@@ -96,6 +96,21 @@ var _ = Describe("ticket list", func() {
 				Expect(fakeUI.Outputs()).To(ContainSubstring("2"))
 				Expect(fakeUI.Outputs()).To(ContainSubstring("0"))
 			})
+			It("Pages open tickets with the API", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--limit", "1", "--offset", "3")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeTicketManager.ListOpenTicketsArgsForCall(0)).To(Equal(metadata.Paging{Limit: 1, Offset: 3}))
+			})
+			It("Pages open and closed tickets together", func() {
+				closed := tickets[0]
+				closed.Id = sl.Int(222222)
+				fakeTicketManager.ListCloseTicketsReturns([]datatypes.Ticket{closed}, nil)
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--open", "--closed", "--offset", "1")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeTicketManager.ListOpenTicketsArgsForCall(0)).To(Equal(metadata.Paging{}))
+				Expect(fakeUI.Outputs()).To(ContainSubstring("222222"))
+				Expect(fakeUI.Outputs()).NotTo(ContainSubstring("111111"))
+			})
 		})
 	})
 })
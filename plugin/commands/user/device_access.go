@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"strconv"
 
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
 	"github.com/spf13/cobra"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
@@ -66,12 +65,21 @@ func (cmd *DeviceAccessCommand) Run(args []string) error {
 	if err != nil {
 		return errors.NewAPIError(T("Failed to get virtual servers.\n"), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, map[string]interface{}{
+			"userId":         id,
+			"permissions":    userpermissions,
+			"dedicatedHosts": dedicatedHosts,
+			"hardware":       hardwares,
+			"virtualGuests":  virtualGuests,
+		})
+	}
 
 	table := cmd.UI.Table([]string{T("Name"), T("Value")})
 	table.Add(T("User ID"), utils.FormatIntPointer(&id))
 	if len(userpermissions) != 0 {
 		buf := new(bytes.Buffer)
-		permissionsTable := terminal.NewTable(buf, []string{T("Key Name"), T("Name")})
+		permissionsTable := utils.NewTable(buf, []string{T("Key Name"), T("Name")})
 		for _, permission := range userpermissions {
 			permissionsTable.Add(
 				utils.FormatStringPointer(permission.KeyName),
@@ -86,7 +94,7 @@ func (cmd *DeviceAccessCommand) Run(args []string) error {
 
 	if len(dedicatedHosts) != 0 || len(hardwares) != 0 || len(virtualGuests) != 0 {
 		buf := new(bytes.Buffer)
-		devicesTable := terminal.NewTable(buf, []string{T("Id"), T("Device Name"), T("Device Type"), T("Public Ip"), T("Private Ip"), T("Notes")})
+		devicesTable := utils.NewTable(buf, []string{T("Id"), T("Device Name"), T("Device Type"), T("Public Ip"), T("Private Ip"), T("Notes")})
 		if len(dedicatedHosts) != 0 {
 			for _, device := range dedicatedHosts {
 				notes := ""
@@ -157,10 +165,6 @@ func (cmd *DeviceAccessCommand) Run(args []string) error {
 		table.Add(T("Devices"), "-")
 	}
 
-	if outputFormat == "JSON" {
-		table.PrintJson()
-	} else {
-		table.Print()
-	}
+	table.Print()
 	return nil
 }
@@ -150,6 +150,14 @@ var _ = Describe("Device access", func() {
 				Expect(fakeUI.Outputs()).To(ContainSubstring("10.10.10.12"))
 				Expect(fakeUI.Outputs()).To(ContainSubstring("my virtual guests notes"))
 			})
+			It("Prints the devices as JSON", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "123456", "--output=JSON")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"userId": 123456`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"keyName": "ACCESS_ALL_GUEST"`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"fullyQualifiedDomainName": "hardware.mydomain.com"`))
+				Expect(fakeUI.Outputs()).To(ContainSubstring(`"fullyQualifiedDomainName": "virtual.mydomain.com"`))
+			})
 		})
 
 		Context("Return no error", func() {
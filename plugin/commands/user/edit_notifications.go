@@ -80,7 +80,7 @@ func (cmd *EditNotificationsCommand) Run(args []string) error {
 		succesNotifications, failedNotifications = setNotifications(cmd, "enable", notificationsInput, allNotifications)
 	}
 
-	if len(cmd.Disable) == 0 && len(cmd.Disable) == 0 {
+	if len(cmd.Disable) == 0 {
 		notificationsInput = append(notificationsInput, args...)
 		succesNotifications, failedNotifications = setNotifications(cmd, "enable", notificationsInput, allNotifications)
 	}
@@ -37,7 +37,7 @@ var _ = Describe("User Edit Notifications", func() {
 			It("An invalid output id is set", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 
 			It("Set --enable and --disable options", func() {
@@ -65,6 +65,22 @@ var _ = Describe("User Edit Notifications", func() {
 			})
 		})
 
+		Context("Enable notifications", func() {
+			BeforeEach(func() {
+				fakeUserManager.GetAllNotificationsReturns([]datatypes.Email_Subscription{
+					datatypes.Email_Subscription{Id: sl.Int(1), Name: sl.String("Order Being Reviewed")},
+					datatypes.Email_Subscription{Id: sl.Int(12), Name: sl.String("Severity 2")},
+				}, nil)
+				fakeUserManager.EnableEmailSubscriptionNotificationReturns(true, nil)
+			})
+			It("Enables the arguments with the --enable notifications", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "Severity 2", "--enable=Order Being Reviewed")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeUI.Outputs()).To(ContainSubstring("Notifications updated successfully: Order Being Reviewed, Severity 2"))
+				Expect(fakeUserManager.DisableEmailSubscriptionNotificationCallCount()).To(Equal(0))
+			})
+		})
+
 		Context("Return error", func() {
 			fakeNotifications := []datatypes.Email_Subscription{}
 			BeforeEach(func() {
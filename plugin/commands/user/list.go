@@ -20,6 +20,7 @@ type ListCommand struct {
 	*metadata.SoftlayerCommand
 	UserManager managers.UserManager
 	Command     *cobra.Command
+	Paging      metadata.Paging
 	Column      []string
 }
 
@@ -60,6 +61,7 @@ func NewListCommand(sl *metadata.SoftlayerCommand) (cmd *ListCommand) {
 	cobraCmd.Flags().StringSliceVar(&thisCmd.Column, "column", []string{},
 		T("Column to display. options are: {{.Columns}}. This option can be specified multiple times", subs))
 
+	metadata.AddPagingFlags(cobraCmd, &thisCmd.Paging)
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
@@ -80,7 +82,7 @@ func (cmd *ListCommand) Run(args []string) error {
 
 	outputFormat := cmd.GetOutputFlag()
 
-	users, err := cmd.UserManager.ListUsers(mask)
+	users, err := cmd.UserManager.ListUsers(mask, cmd.Paging)
 	if err != nil {
 		return errors.NewAPIError(T("Failed to list users.\n"), err.Error(), 2)
 	}
@@ -37,12 +37,12 @@ var _ = Describe("User Notifications", func() {
 
 		Context("Return error", func() {
 			BeforeEach(func() {
-				fakeUserManager.GetAllNotificationsReturns([]datatypes.Email_Subscription{}, errors.New("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				fakeUserManager.GetAllNotificationsReturns([]datatypes.Email_Subscription{}, errors.New("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 			It("An invalid output id is set", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "--output=xml")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, only JSON is supported now."))
+				Expect(err.Error()).To(ContainSubstring("Incorrect Usage: Invalid output format, supported formats are: JSON, CSV, YAML, template=<TEMPLATE>"))
 			})
 		})
 
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"strconv"
 
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/spf13/cobra"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
@@ -118,7 +117,7 @@ func (cmd *PermissionsCommand) Run(args []string) error {
 	for _, department := range userPermissions {
 		buf := new(bytes.Buffer)
 		headers := []string{T("KeyName"), T("Assigned"), T("Description")}
-		subTable := terminal.NewTable(buf, headers)
+		subTable := utils.NewTable(buf, headers)
 		for _, perm := range department.Permissions {
 			subTable.Add(perm.KeyName, perm.Assigned, perm.Description)
 		}
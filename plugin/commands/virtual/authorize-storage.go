@@ -3,6 +3,7 @@ package virtual
 import (
 	"github.com/spf13/cobra"
 
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -36,6 +37,9 @@ EXAMPLE:
 			return thisCmd.Run(args)
 		},
 	}
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "virtual_guest", func() ([]string, error) {
+		return managers.VirtualGuestCompletions(thisCmd.VirtualServerManager)
+	})
 	thisCmd.Command = cobraCmd
 	cobraCmd.Flags().StringVarP(&thisCmd.Username, "username-storage", "u", "", T("The storage username to be added to the virtual server."))
 	cobraCmd.Flags().IntVarP(&thisCmd.PortableId, "portable-id", "p", 0, T("The portable storage id to be added to the virtual server"))
@@ -44,9 +48,9 @@ EXAMPLE:
 
 func (cmd *AuthorizeStorageCommand) Run(args []string) error {
 
-	vsID, err := utils.ResolveVirtualGuestId(args[0])
+	vsID, err := managers.ResolveVirtualGuestId(cmd.VirtualServerManager, args[0])
 	if err != nil {
-		return slErrors.NewInvalidSoftlayerIdInputError("Virtual server ID")
+		return err
 	}
 	outputFormat := cmd.GetOutputFlag()
 	subs := map[string]interface{}{
@@ -9,6 +9,7 @@ import (
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/spf13/cobra"
 
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -55,6 +56,9 @@ Example::
 			return thisCmd.Run(args)
 		},
 	}
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "virtual_guest", func() ([]string, error) {
+		return managers.VirtualGuestCompletions(thisCmd.VirtualServerManager)
+	})
 	thisCmd.Command = cobraCmd
 	cobraCmd.Flags().StringVarP(&thisCmd.Start, "start", "s", "", T("Start date for bandwdith reporting"))
 	cobraCmd.Flags().StringVarP(&thisCmd.End, "end", "e", "", T("End date for bandwidth reporting"))
@@ -66,9 +70,9 @@ Example::
 
 func (cmd *BandwidthCommand) Run(args []string) error {
 
-	VsID, err := utils.ResolveVirtualGuestId(args[0])
+	VsID, err := managers.ResolveVirtualGuestId(cmd.VirtualServerManager, args[0])
 	if err != nil {
-		return slErrors.NewInvalidSoftlayerIdInputError("Virtual server ID")
+		return err
 	}
 
 	var start, end string
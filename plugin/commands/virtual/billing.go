@@ -5,8 +5,7 @@ import (
 	"fmt"
 	"github.com/spf13/cobra"
 
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
-
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -33,15 +32,18 @@ func NewBillingCommand(sl *metadata.SoftlayerCommand) (cmd *BillingCommand) {
 			return thisCmd.Run(args)
 		},
 	}
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "virtual_guest", func() ([]string, error) {
+		return managers.VirtualGuestCompletions(thisCmd.VirtualServerManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *BillingCommand) Run(args []string) error {
 
-	vsID, err := utils.ResolveVirtualGuestId(args[0])
+	vsID, err := managers.ResolveVirtualGuestId(cmd.VirtualServerManager, args[0])
 	if err != nil {
-		return slErrors.NewInvalidSoftlayerIdInputError("Virtual server ID")
+		return err
 	}
 
 	outputFormat := cmd.GetOutputFlag()
@@ -63,7 +65,7 @@ func (cmd *BillingCommand) Run(args []string) error {
 	table.Add(T("Provisioning Date"), utils.FormatSLTimePointer(virtualGuest.ProvisionDate))
 
 	buf := new(bytes.Buffer)
-	tablePrices := terminal.NewTable(buf, []string{T("Item"), T("Recurring Fee")})
+	tablePrices := utils.NewTable(buf, []string{T("Item"), T("Recurring Fee")})
 	for _, item := range virtualGuest.BillingItem.Children {
 		tablePrices.Add(utils.FormatStringPointer(item.Description), fmt.Sprintf("%.2f", *item.NextInvoiceTotalRecurringAmount))
 	}
@@ -48,7 +48,7 @@ var _ = Describe("vs billing", func() {
 			It("return error", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "abcd")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Invalid input for 'Virtual server ID'. It must be a positive integer."))
+				Expect(err.Error()).To(ContainSubstring("Unable to find virtual server instance"))
 			})
 		})
 
@@ -0,0 +1,47 @@
+package virtual
+
+import (
+	"fmt"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/cmdutils"
+	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
+)
+
+// The virtual servers a bulk command works on, from its IDENTIFIER arguments or the filters of selector
+func selectVirtualServers(manager managers.VirtualServerManager, args []string, selector cmdutils.ServerSelector) ([]cmdutils.BulkTarget, error) {
+	targets := []cmdutils.BulkTarget{}
+	if selector.HasFilters() {
+		guests, err := manager.ListInstances(false, false, "", selector.HostnamePattern, selector.Datacenter, "", "", "",
+			0, 0, 0, 0, selector.Tags, "mask[id,hostname,domain]", metadata.Paging{})
+		if err != nil {
+			return nil, slErrors.NewAPIError(T("Failed to list virtual server instances on your account.\n"), err.Error(), 2)
+		}
+		for _, guest := range guests {
+			targets = append(targets, cmdutils.BulkTarget{
+				Id:   utils.IntPointertoInt(guest.Id),
+				Name: fmt.Sprintf("%s.%s", utils.StringPointertoString(guest.Hostname), utils.StringPointertoString(guest.Domain)),
+			})
+		}
+		return targets, nil
+	}
+	identifiers, err := selector.Identifiers(args)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[int]bool{}
+	for _, identifier := range identifiers {
+		vsID, err := managers.ResolveVirtualGuestId(manager, identifier)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[vsID] {
+			seen[vsID] = true
+			targets = append(targets, cmdutils.BulkTarget{Id: vsID})
+		}
+	}
+	return targets, nil
+}
@@ -5,11 +5,12 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/cmdutils"
 	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
-	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
 )
 
 type CancelCommand struct {
@@ -17,6 +18,7 @@ type CancelCommand struct {
 	VirtualServerManager managers.VirtualServerManager
 	Command              *cobra.Command
 	Force                bool
+	Selector             cmdutils.ServerSelector
 }
 
 func NewCancelCommand(sl *metadata.SoftlayerCommand) (cmd *CancelCommand) {
@@ -25,23 +27,38 @@ func NewCancelCommand(sl *metadata.SoftlayerCommand) (cmd *CancelCommand) {
 		VirtualServerManager: managers.NewVirtualServerManager(sl.Session),
 	}
 	cobraCmd := &cobra.Command{
-		Use:   "cancel " + T("IDENTIFIER"),
+		Use:   "cancel " + T("IDENTIFIER") + "...",
 		Short: T("Cancel virtual server instance"),
-		Args:  metadata.OneArgs,
+		Args:  cmdutils.ServerSelectorArgs(&thisCmd.Selector),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return thisCmd.Run(args)
 		},
 	}
+	cobraCmd.ValidArgsFunction = client.CompleteEveryIdentifier(sl.Session, "virtual_guest", func() ([]string, error) {
+		return managers.VirtualGuestCompletions(thisCmd.VirtualServerManager)
+	})
 	thisCmd.Command = cobraCmd
 	cobraCmd.Flags().BoolVarP(&thisCmd.Force, "force", "f", false, T("Force operation without confirmation"))
+	cmdutils.AddServerSelectorFlags(cobraCmd, &thisCmd.Selector)
 	return thisCmd
 }
 
 func (cmd *CancelCommand) Run(args []string) error {
 
-	VsID, err := utils.ResolveVirtualGuestId(args[0])
+	if cmd.Selector.IsBulk(args) {
+		servers, err := selectVirtualServers(cmd.VirtualServerManager, args, cmd.Selector)
+		if err != nil {
+			return err
+		}
+		question := T("This will cancel {{.COUNT}} virtual server instances and cannot be undone. Continue?", map[string]interface{}{"COUNT": len(servers)})
+		return cmdutils.RunBulk(cmd.SoftlayerCommand, servers, cmd.Force, question, func(id int) error {
+			return cmd.VirtualServerManager.CancelInstance(id)
+		})
+	}
+
+	VsID, err := managers.ResolveVirtualGuestId(cmd.VirtualServerManager, args[0])
 	if err != nil {
-		return slErrors.NewInvalidSoftlayerIdInputError("Virtual server ID")
+		return err
 	}
 
 	subs := map[string]interface{}{"VsID": VsID, "VsId": VsID}
@@ -42,9 +42,11 @@ func (cmd *CapacityCreateOptionsCommand) Run(args []string) error {
 	}
 	outputFormat := cmd.GetOutputFlag()
 	tableRegion := cmd.UI.Table([]string{T("Location"), T("POD"), T("BackendRouterId")})
+	regionPods := []datatypes.Network_Pod{}
 	for _, datacenter := range datacenters {
 		for _, pod := range pods {
 			if utils.FormatStringPointer(datacenter.Location.Location.Name) == utils.FormatStringPointer(pod.DatacenterName) {
+				regionPods = append(regionPods, pod)
 				tableRegion.Add(utils.FormatStringPointer(datacenter.Keyname),
 					utils.FormatStringPointer(pod.BackendRouterName),
 					utils.FormatIntPointer(pod.BackendRouterId))
@@ -57,15 +59,19 @@ func (cmd *CapacityCreateOptionsCommand) Run(args []string) error {
 	if err != nil {
 		return slErrors.NewInvalidUsageError("Internal error.")
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, map[string]interface{}{"items": items, "pods": regionPods})
+	}
 	for _, item := range items {
 		tableItems.Add(utils.FormatStringPointer(item.KeyName),
 			utils.FormatStringPointer(item.Description),
 			utils.FormatSLFloatPointerToFloat(item.Capacity), getPrices(item.Prices))
 	}
 
-	utils.PrintTable(cmd.UI, tableItems, outputFormat)
-	utils.PrintTable(cmd.UI, tableRegion, outputFormat)
-	return nil
+	if err := utils.PrintTable(cmd.UI, tableItems, outputFormat); err != nil {
+		return err
+	}
+	return utils.PrintTable(cmd.UI, tableRegion, outputFormat)
 }
 
 // Finds the price with the default locationGroupId
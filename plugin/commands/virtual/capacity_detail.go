@@ -6,8 +6,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
-
 	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -75,7 +73,7 @@ func (cmd *CapacityDetailCommand) Run(args []string) error {
 	mainTable := cmd.UI.Table([]string{T("detail")})
 	mainTable.Add(utils.FormatStringPointer(capacity.Name))
 	buf := new(bytes.Buffer)
-	table := terminal.NewTable(buf, utils.GetColumnHeader(showColumns))
+	table := utils.NewTable(buf, utils.GetColumnHeader(showColumns))
 	for _, instance := range capacity.Instances {
 		values := make(map[string]string)
 		if instance.Guest != nil {
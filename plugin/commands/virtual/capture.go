@@ -7,6 +7,7 @@ import (
 	"github.com/softlayer/softlayer-go/sl"
 	"github.com/spf13/cobra"
 
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -42,6 +43,9 @@ EXAMPLE:
 			return thisCmd.Run(args)
 		},
 	}
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "virtual_guest", func() ([]string, error) {
+		return managers.VirtualGuestCompletions(thisCmd.VirtualServerManager)
+	})
 	thisCmd.Command = cobraCmd
 	cobraCmd.Flags().StringVarP(&thisCmd.Name, "name", "n", "", T("Name of the image [required]"))
 	cobraCmd.Flags().BoolVar(&thisCmd.All, "all", false, T("Capture all block devices that belong to the virtual server"))
@@ -52,9 +56,9 @@ EXAMPLE:
 }
 func (cmd *CaptureCommand) Run(args []string) error {
 
-	vsID, err := utils.ResolveVirtualGuestId(args[0])
+	vsID, err := managers.ResolveVirtualGuestId(cmd.VirtualServerManager, args[0])
 	if err != nil {
-		return slErrors.NewInvalidSoftlayerIdInputError("Virtual server ID")
+		return err
 	}
 	if cmd.Name == "" {
 		return slErrors.NewMissingInputError("-n|--name")
@@ -88,13 +92,16 @@ func (cmd *CaptureCommand) Run(args []string) error {
 			map[string]interface{}{"VsID": vsID}), err.Error(), 2)
 	}
 
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, image)
+	}
+
 	table := cmd.UI.Table([]string{T("name"), T("value")})
 	table.Add(T("Virtual guest ID"), strconv.Itoa(vsID))
 	table.Add(T("Image ID"), utils.FormatIntPointer(image.Id))
 	table.Add(T("Date time"), utils.FormatSLTimePointer(image.CreateDate))
 	table.Add(T("Note"), utils.FormatStringPointer(image.Note))
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
 
 func getDisks(vs datatypes.Virtual_Guest, onlyPrimary bool) []datatypes.Virtual_Guest_Block_Device {
@@ -24,6 +24,7 @@ type CreateCommand struct {
 	Command              *cobra.Command
 	VirtualServerManager managers.VirtualServerManager
 	ImageManager         managers.ImageManager
+	SecurityManager      managers.SecurityManager
 
 	Dedicated      bool
 	Private        bool
@@ -32,6 +33,8 @@ type CreateCommand struct {
 	Test           bool
 	Transient      bool
 	Force          bool
+	Interactive    bool
+	Manifest       string
 	Disk           []int
 	Key            []int
 	PriSecGroup    []int
@@ -69,6 +72,7 @@ func NewCreateCommand(sl *metadata.SoftlayerCommand) (cmd *CreateCommand) {
 		SoftlayerCommand:     sl,
 		VirtualServerManager: managers.NewVirtualServerManager(sl.Session),
 		ImageManager:         managers.NewImageManager(sl.Session),
+		SecurityManager:      managers.NewSecurityManager(sl.Session),
 	}
 	cobraCmd := &cobra.Command{
 		Use:   "create",
@@ -80,7 +84,23 @@ func NewCreateCommand(sl *metadata.SoftlayerCommand) (cmd *CreateCommand) {
 	${COMMAND_NAME} sl vs create -H myvsi -D ibm.com -c 4 -m 4096 -d dal10 -o UBUNTU_16_64 --disk 100 --disk 1000 --vlan-public 413 --test
 	This command tests whether the order is valid with above options before the order is actually placed.
 	${COMMAND_NAME} sl vs create -H myvsi -D ibm.com -c 4 -m 4096 -d dal10 -o UBUNTU_16_64 --disk 100 --disk 1000 --vlan-public 413 --export ~/myvsi.txt
-	This command exports above options to a file: myvsi.txt under user home directory for later use.`),
+	This command exports above options to a file: myvsi.txt under user home directory for later use.
+	${COMMAND_NAME} sl vs create --interactive
+	This command asks for the datacenter, flavor, operating system, network and SSH keys, then shows the same command without --interactive.
+	${COMMAND_NAME} sl vs create --manifest fleet.yaml --datacenter dal10
+	This command verifies every virtual server in fleet.yaml, shows the combined price and creates all of them with one order.
+	The file has the options of each server, like:
+	  defaults:
+	    domain: ibm.com
+	    os: UBUNTU_LATEST
+	  servers:
+	    - hostname: web1
+	      flavor: B1_2X8X25
+	      tags: [web]
+	    - hostname: db1
+	      flavor: B1_4X16X100
+	      disks: [25, 500]
+	      vlan-private: 1234`),
 		Args: metadata.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return thisCmd.Run(args)
@@ -94,6 +114,7 @@ func NewCreateCommand(sl *metadata.SoftlayerCommand) (cmd *CreateCommand) {
 	cobraCmd.Flags().BoolVar(&thisCmd.Test, "test", false, T("Do not actually create the virtual server"))
 	cobraCmd.Flags().BoolVar(&thisCmd.Transient, "transient", false, T("Create a transient virtual server"))
 	cobraCmd.Flags().BoolVarP(&thisCmd.Force, "force", "f", false, T("Force operation without confirmation"))
+	cobraCmd.Flags().BoolVar(&thisCmd.Interactive, "interactive", false, T("Ask for the options one at a time, with the choices available"))
 	cobraCmd.Flags().IntSliceVar(&thisCmd.Disk, "disk", []int{}, T("Disk sizes (multiple occurrence permitted)"))
 	cobraCmd.Flags().IntSliceVarP(&thisCmd.Key, "key", "k", []int{}, T("The IDs of the SSH keys to add to the root user (multiple occurrence permitted)"))
 	cobraCmd.Flags().IntSliceVarP(&thisCmd.PriSecGroup, "private-security-group", "s", []int{}, T("Security group ID to associate with the private interface (multiple occurrence permitted)"))
@@ -117,18 +138,29 @@ func NewCreateCommand(sl *metadata.SoftlayerCommand) (cmd *CreateCommand) {
 	cobraCmd.Flags().StringVar(&thisCmd.Export, "export", "", T("Exports options to a template file"))
 	cobraCmd.Flags().StringVar(&thisCmd.Flavor, "flavor", "", T("Public Virtual Server flavor key name"))
 	cobraCmd.Flags().StringVarP(&thisCmd.Datacenter, "datacenter", "d", "", T("Datacenter shortname [required]"))
+	metadata.UseProfileDatacenter(cobraCmd, "datacenter")
 	cobraCmd.Flags().StringVarP(&thisCmd.Domain, "domain", "D", "", T("Domain portion of the FQDN [required]"))
 	cobraCmd.Flags().StringVarP(&thisCmd.Hostname, "hostname", "H", "", T("Host portion of the FQDN [required]"))
+	cobraCmd.Flags().StringVar(&thisCmd.Manifest, "manifest", "", T("A YAML file of virtual servers to create with one order, each with its own options"))
 	cobraCmd.Flags().StringVarP(&thisCmd.Os, "os", "o", "", T("OS install code. Tip: you can specify <OS>_LATEST"))
 	cobraCmd.Flags().StringVarP(&thisCmd.PostInstall, "postinstall", "i", "", T("Post-install script to download"))
 	cobraCmd.Flags().StringVarP(&thisCmd.Template, "template", "t", "", T("A template file that defaults the command-line options"))
 	cobraCmd.Flags().StringVarP(&thisCmd.Userdata, "userdata", "u", "", T("User defined metadata string"))
 	cobraCmd.Flags().StringVarP(&thisCmd.Userfile, "userfile", "F", "", T("Read userdata from file"))
 	cobraCmd.MarkFlagsMutuallyExclusive("san", "local")
+	cobraCmd.MarkFlagsMutuallyExclusive("interactive", "template")
+	cobraCmd.MarkFlagsMutuallyExclusive("interactive", "like")
+	for _, flag := range []string{"template", "like", "interactive", "quantity", "hostname", "export", "wait"} {
+		cobraCmd.MarkFlagsMutuallyExclusive("manifest", flag)
+	}
 	return thisCmd
 }
 
 func (cmd *CreateCommand) CheckRequiredOptions() bool {
+	// The template has the options, the flags only change some of them
+	if cmd.Template != "" {
+		return true
+	}
 	if cmd.Hostname == "" {
 		return false
 	} else if cmd.Domain == "" {
@@ -144,6 +176,15 @@ func (cmd *CreateCommand) CheckRequiredOptions() bool {
 func (cmd *CreateCommand) Run(args []string) error {
 	virtualGuest := datatypes.Virtual_Guest{}
 	var err error
+	if cmd.Interactive {
+		err = cmd.askOptions()
+		if err != nil {
+			return err
+		}
+	}
+	if cmd.Manifest != "" {
+		return cmd.createFromManifest()
+	}
 	params, err := cmd.verifyParams()
 	if err != nil {
 		return err
@@ -210,9 +251,19 @@ func (cmd *CreateCommand) Run(args []string) error {
 		}
 	}
 
+	// Tags aren't part of the order, they are kept in the template and set after the server is created
+	if len(cmd.Tag) == 0 {
+		cmd.Tag = managers.InstanceTemplateTags(virtualGuest)
+	}
+	virtualGuest.TagReferences = nil
+
 	//do export
 	if cmd.Export != "" {
-		content, err := json.Marshal(virtualGuest)
+		exported := virtualGuest
+		if len(cmd.Tag) > 0 {
+			exported.TagReferences = managers.InstanceTemplateTagReferences(cmd.Tag)
+		}
+		content, err := json.Marshal(exported)
 		if err != nil {
 			return slErrors.NewAPIError(T("Failed to marshal virtual server template.\n"), err.Error(), 1)
 		}
@@ -40,6 +40,7 @@ func NewCreateHostCommand(sl *metadata.SoftlayerCommand) (cmd *CreateHostCommand
 	}
 	thisCmd.Command = cobraCmd
 	cobraCmd.Flags().StringVarP(&thisCmd.Datacenter, "datacenter", "d", "", T("Datacenter shortname [required]"))
+	metadata.UseProfileDatacenter(cobraCmd, "datacenter")
 	cobraCmd.Flags().StringVarP(&thisCmd.Domain, "domain", "D", "", T("Domain portion of the FQDN [required]"))
 	cobraCmd.Flags().StringVarP(&thisCmd.Hostname, "hostname", "H", "", T("Host portion of the FQDN [required]"))
 	cobraCmd.Flags().StringVarP(&thisCmd.Billing, "billing", "b", "hourly", T("Billing rate. Default is: hourly. Options are: hourly, monthly"))
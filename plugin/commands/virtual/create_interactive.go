@@ -0,0 +1,143 @@
+package virtual
+
+import (
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/cmdutils"
+	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
+)
+
+// The flavor choice for picking the CPUs and memory instead
+const customFlavor = "CUSTOM"
+
+// Walks through the options of --interactive, the flags that were given are the defaults.
+// The answers are set on the flags, so the rest of Run works the same as without --interactive.
+func (cmd *CreateCommand) askOptions() error {
+	createOptions, err := cmd.VirtualServerManager.GetCreateOptions("PUBLIC_CLOUD_SERVER", "")
+	if err != nil {
+		return slErrors.NewAPIError(T("Failed to get virtual server creation options.\n"), err.Error(), 2)
+	}
+	cmd.UI.Print(T("Answer the questions to create a virtual server, the default answer is in parentheses."))
+
+	hostname, err := cmdutils.AskText(cmd.SoftlayerCommand, T("Hostname"), cmd.Hostname, true)
+	if err != nil {
+		return err
+	}
+	domain, err := cmdutils.AskText(cmd.SoftlayerCommand, T("Domain"), cmd.Domain, true)
+	if err != nil {
+		return err
+	}
+	datacenter, err := cmdutils.AskChoice(cmd.SoftlayerCommand, T("Datacenter"), createOptions[managers.KEY_LOCATIONS], cmd.Datacenter)
+	if err != nil {
+		return err
+	}
+	err = cmdutils.SetFlags(cmd.Command, map[string]interface{}{"hostname": hostname, "domain": domain, "datacenter": datacenter})
+	if err != nil {
+		return err
+	}
+
+	flavors := map[string]string{customFlavor: T("Choose the number of CPU cores and the memory")}
+	for key, description := range createOptions[managers.KEY_SIZES] {
+		flavors[key] = description
+	}
+	currentFlavor := cmd.Flavor
+	if currentFlavor == "" && cmd.CPU != 0 {
+		currentFlavor = customFlavor
+	}
+	flavor, err := cmdutils.AskChoice(cmd.SoftlayerCommand, T("Flavor"), flavors, currentFlavor)
+	if err != nil {
+		return err
+	}
+	cpu, memory := 0, 0
+	if flavor == customFlavor {
+		flavor = ""
+		cpu, err = cmdutils.AskInt(cmd.SoftlayerCommand, T("Number of CPU cores"), cmd.CPU)
+		if err != nil {
+			return err
+		}
+		memory, err = cmdutils.AskInt(cmd.SoftlayerCommand, T("Memory in megabytes"), cmd.Memory)
+		if err != nil {
+			return err
+		}
+	}
+	err = cmdutils.SetFlags(cmd.Command, map[string]interface{}{"flavor": flavor, "cpu": cpu, "memory": memory})
+	if err != nil {
+		return err
+	}
+
+	if cmd.Image == 0 {
+		operatingSystem, err := cmdutils.AskChoice(cmd.SoftlayerCommand, T("Operating system"), createOptions[managers.KEY_OS], cmd.Os)
+		if err != nil {
+			return err
+		}
+		err = cmdutils.SetFlags(cmd.Command, map[string]interface{}{"os": operatingSystem})
+		if err != nil {
+			return err
+		}
+	}
+
+	private, err := cmdutils.AskYesNo(cmd.SoftlayerCommand, T("Private network only?"), cmd.Private)
+	if err != nil {
+		return err
+	}
+	network, err := cmdutils.AskInt(cmd.SoftlayerCommand, T("Network port speed in Mbps, like 100 or 1000 (0 for the default)"), cmd.Network)
+	if err != nil {
+		return err
+	}
+	vlanPublic := 0
+	if !private {
+		vlanPublic, err = cmdutils.AskInt(cmd.SoftlayerCommand, T("ID of the public VLAN (0 for any)"), cmd.VlanPublic)
+		if err != nil {
+			return err
+		}
+	}
+	vlanPrivate, err := cmdutils.AskInt(cmd.SoftlayerCommand, T("ID of the private VLAN (0 for any)"), cmd.VlanPrivate)
+	if err != nil {
+		return err
+	}
+	err = cmdutils.SetFlags(cmd.Command, map[string]interface{}{"private": private, "network": network, "vlan-public": vlanPublic, "vlan-private": vlanPrivate})
+	if err != nil {
+		return err
+	}
+
+	sshKeys, err := cmd.SecurityManager.ListSSHKeys("")
+	if err != nil {
+		return slErrors.NewAPIError(T("Failed to get SSH keys on your account.\n"), err.Error(), 2)
+	}
+	if len(sshKeys) > 0 {
+		table := cmd.UI.Table([]string{T("ID"), T("Label")})
+		for _, key := range sshKeys {
+			table.Add(utils.FormatIntPointer(key.Id), utils.FormatStringPointer(key.Label))
+		}
+		table.Print()
+		keys, err := cmdutils.AskIntList(cmd.SoftlayerCommand, T("IDs of the SSH keys to add, separated by commas (blank for none)"), cmd.Key)
+		if err != nil {
+			return err
+		}
+		err = cmdutils.SetFlags(cmd.Command, map[string]interface{}{"key": keys})
+		if err != nil {
+			return err
+		}
+	}
+
+	billing, err := cmdutils.AskChoice(cmd.SoftlayerCommand, T("Billing"), map[string]string{"hourly": T("Hourly"), "monthly": T("Monthly")}, cmd.Billing)
+	if err != nil {
+		return err
+	}
+	err = cmdutils.SetFlags(cmd.Command, map[string]interface{}{"billing": billing})
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.Print(T("The same virtual server can be created with:"))
+	cmd.UI.Print(cmdutils.EquivalentCommandLine(cmd.Command, "interactive", "export"))
+	if cmd.Export == "" {
+		export, err := cmdutils.AskText(cmd.SoftlayerCommand, T("File to export these options to as a template (blank to continue without one)"), "", false)
+		if err != nil {
+			return err
+		}
+		cmd.Export = export
+	}
+	return nil
+}
@@ -1,9 +1,11 @@
 package virtual_test
 
 import (
+	"encoding/json"
 	"errors"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -109,6 +111,253 @@ var _ = Describe("VS create", func() {
 				Expect(fakeUI.Outputs()).To(ContainSubstring("OK"))
 				Expect(fakeUI.Outputs()).To(ContainSubstring("Virtual server template is exported to: " + fileName + "."))
 			})
+			It("Keeps the tags in the template", func() {
+				fileName := filepath.Join(GinkgoT().TempDir(), "vs.json")
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "-H", "vs-abc", "-D", "wilma.com", "-c", "2", "-m", "4096", "--datacenter", "dal10", "-o", "CENTOS", "--tag", "web", "--export", fileName)
+				Expect(err).NotTo(HaveOccurred())
+				content, err := os.ReadFile(fileName)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(content)).To(ContainSubstring(`"tagReferences":[{"tag":{"name":"web"}}]`))
+			})
+		})
+		Context("VS create with --template", func() {
+			BeforeEach(func() {
+				fakeVSManager.GenerateInstanceCreationTemplateStub = func(virtualGuest *datatypes.Virtual_Guest, params map[string]interface{}) (*datatypes.Virtual_Guest, error) {
+					virtualGuest.Hostname = sl.String("web2")
+					virtualGuest.TagReferences = []datatypes.Tag_Reference{{Tag: &datatypes.Tag{Name: sl.String("web")}}}
+					return virtualGuest, nil
+				}
+			})
+			It("Sets the tags of the template after the server is created", func() {
+				var guest datatypes.Virtual_Guest
+				fakeVSManager.CreateInstanceStub = func(template *datatypes.Virtual_Guest) (datatypes.Virtual_Guest, error) {
+					guest = *template
+					return fakeServer, nil
+				}
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--template", "web.json", "-f")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*guest.Hostname).To(Equal("web2"))
+				Expect(guest.TagReferences).To(BeNil())
+				id, tags := fakeVSManager.SetTagsArgsForCall(0)
+				Expect(id).To(Equal(1234))
+				Expect(tags).To(Equal("web"))
+			})
+			It("Uses --tag instead of the tags of the template", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--template", "web.json", "--tag", "db", "-f")
+				Expect(err).NotTo(HaveOccurred())
+				_, tags := fakeVSManager.SetTagsArgsForCall(0)
+				Expect(tags).To(Equal("db"))
+			})
+		})
+		Context("VS create --interactive", func() {
+			var fakeSecurityManager *testhelpers.FakeSecurityManager
+			BeforeEach(func() {
+				fakeSecurityManager = new(testhelpers.FakeSecurityManager)
+				cliCommand.SecurityManager = fakeSecurityManager
+				fakeSecurityManager.ListSSHKeysReturns([]datatypes.Security_Ssh_Key{{Id: sl.Int(100), Label: sl.String("mykey")}}, nil)
+				fakeVSManager.GetCreateOptionsReturns(map[string]map[string]string{
+					"locations":         {"dal10": "Dallas 10", "dal13": "Dallas 13"},
+					"sizes":             {"B1_2X4X25": "B1.2x4x25", "C1_1X1X25": "C1.1x1x25"},
+					"operating_systems": {"CENTOS_8_64": "CentOS 8", "UBUNTU_20_64": "Ubuntu 20.04"},
+				}, nil)
+			})
+			It("Asks for the options and shows the same command", func() {
+				fakeUI.Inputs("vs-abc", "wilma.com", "2", "1", "2", "n", "", "413", "", "100", "", "")
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--interactive", "-f")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeUI.Outputs()).To(ContainSubstring("dal13 (Dallas 13)"))
+				Expect(fakeUI.Outputs()).To(ContainSubstring("mykey"))
+				Expect(fakeUI.Outputs()).To(ContainSubstring("The same virtual server can be created with:"))
+				Expect(fakeUI.Outputs()).To(ContainSubstring("ibmcloud sl create --datacenter dal13 --domain wilma.com --flavor B1_2X4X25 --force --hostname vs-abc --key 100 --os UBUNTU_20_64 --vlan-public 413"))
+				_, params := fakeVSManager.GenerateInstanceCreationTemplateArgsForCall(0)
+				Expect(params["hostname"]).To(Equal("vs-abc"))
+				Expect(params["datacenter"]).To(Equal("dal13"))
+				Expect(params["flavor"]).To(Equal("B1_2X4X25"))
+				Expect(params["os"]).To(Equal("UBUNTU_20_64"))
+				Expect(params["vlan-public"]).To(Equal(413))
+				Expect(params["sshkeys"]).To(Equal([]int{100}))
+				Expect(params["billing"]).To(BeTrue())
+				Expect(fakeVSManager.CreateInstanceCallCount()).To(Equal(1))
+			})
+			It("Uses the flags as defaults and asks for CPU and memory", func() {
+				fakeUI.Inputs("", "", "", "3", "4", "8192", "", "y", "", "", "", "2", "")
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--interactive", "-f", "-H", "web1", "-D", "example.com", "-d", "dal10", "-o", "CENTOS_8_64")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeUI.Outputs()).To(ContainSubstring("ibmcloud sl create --billing monthly --cpu 4 --datacenter dal10 --domain example.com --force --hostname web1 --memory 8192 --os CENTOS_8_64 --private"))
+				_, params := fakeVSManager.GenerateInstanceCreationTemplateArgsForCall(0)
+				Expect(params["hostname"]).To(Equal("web1"))
+				Expect(params["cpu"]).To(Equal(4))
+				Expect(params["memory"]).To(Equal(8192))
+				Expect(params["private"]).To(BeTrue())
+				Expect(params["billing"]).To(BeFalse())
+				Expect(params).NotTo(HaveKey("flavor"))
+			})
+			It("Exports a template", func() {
+				fileName := filepath.Join(GinkgoT().TempDir(), "vs.json")
+				fakeUI.Inputs("vs-abc", "wilma.com", "1", "2", "1", "n", "", "", "", "", "", fileName)
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--interactive")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeUI.Outputs()).To(ContainSubstring("Virtual server template is exported to: " + fileName + "."))
+				Expect(fileName).To(BeAnExistingFile())
+				Expect(fakeVSManager.CreateInstanceCallCount()).To(Equal(0))
+			})
+			It("Can't be used with --template", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--interactive", "--template", "vs.json")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("[interactive template] were all set"))
+			})
+			It("Returns an error when the options can't be read", func() {
+				fakeVSManager.GetCreateOptionsReturns(nil, errors.New("Internal Server Error"))
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--interactive")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Failed to get virtual server creation options."))
+			})
+		})
+		Context("VS create --manifest", func() {
+			var manifestFile string
+			BeforeEach(func() {
+				dir := GinkgoT().TempDir()
+				manifestFile = filepath.Join(dir, "fleet.yaml")
+				manifest := `defaults:
+  domain: wilma.com
+  os: UBUNTU_LATEST
+  flavor: B1_2X8X25
+servers:
+  - hostname: web1
+    tags: [web, prod]
+  - hostname: db1
+    cpu: 4
+    memory: 16384
+    disks: [25, 500]
+    vlan-private: 1234
+    private-security-groups: [10]
+    userfile: db-init.sh
+`
+				Expect(os.WriteFile(manifestFile, []byte(manifest), 0600)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(dir, "db-init.sh"), []byte("#!/bin/sh"), 0600)).To(Succeed())
+				fakeVSManager.GenerateInstanceCreationTemplateStub = func(virtualGuest *datatypes.Virtual_Guest, params map[string]interface{}) (*datatypes.Virtual_Guest, error) {
+					hostname := params["hostname"].(string)
+					return &datatypes.Virtual_Guest{Hostname: &hostname}, nil
+				}
+				fakeVSManager.VerifyInstanceCreationReturns(datatypes.Container_Product_Order{
+					Prices: []datatypes.Product_Item_Price{
+						{HourlyRecurringFee: sl.Float(0.05), RecurringFee: sl.Float(30)},
+						{HourlyRecurringFee: sl.Float(0.01), RecurringFee: sl.Float(5.5)},
+					},
+				}, nil)
+				fakeVSManager.CreateInstancesReturns([]datatypes.Virtual_Guest{
+					{Id: sl.Int(1), FullyQualifiedDomainName: sl.String("web1.wilma.com")},
+					{Id: sl.Int(2), FullyQualifiedDomainName: sl.String("db1.wilma.com")},
+				}, nil)
+			})
+			It("Verifies every server and creates them with one order", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--manifest", manifestFile, "-d", "dal13", "-f")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeVSManager.GenerateInstanceCreationTemplateCallCount()).To(Equal(2))
+				_, web := fakeVSManager.GenerateInstanceCreationTemplateArgsForCall(0)
+				Expect(web["hostname"]).To(Equal("web1"))
+				Expect(web["domain"]).To(Equal("wilma.com"))
+				Expect(web["datacenter"]).To(Equal("dal13"))
+				Expect(web["flavor"]).To(Equal("B1_2X8X25"))
+				_, db := fakeVSManager.GenerateInstanceCreationTemplateArgsForCall(1)
+				Expect(db["hostname"]).To(Equal("db1"))
+				Expect(db).NotTo(HaveKey("flavor"))
+				Expect(db["cpu"]).To(Equal(4))
+				Expect(db["memory"]).To(Equal(16384))
+				Expect(db["disks"]).To(Equal([]int{25, 500}))
+				Expect(db["vlan-private"]).To(Equal(1234))
+				Expect(db["private-security-group"]).To(Equal([]int{10}))
+				Expect(fakeVSManager.VerifyInstanceCreationCallCount()).To(Equal(2))
+				Expect(fakeUI.Outputs()).To(MatchRegexp(`web1\s+0.06\s+35.50`))
+				Expect(fakeUI.Outputs()).To(MatchRegexp(`Total\s+0.12\s+71.00`))
+				Expect(fakeVSManager.CreateInstancesCallCount()).To(Equal(1))
+				guests := fakeVSManager.CreateInstancesArgsForCall(0)
+				Expect(guests).To(HaveLen(2))
+				Expect(guests[0].UserData).To(BeEmpty())
+				Expect(*guests[1].UserData[0].Value).To(Equal("#!/bin/sh"))
+				Expect(fakeVSManager.SetTagsCallCount()).To(Equal(1))
+				id, tags := fakeVSManager.SetTagsArgsForCall(0)
+				Expect(id).To(Equal(1))
+				Expect(tags).To(Equal("web,prod"))
+				Expect(fakeUI.Outputs()).To(ContainSubstring("db1.wilma.com"))
+			})
+			It("Only verifies with --test", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--manifest", manifestFile, "-d", "dal13", "--test")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeUI.Outputs()).To(ContainSubstring("The order is correct."))
+				Expect(fakeVSManager.CreateInstancesCallCount()).To(Equal(0))
+			})
+			It("Asks before ordering", func() {
+				fakeUI.Inputs("n")
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--manifest", manifestFile, "-d", "dal13")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeUI.Outputs()).To(ContainSubstring("This action will create 2 virtual servers"))
+				Expect(fakeUI.Outputs()).To(ContainSubstring("Aborted."))
+				Expect(fakeVSManager.CreateInstancesCallCount()).To(Equal(0))
+			})
+			It("Names the server that is missing options", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--manifest", manifestFile, "-f")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Failed to create the template of web1"))
+				Expect(fakeVSManager.CreateInstancesCallCount()).To(Equal(0))
+			})
+			It("Stops when a server fails to verify", func() {
+				fakeVSManager.VerifyInstanceCreationReturnsOnCall(1, datatypes.Container_Product_Order{}, errors.New("Internal Server Error"))
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--manifest", manifestFile, "-d", "dal13", "-f")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Failed to verify the creation of db1."))
+				Expect(fakeVSManager.CreateInstancesCallCount()).To(Equal(0))
+			})
+			It("Verifies every server before it stops", func() {
+				fakeVSManager.VerifyInstanceCreationReturnsOnCall(0, datatypes.Container_Product_Order{}, errors.New("Internal Server Error"))
+				fakeVSManager.VerifyInstanceCreationReturnsOnCall(1, datatypes.Container_Product_Order{}, errors.New("Invalid flavor"))
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--manifest", manifestFile, "-d", "dal13", "-f")
+				Expect(err).To(HaveOccurred())
+				Expect(fakeVSManager.VerifyInstanceCreationCallCount()).To(Equal(2))
+				Expect(err.Error()).To(ContainSubstring("Failed to verify the creation of web1."))
+				Expect(err.Error()).To(ContainSubstring("Failed to verify the creation of db1."))
+				Expect(fakeVSManager.CreateInstancesCallCount()).To(Equal(0))
+			})
+			It("Prints the verified orders as JSON with --test", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--manifest", manifestFile, "-d", "dal13", "--test", "--output", "json")
+				Expect(err).NotTo(HaveOccurred())
+				var orders []datatypes.Container_Product_Order
+				Expect(json.Unmarshal([]byte(fakeUI.Outputs()), &orders)).To(Succeed())
+				Expect(orders).To(HaveLen(2))
+				Expect(*orders[1].Prices[0].RecurringFee).To(Equal(datatypes.Float64(30)))
+			})
+			It("Prints the created servers as JSON", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--manifest", manifestFile, "-d", "dal13", "-f", "--output", "json")
+				Expect(err).NotTo(HaveOccurred())
+				var created []datatypes.Virtual_Guest
+				Expect(json.Unmarshal([]byte(fakeUI.Outputs()), &created)).To(Succeed())
+				Expect(created).To(HaveLen(2))
+				Expect(*created[1].FullyQualifiedDomainName).To(Equal("db1.wilma.com"))
+			})
+			It("Refuses a manifest without servers", func() {
+				Expect(os.WriteFile(manifestFile, []byte("defaults:\n  domain: wilma.com\n"), 0600)).To(Succeed())
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--manifest", manifestFile)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("has no servers."))
+			})
+			It("Refuses two servers with the same hostname", func() {
+				Expect(os.WriteFile(manifestFile, []byte("servers:\n  - hostname: web1\n  - hostname: web1\n"), 0600)).To(Succeed())
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--manifest", manifestFile)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("has more than one server named web1."))
+			})
+			It("Refuses a manifest with unknown keys", func() {
+				Expect(os.WriteFile(manifestFile, []byte("servers:\n  - hostname: web1\n    flavour: B1_2X8X25\n"), 0600)).To(Succeed())
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--manifest", manifestFile, "-d", "dal13", "-f")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("field flavour not found"))
+				Expect(fakeVSManager.CreateInstancesCallCount()).To(Equal(0))
+			})
+			It("Can't be used with --quantity", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--manifest", manifestFile, "--quantity", "2")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("[manifest quantity] were all set"))
+			})
 		})
 		Context("VS create with --test", func() {
 			It("API Error", func() {
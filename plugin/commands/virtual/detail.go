@@ -7,8 +7,8 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
 	"github.com/softlayer/softlayer-go/datatypes"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -37,6 +37,9 @@ func NewDetailCommand(sl *metadata.SoftlayerCommand) (cmd *DetailCommand) {
 			return thisCmd.Run(args)
 		},
 	}
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "virtual_guest", func() ([]string, error) {
+		return managers.VirtualGuestCompletions(thisCmd.VirtualServerManager)
+	})
 	thisCmd.Command = cobraCmd
 	cobraCmd.Flags().BoolVar(&thisCmd.Passwords, "passwords", false, T("Show passwords (check over your shoulder!)"))
 	cobraCmd.Flags().BoolVar(&thisCmd.Price, "price", false, T("Show associated prices"))
@@ -45,9 +48,9 @@ func NewDetailCommand(sl *metadata.SoftlayerCommand) (cmd *DetailCommand) {
 
 func (cmd *DetailCommand) Run(args []string) error {
 
-	vsID, err := utils.ResolveVirtualGuestId(args[0])
+	vsID, err := managers.ResolveVirtualGuestId(cmd.VirtualServerManager, args[0])
 	if err != nil {
-		return slErrors.NewInvalidSoftlayerIdInputError("Virtual server ID")
+		return err
 	}
 
 	outputFormat := cmd.GetOutputFlag()
@@ -112,7 +115,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 
 	if localDisks != nil && len(localDisks) > 0 {
 		buf := new(bytes.Buffer)
-		drivesTable := terminal.NewTable(buf, []string{T("id"), T("type"), T("name"), T("drive"), T("capacity")})
+		drivesTable := utils.NewTable(buf, []string{T("id"), T("type"), T("name"), T("drive"), T("capacity")})
 		for _, localDisk := range localDisks {
 			diskType := "System"
 			diskCapacity := 0
@@ -202,7 +205,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 
 	if vlans := virtualGuest.NetworkVlans; len(vlans) > 0 {
 		buf := new(bytes.Buffer)
-		vlanTable := terminal.NewTable(buf, []string{T("type"), T("number"), T("id")})
+		vlanTable := utils.NewTable(buf, []string{T("type"), T("number"), T("id")})
 		for _, vlan := range vlans {
 			vlanTable.Add(utils.FormatStringPointer(vlan.NetworkSpace),
 				utils.FormatIntPointer(vlan.VlanNumber),
@@ -214,7 +217,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 
 	hasSecGroups := false
 	buf := new(bytes.Buffer)
-	secGroupTable := terminal.NewTable(buf, []string{T("interface"), T("id"), T("name")})
+	secGroupTable := utils.NewTable(buf, []string{T("interface"), T("id"), T("name")})
 	for _, comp := range virtualGuest.NetworkComponents {
 		nicType := T("public")
 		if (comp.Port != nil && *comp.Port == 0) || comp.Port == nil {
@@ -233,7 +236,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 
 	if virtualGuest.DedicatedHost != nil && virtualGuest.DedicatedHost.Id != nil {
 		buf := new(bytes.Buffer)
-		hostTable := terminal.NewTable(buf, []string{T("id"), T("name")})
+		hostTable := utils.NewTable(buf, []string{T("id"), T("name")})
 		hostTable.Add(utils.FormatIntPointer(host.Id),
 			utils.FormatStringPointer(host.Name))
 		hostTable.Print()
@@ -243,7 +246,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 	if cmd.Passwords {
 		if virtualGuest.OperatingSystem != nil && virtualGuest.OperatingSystem.Passwords != nil {
 			buf := new(bytes.Buffer)
-			userTable := terminal.NewTable(buf, []string{T("software"), T("username"), T("password")})
+			userTable := utils.NewTable(buf, []string{T("software"), T("username"), T("password")})
 			for _, pwd := range virtualGuest.OperatingSystem.Passwords {
 				software := ""
 				if virtualGuest.OperatingSystem.SoftwareLicense != nil && virtualGuest.OperatingSystem.SoftwareLicense.SoftwareDescription != nil && virtualGuest.OperatingSystem.SoftwareLicense.SoftwareDescription.Name != nil {
@@ -259,7 +262,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 	if cmd.Price {
 		if virtualGuest.BillingItem != nil && virtualGuest.BillingItem.NextInvoiceTotalRecurringAmount != nil {
 			buf := new(bytes.Buffer)
-			priceTable := terminal.NewTable(buf, []string{T("Item"), T("CategoryCode"), T("Recurring Price")})
+			priceTable := utils.NewTable(buf, []string{T("Item"), T("CategoryCode"), T("Recurring Price")})
 
 			totalPrice := virtualGuest.BillingItem.NextInvoiceTotalRecurringAmount
 			priceTable.Add("Total", "-", fmt.Sprintf("%.2f", *totalPrice))
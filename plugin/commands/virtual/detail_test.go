@@ -2,6 +2,7 @@ package virtual_test
 
 import (
 	"errors"
+	"os"
 	"strings"
 	"time"
 
@@ -11,7 +12,9 @@ import (
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
 	"github.com/softlayer/softlayer-go/sl"
+	"github.com/spf13/cobra"
 
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/virtual"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
@@ -131,6 +134,7 @@ var _ = Describe("VS detail", func() {
 		slCommand = metadata.NewSoftlayerCommand(fakeUI, fakeSession)
 		cliCommand = virtual.NewDetailCommand(slCommand)
 		cliCommand.Command.PersistentFlags().Var(cliCommand.OutputFlag, "output", "--output=JSON for json output.")
+		cliCommand.Command.PersistentFlags().Var(cliCommand.QueryFlag, "query", "JMESPath query")
 		cliCommand.VirtualServerManager = fakeVSManager
 	})
 	Describe("VS detail", func() {
@@ -145,7 +149,7 @@ var _ = Describe("VS detail", func() {
 			It("return error", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "abc")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Invalid input for 'Virtual server ID'. It must be a positive integer."))
+				Expect(err.Error()).To(ContainSubstring("Unable to find virtual server instance"))
 			})
 		})
 
@@ -218,6 +222,48 @@ var _ = Describe("VS detail", func() {
 				Expect(fakeUI.Outputs()).To(ContainSubstring("guest_core_usage"))
 			})
 		})
+		Context("VS detail with a FQDN", func() {
+			BeforeEach(func() {
+				fakeVSManager.ListInstancesReturns([]datatypes.Virtual_Guest{{Id: sl.Int(1234)}}, nil)
+				fakeVSManager.GetInstanceReturns(GetInstanceReturn, nil)
+				fakeVSManager.GetLocalDisksReturns(BlockDeviceReturns, nil)
+			})
+			It("return the details of the matching server", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "vs-abc.wilma.com")
+				Expect(err).NotTo(HaveOccurred())
+				id, _ := fakeVSManager.GetInstanceArgsForCall(0)
+				Expect(id).To(Equal(1234))
+				Expect(fakeUI.Outputs()).To(ContainSubstring("rthtoshfkthr"))
+			})
+		})
+		Context("VS detail with structured output", func() {
+			BeforeEach(func() {
+				fakeVSManager.GetInstanceReturns(GetInstanceReturn, nil)
+				fakeVSManager.GetLocalDisksReturns(BlockDeviceReturns, nil)
+			})
+			It("return yaml", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "1234", "--output=yaml")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeUI.Outputs()).To(ContainSubstring("id: 1234\n"))
+				Expect(fakeUI.Outputs()).To(ContainSubstring("fullyQualifiedDomainName: vs-abc.wilma.com\n"))
+				Expect(fakeUI.Outputs()).NotTo(ContainSubstring("{"))
+			})
+			It("return template", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "1234", "--output=template={{.Id}} {{.FullyQualifiedDomainName}}")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeUI.Outputs()).To(Equal("1234 vs-abc.wilma.com\n"))
+			})
+			It("return the result of --query", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "1234", "--query", "fullyQualifiedDomainName")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeUI.Outputs()).To(Equal("\"vs-abc.wilma.com\"\n"))
+			})
+			It("return error for a bad template", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "1234", "--output=template={{.Id")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Invalid output template"))
+			})
+		})
 		Context("Github issues #252", func() {
 
 			BeforeEach(func() {
@@ -252,4 +298,29 @@ var _ = Describe("VS detail", func() {
 			})
 		})
 	})
+	Describe("VS detail completion", func() {
+		BeforeEach(func() {
+			os.Setenv(client.ENV_SL_CACHE_DIR, GinkgoT().TempDir())
+			fakeVSManager.ListInstancesReturns([]datatypes.Virtual_Guest{
+				{Id: sl.Int(111), Hostname: sl.String("web1"), Domain: sl.String("example.com")},
+				{Id: sl.Int(222), Hostname: sl.String("db1"), Domain: sl.String("example.com")},
+			}, nil)
+		})
+		AfterEach(func() {
+			os.Unsetenv(client.ENV_SL_CACHE_DIR)
+		})
+		It("Completes virtual server IDs", func() {
+			completions, directive := cliCommand.Command.ValidArgsFunction(cliCommand.Command, []string{}, "1")
+			Expect(completions).To(Equal([]string{"111\tweb1.example.com"}))
+			Expect(directive).To(Equal(cobra.ShellCompDirectiveNoFileComp))
+			_, _, _, _, _, _, _, _, _, _, _, _, _, mask, _ := fakeVSManager.ListInstancesArgsForCall(0)
+			Expect(mask).To(Equal("mask[id,hostname,domain]"))
+		})
+		It("Reuses the list for a while", func() {
+			cliCommand.Command.ValidArgsFunction(cliCommand.Command, []string{}, "")
+			completions, _ := cliCommand.Command.ValidArgsFunction(cliCommand.Command, []string{}, "")
+			Expect(completions).To(HaveLen(2))
+			Expect(fakeVSManager.ListInstancesCallCount()).To(Equal(1))
+		})
+	})
 })
@@ -0,0 +1,181 @@
+package virtual
+
+import (
+	"fmt"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/spf13/cobra"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/cmdutils"
+	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
+)
+
+const diffNetworkMask = "maxSpeed,networkVlan.id,securityGroupBindings.securityGroup[id,name]"
+
+// Everything vs diff compares
+const DIFF_VIRTUAL_GUEST_MASK = "id,hostname,fullyQualifiedDomainName,datacenter.name,maxCpu,maxMemory,notes,hourlyBillingFlag," +
+	"localDiskFlag,dedicatedAccountHostOnlyFlag,privateNetworkOnlyFlag,placementGroupId,operatingSystemReferenceCode," +
+	"operatingSystem.softwareLicense.softwareDescription[longDescription,version],blockDeviceTemplateGroup[name,globalIdentifier]," +
+	"billingItem[recurringFee,hourlyRecurringFee,orderItem.preset.keyName],blockDevices[device,mountType,diskImage[capacity,units]]," +
+	"primaryNetworkComponent[" + diffNetworkMask + "],primaryBackendNetworkComponent[" + diffNetworkMask + "]," +
+	"tagReferences.tag.name,userData.value"
+
+type DiffCommand struct {
+	*metadata.SoftlayerCommand
+	VirtualServerManager managers.VirtualServerManager
+	Command              *cobra.Command
+	All                  bool
+}
+
+func NewDiffCommand(sl *metadata.SoftlayerCommand) (cmd *DiffCommand) {
+	thisCmd := &DiffCommand{
+		SoftlayerCommand:     sl,
+		VirtualServerManager: managers.NewVirtualServerManager(sl.Session),
+	}
+	cobraCmd := &cobra.Command{
+		Use:   "diff " + T("IDENTIFIER") + " " + T("IDENTIFIER"),
+		Short: T("Compare the configuration of two virtual server instances"),
+		Long: T(`${COMMAND_NAME} sl vs diff IDENTIFIER IDENTIFIER [OPTIONS]
+
+Compares the OS, image, flavor, CPU, memory, disks, port speeds, VLANs, security groups, tags, userdata, notes and billing.
+
+EXAMPLE:
+   ${COMMAND_NAME} sl vs diff web1 web2
+   Shows the settings that are not the same on web1 and web2.
+   ${COMMAND_NAME} sl vs diff 12345678 87654321 --all --output JSON
+   Shows every setting of both virtual servers in JSON, with whether they are the same.`),
+		Args: metadata.TwoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return thisCmd.Run(args)
+		},
+	}
+	cobraCmd.Flags().BoolVar(&thisCmd.All, "all", false, T("Show the settings that are the same too"))
+	cobraCmd.ValidArgsFunction = client.CompleteEveryIdentifier(sl.Session, "virtual_guest", func() ([]string, error) {
+		return managers.VirtualGuestCompletions(thisCmd.VirtualServerManager)
+	})
+	thisCmd.Command = cobraCmd
+	return thisCmd
+}
+
+func (cmd *DiffCommand) Run(args []string) error {
+	guests := []datatypes.Virtual_Guest{}
+	for _, identifier := range args {
+		vsID, err := managers.ResolveVirtualGuestId(cmd.VirtualServerManager, identifier)
+		if err != nil {
+			return err
+		}
+		guest, err := cmd.VirtualServerManager.GetInstance(vsID, DIFF_VIRTUAL_GUEST_MASK)
+		if err != nil {
+			return slErrors.NewAPIError(T("Failed to get virtual server instance: {{.VsID}}.\n", map[string]interface{}{"VsID": vsID}), err.Error(), 2)
+		}
+		guests = append(guests, guest)
+	}
+	fields, err := cmdutils.DiffConfigs(VirtualGuestConfig(guests[0]), VirtualGuestConfig(guests[1]))
+	if err != nil {
+		return err
+	}
+	return cmdutils.PrintDiff(cmd.SoftlayerCommand, diffName(guests[0]), diffName(guests[1]), fields, cmd.All)
+}
+
+// The settings of guest that vs diff compares
+func VirtualGuestConfig(guest datatypes.Virtual_Guest) []cmdutils.ConfigValue {
+	osName, osVersion := "", ""
+	if guest.OperatingSystem != nil && guest.OperatingSystem.SoftwareLicense != nil && guest.OperatingSystem.SoftwareLicense.SoftwareDescription != nil {
+		osName = utils.StringPointertoString(guest.OperatingSystem.SoftwareLicense.SoftwareDescription.LongDescription)
+		osVersion = utils.StringPointertoString(guest.OperatingSystem.SoftwareLicense.SoftwareDescription.Version)
+	}
+	image := ""
+	if guest.BlockDeviceTemplateGroup != nil {
+		image = utils.StringPointertoString(guest.BlockDeviceTemplateGroup.Name)
+	}
+	flavor := ""
+	var billingItem *datatypes.Billing_Item
+	if guest.BillingItem != nil {
+		billingItem = &guest.BillingItem.Billing_Item
+		if guest.BillingItem.OrderItem != nil && guest.BillingItem.OrderItem.Preset != nil {
+			flavor = utils.StringPointertoString(guest.BillingItem.OrderItem.Preset.KeyName)
+		}
+	}
+	datacenter := ""
+	if guest.Datacenter != nil {
+		datacenter = utils.StringPointertoString(guest.Datacenter.Name)
+	}
+	// Device 1 is the swap disk
+	disks := []string{}
+	for _, device := range guest.BlockDevices {
+		if device.DiskImage == nil || device.DiskImage.Capacity == nil || utils.StringPointertoString(device.Device) == "1" {
+			continue
+		}
+		if device.MountType != nil && *device.MountType != "Disk" {
+			continue
+		}
+		disks = append(disks, fmt.Sprintf("%s: %d %s", utils.StringPointertoString(device.Device), *device.DiskImage.Capacity, utils.StringPointertoString(device.DiskImage.Units)))
+	}
+	tags := []string{}
+	for _, reference := range guest.TagReferences {
+		if reference.Tag != nil && reference.Tag.Name != nil {
+			tags = append(tags, *reference.Tag.Name)
+		}
+	}
+	userData := []string{}
+	for _, attribute := range guest.UserData {
+		userData = append(userData, utils.StringPointertoString(attribute.Value))
+	}
+	publicSpeed, publicVlan, publicGroups := diffNetworkComponent(guest.PrimaryNetworkComponent)
+	privateSpeed, privateVlan, privateGroups := diffNetworkComponent(guest.PrimaryBackendNetworkComponent)
+	config := []cmdutils.ConfigValue{
+		{Key: "datacenter", Label: T("Datacenter"), Value: datacenter},
+		{Key: "os", Label: T("OS"), Value: osName},
+		{Key: "osVersion", Label: T("OS version"), Value: osVersion},
+		{Key: "osCode", Label: T("OS code"), Value: utils.StringPointertoString(guest.OperatingSystemReferenceCode)},
+		{Key: "image", Label: T("Image"), Value: image},
+		{Key: "flavor", Label: T("Flavor"), Value: flavor},
+		{Key: "cpu", Label: T("CPU"), Value: cmdutils.DiffInt(guest.MaxCpu)},
+		{Key: "memory", Label: T("Memory"), Value: cmdutils.DiffInt(guest.MaxMemory)},
+		{Key: "disks", Label: T("Disks"), Value: cmdutils.DiffList(disks)},
+		{Key: "localDisk", Label: T("Local disk"), Value: cmdutils.DiffBool(guest.LocalDiskFlag)},
+		{Key: "dedicated", Label: T("Dedicated"), Value: cmdutils.DiffBool(guest.DedicatedAccountHostOnlyFlag)},
+		{Key: "privateOnly", Label: T("Private network only"), Value: cmdutils.DiffBool(guest.PrivateNetworkOnlyFlag)},
+		{Key: "publicPortSpeed", Label: T("Public port speed"), Value: publicSpeed},
+		{Key: "privatePortSpeed", Label: T("Private port speed"), Value: privateSpeed},
+		{Key: "publicVlan", Label: T("Public VLAN ID"), Value: publicVlan},
+		{Key: "privateVlan", Label: T("Private VLAN ID"), Value: privateVlan},
+		{Key: "publicSecurityGroups", Label: T("Public security groups"), Value: publicGroups},
+		{Key: "privateSecurityGroups", Label: T("Private security groups"), Value: privateGroups},
+		{Key: "placementGroup", Label: T("Placement group ID"), Value: cmdutils.DiffInt(guest.PlacementGroupId)},
+		{Key: "tags", Label: T("Tags"), Value: cmdutils.DiffList(tags)},
+		{Key: "userData", Label: T("User data"), Value: cmdutils.DiffList(userData)},
+		{Key: "notes", Label: T("Notes"), Value: utils.StringPointertoString(guest.Notes)},
+	}
+	return append(config, cmdutils.DiffBilling(guest.HourlyBillingFlag, billingItem)...)
+}
+
+// The port speed, VLAN ID and security groups of a network component
+func diffNetworkComponent(component *datatypes.Virtual_Guest_Network_Component) (string, string, string) {
+	if component == nil {
+		return "", "", ""
+	}
+	vlan := ""
+	if component.NetworkVlan != nil {
+		vlan = cmdutils.DiffInt(component.NetworkVlan.Id)
+	}
+	groups := []string{}
+	for _, binding := range component.SecurityGroupBindings {
+		if binding.SecurityGroup != nil && binding.SecurityGroup.Id != nil {
+			groups = append(groups, fmt.Sprintf("%d %s", *binding.SecurityGroup.Id, utils.StringPointertoString(binding.SecurityGroup.Name)))
+		}
+	}
+	return cmdutils.DiffInt(component.MaxSpeed), vlan, cmdutils.DiffList(groups)
+}
+
+func diffName(guest datatypes.Virtual_Guest) string {
+	if guest.FullyQualifiedDomainName != nil {
+		return *guest.FullyQualifiedDomainName
+	}
+	return utils.FormatIntPointer(guest.Id)
+}
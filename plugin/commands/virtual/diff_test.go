@@ -0,0 +1,126 @@
+package virtual_test
+
+import (
+	"errors"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/virtual"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
+)
+
+var _ = Describe("VS diff", func() {
+	var (
+		fakeUI        *terminal.FakeUI
+		cliCommand    *virtual.DiffCommand
+		fakeSession   *session.Session
+		slCommand     *metadata.SoftlayerCommand
+		fakeVSManager *testhelpers.FakeVirtualServerManager
+	)
+	guest := func(name string, speed int, vlan int, groups []datatypes.Virtual_Network_SecurityGroup_NetworkComponentBinding, tags ...string) datatypes.Virtual_Guest {
+		references := []datatypes.Tag_Reference{}
+		for _, tag := range tags {
+			references = append(references, datatypes.Tag_Reference{Tag: &datatypes.Tag{Name: sl.String(tag)}})
+		}
+		return datatypes.Virtual_Guest{
+			Id:                       sl.Int(1),
+			FullyQualifiedDomainName: sl.String(name),
+			Datacenter:               &datatypes.Location{Name: sl.String("dal13")},
+			MaxCpu:                   sl.Int(2),
+			MaxMemory:                sl.Int(4096),
+			HourlyBillingFlag:        sl.Bool(true),
+			OperatingSystem: &datatypes.Software_Component_OperatingSystem{Software_Component: datatypes.Software_Component{
+				SoftwareLicense: &datatypes.Software_License{SoftwareDescription: &datatypes.Software_Description{
+					LongDescription: sl.String("Ubuntu 22.04-64 Minimal"), Version: sl.String("22.04-64"),
+				}},
+			}},
+			PrimaryNetworkComponent: &datatypes.Virtual_Guest_Network_Component{
+				MaxSpeed:              sl.Int(speed),
+				NetworkVlan:           &datatypes.Network_Vlan{Id: sl.Int(vlan)},
+				SecurityGroupBindings: groups,
+			},
+			BlockDevices: []datatypes.Virtual_Guest_Block_Device{
+				{Device: sl.String("0"), MountType: sl.String("Disk"), DiskImage: &datatypes.Virtual_Disk_Image{Capacity: sl.Int(25), Units: sl.String("GB")}},
+				{Device: sl.String("1"), MountType: sl.String("Disk"), DiskImage: &datatypes.Virtual_Disk_Image{Capacity: sl.Int(2), Units: sl.String("GB")}},
+			},
+			TagReferences: references,
+		}
+	}
+	group := func(id int, name string) datatypes.Virtual_Network_SecurityGroup_NetworkComponentBinding {
+		return datatypes.Virtual_Network_SecurityGroup_NetworkComponentBinding{SecurityGroup: &datatypes.Network_SecurityGroup{Id: sl.Int(id), Name: sl.String(name)}}
+	}
+	BeforeEach(func() {
+		fakeUI = terminal.NewFakeUI()
+		fakeSession = testhelpers.NewFakeSoftlayerSession([]string{})
+		fakeVSManager = new(testhelpers.FakeVirtualServerManager)
+		slCommand = metadata.NewSoftlayerCommand(fakeUI, fakeSession)
+		cliCommand = virtual.NewDiffCommand(slCommand)
+		cliCommand.Command.PersistentFlags().Var(cliCommand.OutputFlag, "output", "--output=JSON for json output.")
+		cliCommand.VirtualServerManager = fakeVSManager
+		fakeVSManager.GetInstanceReturnsOnCall(0, guest("web1.example.com", 1000, 111, []datatypes.Virtual_Network_SecurityGroup_NetworkComponentBinding{group(1, "ssh"), group(2, "web")}, "web", "prod"), nil)
+		fakeVSManager.GetInstanceReturnsOnCall(1, guest("web2.example.com", 100, 111, []datatypes.Virtual_Network_SecurityGroup_NetworkComponentBinding{group(1, "ssh")}, "prod", "web"), nil)
+	})
+
+	Describe("VS diff", func() {
+		It("Needs two identifiers", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "1234")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Incorrect Usage: This command requires two arguments."))
+		})
+		It("Shows the settings that differ", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "1234", "5678")
+			Expect(err).NotTo(HaveOccurred())
+			id, mask := fakeVSManager.GetInstanceArgsForCall(0)
+			Expect(id).To(Equal(1234))
+			Expect(mask).To(Equal(virtual.DIFF_VIRTUAL_GUEST_MASK))
+			id, _ = fakeVSManager.GetInstanceArgsForCall(1)
+			Expect(id).To(Equal(5678))
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`Field\s+web1.example.com\s+web2.example.com`))
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`Public port speed\s+1000\s+100`))
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`Public security groups\s+1 ssh, 2 web\s+1 ssh`))
+			Expect(fakeUI.Outputs()).NotTo(ContainSubstring("Tags"))
+			Expect(fakeUI.Outputs()).NotTo(ContainSubstring("Public VLAN ID"))
+		})
+		It("Shows every setting with --all", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "1234", "5678", "--all")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`OS\s+Ubuntu 22.04-64 Minimal\s+Ubuntu 22.04-64 Minimal`))
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`Tags\s+prod, web\s+prod, web`))
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`Disks\s+0: 25 GB\s+0: 25 GB`))
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`Billing\s+Hourly\s+Hourly`))
+		})
+		It("Prints JSON", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "1234", "5678", "--output", "JSON")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(ContainSubstring(`"field": "publicPortSpeed",`))
+			Expect(fakeUI.Outputs()).To(ContainSubstring(`"first": "1000",`))
+			Expect(fakeUI.Outputs()).To(ContainSubstring(`"second": "100",`))
+		})
+		It("Compares the fee an hourly and a monthly server are billed by apart", func() {
+			monthly := guest("web2.example.com", 1000, 111, nil)
+			monthly.HourlyBillingFlag = sl.Bool(false)
+			monthly.BillingItem = &datatypes.Billing_Item_Virtual_Guest{Billing_Item: datatypes.Billing_Item{RecurringFee: sl.Float(40)}}
+			hourly := guest("web1.example.com", 1000, 111, nil)
+			hourly.BillingItem = &datatypes.Billing_Item_Virtual_Guest{Billing_Item: datatypes.Billing_Item{HourlyRecurringFee: sl.Float(0.055), RecurringFee: sl.Float(40)}}
+			fakeVSManager.GetInstanceReturnsOnCall(0, hourly, nil)
+			fakeVSManager.GetInstanceReturnsOnCall(1, monthly, nil)
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "1234", "5678", "--output", "JSON")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`"field": "billing",\s+"first": "hourly",\s+"second": "monthly",`))
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`"field": "hourlyFee",\s+"first": "0.055",\s+"second": "",`))
+			Expect(fakeUI.Outputs()).To(MatchRegexp(`"field": "monthlyFee",\s+"first": "",\s+"second": "40.00",`))
+		})
+		It("Returns the API error", func() {
+			fakeVSManager.GetInstanceReturnsOnCall(1, datatypes.Virtual_Guest{}, errors.New("Internal Server Error"))
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "1234", "5678")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Failed to get virtual server instance: 5678."))
+		})
+	})
+})
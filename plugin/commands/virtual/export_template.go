@@ -0,0 +1,78 @@
+package virtual
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+)
+
+type ExportTemplateCommand struct {
+	*metadata.SoftlayerCommand
+	VirtualServerManager managers.VirtualServerManager
+	Command              *cobra.Command
+	File                 string
+}
+
+func NewExportTemplateCommand(sl *metadata.SoftlayerCommand) (cmd *ExportTemplateCommand) {
+	thisCmd := &ExportTemplateCommand{
+		SoftlayerCommand:     sl,
+		VirtualServerManager: managers.NewVirtualServerManager(sl.Session),
+	}
+	cobraCmd := &cobra.Command{
+		Use:   "export-template " + T("IDENTIFIER"),
+		Short: T("Export the configuration of a virtual server to a template file for vs create"),
+		Long: T(`${COMMAND_NAME} sl vs export-template IDENTIFIER [OPTIONS]
+
+The template has the flavor (or CPU and memory), OS or image, disks, network speed, VLANs, subnets, security groups,
+SSH keys, placement group, boot mode, userdata and tags of the virtual server.
+
+EXAMPLE:
+   ${COMMAND_NAME} sl vs export-template web1 --file web.json
+   ${COMMAND_NAME} sl vs create --template web.json -H web2
+   Orders a virtual server named web2 like web1.`),
+		Args: metadata.OneArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return thisCmd.Run(args)
+		},
+	}
+	cobraCmd.Flags().StringVar(&thisCmd.File, "file", "", T("Write the template to this file instead of printing it"))
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "virtual_guest", func() ([]string, error) {
+		return managers.VirtualGuestCompletions(thisCmd.VirtualServerManager)
+	})
+	thisCmd.Command = cobraCmd
+	return thisCmd
+}
+
+func (cmd *ExportTemplateCommand) Run(args []string) error {
+	vsID, err := managers.ResolveVirtualGuestId(cmd.VirtualServerManager, args[0])
+	if err != nil {
+		return err
+	}
+	template, err := cmd.VirtualServerManager.ExportInstanceTemplate(vsID)
+	if err != nil {
+		return slErrors.NewAPIError(T("Failed to get virtual server instance: {{.VsID}}.\n", map[string]interface{}{"VsID": vsID}), err.Error(), 2)
+	}
+	content, err := json.MarshalIndent(template, "", "  ")
+	if err != nil {
+		return slErrors.NewAPIError(T("Failed to marshal virtual server template.\n"), err.Error(), 1)
+	}
+	if cmd.File == "" {
+		cmd.UI.Print(string(content))
+		return nil
+	}
+	err = os.WriteFile(cmd.File, append(content, '\n'), 0600)
+	if err != nil {
+		return slErrors.NewAPIError(T("Failed to write virtual server template file to: {{.Template}}.",
+			map[string]interface{}{"Template": cmd.File}), err.Error(), 1)
+	}
+	cmd.UI.Ok()
+	cmd.UI.Print(T("Virtual server template is exported to: {{.Template}}.", map[string]interface{}{"Template": cmd.File}))
+	return nil
+}
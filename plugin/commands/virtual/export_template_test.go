@@ -0,0 +1,81 @@
+package virtual_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/virtual"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
+)
+
+var _ = Describe("VS export-template", func() {
+	var (
+		fakeUI        *terminal.FakeUI
+		cliCommand    *virtual.ExportTemplateCommand
+		fakeSession   *session.Session
+		slCommand     *metadata.SoftlayerCommand
+		fakeVSManager *testhelpers.FakeVirtualServerManager
+	)
+	BeforeEach(func() {
+		fakeUI = terminal.NewFakeUI()
+		fakeSession = testhelpers.NewFakeSoftlayerSession([]string{})
+		fakeVSManager = new(testhelpers.FakeVirtualServerManager)
+		slCommand = metadata.NewSoftlayerCommand(fakeUI, fakeSession)
+		cliCommand = virtual.NewExportTemplateCommand(slCommand)
+		cliCommand.Command.PersistentFlags().Var(cliCommand.OutputFlag, "output", "--output=JSON for json output.")
+		cliCommand.VirtualServerManager = fakeVSManager
+		fakeVSManager.ExportInstanceTemplateReturns(datatypes.Virtual_Guest{
+			Hostname: sl.String("web1"),
+			Domain:   sl.String("example.com"),
+			SupplementalCreateObjectOptions: &datatypes.Virtual_Guest_SupplementalCreateObjectOptions{
+				FlavorKeyName: sl.String("B1_2X8X25"),
+			},
+			TagReferences: managers.InstanceTemplateTagReferences([]string{"web"}),
+		}, nil)
+	})
+
+	Describe("VS export-template", func() {
+		It("Needs an identifier", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Incorrect Usage: This command requires one argument"))
+		})
+		It("Prints the template", func() {
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "1234")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeVSManager.ExportInstanceTemplateArgsForCall(0)).To(Equal(1234))
+			Expect(fakeUI.Outputs()).To(ContainSubstring(`"flavorKeyName": "B1_2X8X25"`))
+			Expect(fakeUI.Outputs()).To(ContainSubstring(`"name": "web"`))
+		})
+		It("Writes a template that loads back", func() {
+			fileName := filepath.Join(GinkgoT().TempDir(), "web.json")
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "1234", "--file", fileName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(ContainSubstring("Virtual server template is exported to: " + fileName + "."))
+			info, err := os.Stat(fileName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+			template, err := managers.LoadInstanceTemplate(fileName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*template.Hostname).To(Equal("web1"))
+			Expect(managers.InstanceTemplateTags(template)).To(Equal([]string{"web"}))
+		})
+		It("Returns the API error", func() {
+			fakeVSManager.ExportInstanceTemplateReturns(datatypes.Virtual_Guest{}, errors.New("Internal Server Error"))
+			err := testhelpers.RunCobraCommand(cliCommand.Command, "1234")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Failed to get virtual server instance: 1234."))
+			Expect(err.Error()).To(ContainSubstring("Internal Server Error"))
+		})
+	})
+})
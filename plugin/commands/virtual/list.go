@@ -16,6 +16,7 @@ type ListCommand struct {
 	*metadata.SoftlayerCommand
 	VirtualServerManager managers.VirtualServerManager
 	Command              *cobra.Command
+	Paging               metadata.Paging
 	Domain               string
 	Hostname             string
 	Datacenter           string
@@ -51,6 +52,7 @@ EXAMPLE:
 			return thisCmd.Run(args)
 		},
 	}
+	metadata.AddPagingFlags(cobraCmd, &thisCmd.Paging)
 	thisCmd.Command = cobraCmd
 	cobraCmd.Flags().StringVarP(&thisCmd.Domain, "domain", "D", "", T("Filter by domain portion of the FQDN"))
 	cobraCmd.Flags().StringVarP(&thisCmd.Hostname, "hostname", "H", "", T("Filter by host portion of the FQDN"))
@@ -113,7 +115,7 @@ func (cmd *ListCommand) Run(args []string) error {
 
 	vms, err := cmd.VirtualServerManager.ListInstances(
 		cmd.Hourly, cmd.Monthly, cmd.Domain, cmd.Hostname, cmd.Datacenter, cmd.PublicIp, cmd.PrivateIp, cmd.Owner,
-		cmd.Cpu, cmd.Memory, cmd.Network, cmd.Order, cmd.Tag, mask,
+		cmd.Cpu, cmd.Memory, cmd.Network, cmd.Order, cmd.Tag, mask, cmd.Paging,
 	)
 
 	if err != nil {
@@ -58,6 +58,30 @@ var _ = Describe("VS list", func() {
 			})
 		})
 
+		Context("VS list with paging flags", func() {
+			It("Passes --limit and --offset to the manager", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--limit", "10", "--offset", "20")
+				Expect(err).NotTo(HaveOccurred())
+				_, _, _, _, _, _, _, _, _, _, _, _, _, _, paging := fakeVSManager.ListInstancesArgsForCall(0)
+				Expect(paging).To(Equal(metadata.Paging{Limit: 10, Offset: 20}))
+			})
+			It("Passes --all to the manager", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--all")
+				Expect(err).NotTo(HaveOccurred())
+				_, _, _, _, _, _, _, _, _, _, _, _, _, _, paging := fakeVSManager.ListInstancesArgsForCall(0)
+				Expect(paging.All).To(BeTrue())
+			})
+			It("return error with a negative --limit", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--limit", "-5")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("The value must be 0 or a positive integer."))
+			})
+			It("return error with --all and --limit", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--all", "--limit", "5")
+				Expect(err).To(HaveOccurred())
+				Expect(fakeVSManager.ListInstancesCallCount()).To(Equal(0))
+			})
+		})
 		Context("VS list with server fails", func() {
 			BeforeEach(func() {
 				fakeVSManager.ListInstancesReturns([]datatypes.Virtual_Guest{}, errors.New("Internal Server Error"))
@@ -0,0 +1,336 @@
+package virtual
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	"gopkg.in/yaml.v3"
+
+	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
+)
+
+// A file of virtual servers for `sl vs create --manifest`, in YAML (or JSON, which is also YAML).
+// The command line options are the defaults for every server, the defaults of the manifest come next,
+// and the options of a server win over both.
+//
+//	defaults:
+//	  domain: example.com
+//	  datacenter: dal13
+//	  os: UBUNTU_LATEST
+//	servers:
+//	  - hostname: web1
+//	    flavor: B1_2X8X25
+//	    tags: [web]
+//	  - hostname: db1
+//	    flavor: B1_4X16X100
+//	    disks: [25, 500]
+//	    vlan-private: 1234
+//	    userfile: db-init.sh
+type Manifest struct {
+	Defaults ManifestServer   `yaml:"defaults"`
+	Servers  []ManifestServer `yaml:"servers"`
+}
+
+// The options of one server in a Manifest, named like the flags of vs create
+type ManifestServer struct {
+	Hostname              string   `yaml:"hostname"`
+	Domain                string   `yaml:"domain"`
+	Datacenter            string   `yaml:"datacenter"`
+	Flavor                string   `yaml:"flavor"`
+	CPU                   int      `yaml:"cpu"`
+	Memory                int      `yaml:"memory"`
+	Os                    string   `yaml:"os"`
+	Image                 int      `yaml:"image"`
+	Billing               string   `yaml:"billing"`
+	Disks                 []int    `yaml:"disks"`
+	Keys                  []int    `yaml:"keys"`
+	Network               int      `yaml:"network"`
+	Private               *bool    `yaml:"private"`
+	VlanPublic            int      `yaml:"vlan-public"`
+	VlanPrivate           int      `yaml:"vlan-private"`
+	SubnetPublic          int      `yaml:"subnet-public"`
+	SubnetPrivate         int      `yaml:"subnet-private"`
+	PublicSecurityGroups  []int    `yaml:"public-security-groups"`
+	PrivateSecurityGroups []int    `yaml:"private-security-groups"`
+	Tags                  []string `yaml:"tags"`
+	Userdata              string   `yaml:"userdata"`
+	// Relative to the directory of the manifest
+	Userfile       string `yaml:"userfile"`
+	PostInstall    string `yaml:"postinstall"`
+	BootMode       string `yaml:"boot-mode"`
+	PlacementGroup int    `yaml:"placement-group-id"`
+}
+
+// Reads and checks the manifest in file
+func LoadManifest(file string) (Manifest, error) {
+	manifest := Manifest{}
+	manifestBytes, err := os.ReadFile(file) // #nosec
+	if err != nil {
+		return manifest, slErrors.NewInvalidUsageError(T("Failed to read {{.FILE}}: {{.ERROR}}", map[string]interface{}{"FILE": file, "ERROR": err.Error()}))
+	}
+	// Strict, so a typo like flavour: is an error instead of a setting that silently isn't used
+	decoder := yaml.NewDecoder(bytes.NewReader(manifestBytes))
+	decoder.KnownFields(true)
+	err = decoder.Decode(&manifest)
+	if err != nil && err != io.EOF {
+		return manifest, slErrors.NewInvalidUsageError(T("Failed to read {{.FILE}}: {{.ERROR}}", map[string]interface{}{"FILE": file, "ERROR": err.Error()}))
+	}
+	if len(manifest.Servers) == 0 {
+		return manifest, slErrors.NewInvalidUsageError(T("{{.FILE}} has no servers.", map[string]interface{}{"FILE": file}))
+	}
+	names := map[string]bool{}
+	for i, server := range manifest.Servers {
+		subs := map[string]interface{}{"FILE": file, "SERVER": i + 1, "NAME": server.Hostname}
+		if server.Hostname == "" {
+			return manifest, slErrors.NewInvalidUsageError(T("Server {{.SERVER}} of {{.FILE}} has no hostname.", subs))
+		}
+		if names[server.Hostname] {
+			return manifest, slErrors.NewInvalidUsageError(T("{{.FILE}} has more than one server named {{.NAME}}.", subs))
+		}
+		names[server.Hostname] = true
+	}
+	return manifest, nil
+}
+
+// Sets the options server has on cmd. An option replaces the ones it can't be used with,
+// so a server with a flavor doesn't keep the --cpu and --memory of the defaults.
+func (server ManifestServer) applyTo(cmd *CreateCommand, dir string) {
+	if server.Hostname != "" {
+		cmd.Hostname = server.Hostname
+	}
+	if server.Domain != "" {
+		cmd.Domain = server.Domain
+	}
+	if server.Datacenter != "" {
+		cmd.Datacenter = server.Datacenter
+	}
+	if server.Flavor != "" {
+		cmd.Flavor = server.Flavor
+		cmd.CPU = 0
+		cmd.Memory = 0
+	}
+	if server.CPU != 0 {
+		cmd.CPU = server.CPU
+		cmd.Flavor = ""
+	}
+	if server.Memory != 0 {
+		cmd.Memory = server.Memory
+		cmd.Flavor = ""
+	}
+	if server.Os != "" {
+		cmd.Os = server.Os
+		cmd.Image = 0
+	}
+	if server.Image != 0 {
+		cmd.Image = server.Image
+		cmd.Os = ""
+	}
+	if server.Billing != "" {
+		cmd.Billing = server.Billing
+	}
+	if len(server.Disks) > 0 {
+		cmd.Disk = server.Disks
+	}
+	if len(server.Keys) > 0 {
+		cmd.Key = server.Keys
+	}
+	if server.Network != 0 {
+		cmd.Network = server.Network
+	}
+	if server.Private != nil {
+		cmd.Private = *server.Private
+	}
+	if server.VlanPublic != 0 {
+		cmd.VlanPublic = server.VlanPublic
+	}
+	if server.VlanPrivate != 0 {
+		cmd.VlanPrivate = server.VlanPrivate
+	}
+	if server.SubnetPublic != 0 {
+		cmd.SubnetPublic = server.SubnetPublic
+	}
+	if server.SubnetPrivate != 0 {
+		cmd.SubnetPrivate = server.SubnetPrivate
+	}
+	if len(server.PublicSecurityGroups) > 0 {
+		cmd.PubSecGroup = server.PublicSecurityGroups
+	}
+	if len(server.PrivateSecurityGroups) > 0 {
+		cmd.PriSecGroup = server.PrivateSecurityGroups
+	}
+	if len(server.Tags) > 0 {
+		cmd.Tag = server.Tags
+	}
+	if server.Userdata != "" {
+		cmd.Userdata = server.Userdata
+		cmd.Userfile = ""
+	}
+	if server.Userfile != "" {
+		cmd.Userfile = server.Userfile
+		if !filepath.IsAbs(cmd.Userfile) {
+			cmd.Userfile = filepath.Join(dir, cmd.Userfile)
+		}
+		cmd.Userdata = ""
+	}
+	if server.PostInstall != "" {
+		cmd.PostInstall = server.PostInstall
+	}
+	if server.BootMode != "" {
+		cmd.BootMode = server.BootMode
+	}
+	if server.PlacementGroup != 0 {
+		cmd.PlacementGroup = server.PlacementGroup
+	}
+}
+
+// Creates every server of the --manifest file with one order, after verifying all of them and showing what they cost.
+// With --output=JSON the verified orders (--test) or the created servers are printed instead of the tables.
+func (cmd *CreateCommand) createFromManifest() error {
+	manifest, err := LoadManifest(cmd.Manifest)
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(cmd.Manifest)
+	virtualGuests := []datatypes.Virtual_Guest{}
+	tags := [][]string{}
+	for _, server := range manifest.Servers {
+		serverCmd := *cmd
+		manifest.Defaults.applyTo(&serverCmd, dir)
+		server.applyTo(&serverCmd, dir)
+		virtualGuest, err := serverCmd.manifestTemplate()
+		if err != nil {
+			return slErrors.New(T("Failed to create the template of {{.NAME}}: {{.ERROR}}",
+				map[string]interface{}{"NAME": server.Hostname, "ERROR": err.Error()}))
+		}
+		virtualGuests = append(virtualGuests, virtualGuest)
+		tags = append(tags, serverCmd.Tag)
+	}
+
+	// Every server is verified before anything is ordered, so one that fails names all the others that fail too
+	orders := []datatypes.Container_Product_Order{}
+	var verifyErrors []error
+	for _, virtualGuest := range virtualGuests {
+		order, err := cmd.VirtualServerManager.VerifyInstanceCreation(virtualGuest)
+		if err != nil {
+			verifyErrors = append(verifyErrors, slErrors.NewAPIError(T("Failed to verify the creation of {{.NAME}}.\n",
+				map[string]interface{}{"NAME": utils.FormatStringPointer(virtualGuest.Hostname)}), err.Error(), 2))
+			continue
+		}
+		orders = append(orders, order)
+	}
+	if len(verifyErrors) == 1 {
+		return verifyErrors[0]
+	}
+	if len(verifyErrors) > 1 {
+		return slErrors.CollapseErrors(verifyErrors)
+	}
+
+	outputJSON := cmd.GetOutputFlag() == metadata.OutputJSON
+	if !outputJSON {
+		table := cmd.UI.Table([]string{T("Hostname"), T("Hourly cost"), T("Monthly cost")})
+		hourlyTotal, monthlyTotal := 0.0, 0.0
+		for i, order := range orders {
+			hourly, monthly := 0.0, 0.0
+			for _, price := range order.Prices {
+				if price.HourlyRecurringFee != nil {
+					hourly = hourly + float64(*price.HourlyRecurringFee)
+				}
+				if price.RecurringFee != nil {
+					monthly = monthly + float64(*price.RecurringFee)
+				}
+			}
+			hourlyTotal = hourlyTotal + hourly
+			monthlyTotal = monthlyTotal + monthly
+			table.Add(utils.FormatStringPointer(virtualGuests[i].Hostname), fmt.Sprintf("%.2f", hourly), fmt.Sprintf("%.2f", monthly))
+		}
+		table.Add(T("Total"), fmt.Sprintf("%.2f", hourlyTotal), fmt.Sprintf("%.2f", monthlyTotal))
+		table.Print()
+	}
+
+	if cmd.Test {
+		if outputJSON {
+			return utils.PrintPrettyJSON(cmd.UI, orders)
+		}
+		cmd.UI.Ok()
+		cmd.UI.Print(T("The order is correct."))
+		return nil
+	}
+	if !cmd.Force {
+		confirm, err := cmd.UI.Confirm(T("This action will create {{.COUNT}} virtual servers and incur charges on your account. Continue?",
+			map[string]interface{}{"COUNT": len(virtualGuests)}))
+		if err != nil {
+			return err
+		}
+		if !confirm {
+			cmd.UI.Print(T("Aborted."))
+			return nil
+		}
+	}
+
+	created, err := cmd.VirtualServerManager.CreateInstances(virtualGuests)
+	if err != nil {
+		return slErrors.NewAPIError(T("Failed to create multi virtual server instances.\n"), err.Error(), 2)
+	}
+	var multiErrors []error
+	for i, vs := range created {
+		if i >= len(tags) || len(tags[i]) == 0 || vs.Id == nil {
+			continue
+		}
+		err := cmd.VirtualServerManager.SetTags(*vs.Id, utils.StringSliceToString(tags[i]))
+		if err != nil {
+			newError := errors.New(T("Failed to update the tag of virtual server instance: {{.VsID}}.\n", map[string]interface{}{"VsID": *vs.Id}) + err.Error())
+			multiErrors = append(multiErrors, newError)
+		}
+	}
+	if outputJSON {
+		err = utils.PrintPrettyJSON(cmd.UI, created)
+		if err != nil {
+			multiErrors = append(multiErrors, err)
+		}
+	} else {
+		cmd.printVirtualGuests(created)
+	}
+	if len(multiErrors) > 0 {
+		return slErrors.CollapseErrors(multiErrors)
+	}
+	return nil
+}
+
+// The creation template of one manifest server, from the options applyTo set on cmd
+func (cmd *CreateCommand) manifestTemplate() (datatypes.Virtual_Guest, error) {
+	params, err := cmd.verifyParams()
+	if err != nil {
+		return datatypes.Virtual_Guest{}, err
+	}
+	if !cmd.CheckRequiredOptions() {
+		return datatypes.Virtual_Guest{}, slErrors.NewInvalidUsageError(T("hostname, domain, datacenter and os or image are required."))
+	}
+	if cmd.Flavor == "" && (cmd.CPU == 0 || cmd.Memory == 0) {
+		return datatypes.Virtual_Guest{}, slErrors.NewInvalidUsageError(T("either [-m|--memory] or [--flavor] is required."))
+	}
+	template, err := cmd.VirtualServerManager.GenerateInstanceCreationTemplate(&datatypes.Virtual_Guest{}, params)
+	if err != nil {
+		return datatypes.Virtual_Guest{}, err
+	}
+	virtualGuest := *template
+	userData := cmd.Userdata
+	if cmd.Userfile != "" {
+		content, err := os.ReadFile(cmd.Userfile) // #nosec
+		if err != nil {
+			return datatypes.Virtual_Guest{}, slErrors.NewInvalidUsageError(T("Failed to read user data from file: {{.File}}.", map[string]interface{}{"File": cmd.Userfile}))
+		}
+		userData = string(content)
+	}
+	if userData != "" {
+		virtualGuest.UserData = []datatypes.Virtual_Guest_Attribute{datatypes.Virtual_Guest_Attribute{Value: &userData}}
+	}
+	return virtualGuest, nil
+}
@@ -118,7 +118,9 @@ func (cmd *MigrateCommand) Run(args []string) error {
 			cmd.UI.Print(T("The virtual server is migrating."))
 			table := cmd.UI.Table([]string{T("id"), T("CreateDate")})
 			table.Add(utils.FormatIntPointer(result.Id), utils.FormatSLTimePointer(result.CreateDate))
-			utils.PrintTable(cmd.UI, table, outputFormat)
+			if err := utils.PrintTable(cmd.UI, table, outputFormat); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -133,7 +135,7 @@ func getMigrationServerList(mask string, filter filter.Filters, cmd *MigrateComm
 	return migrationServerList
 }
 
-func showsServerPendingMigration(vsList []datatypes.Virtual_Guest, cmd *MigrateCommand, typeServer string, outputFormat string) {
+func showsServerPendingMigration(vsList []datatypes.Virtual_Guest, cmd *MigrateCommand, typeServer string, outputFormat string) error {
 	if typeServer == "vs" {
 		table := cmd.UI.Table([]string{T("id"), T("Hostname"), T("domain"), T("datacenter"), T("PendingMigrationFlag")})
 		cmd.UI.Print("Virtual Server Pending Migration")
@@ -142,7 +144,9 @@ func showsServerPendingMigration(vsList []datatypes.Virtual_Guest, cmd *MigrateC
 				utils.FormatStringPointer(vm.Domain), utils.FormatStringPointer(vm.Datacenter.Name),
 				utils.FormatBoolPointer(vm.PendingMigrationFlag))
 		}
-		utils.PrintTable(cmd.UI, table, outputFormat)
+		if err := utils.PrintTable(cmd.UI, table, outputFormat); err != nil {
+			return err
+		}
 		fmt.Println()
 	} else {
 		table := cmd.UI.Table([]string{T("id"), T("Hostname"), T("domain"), T("datacenter"), T("PendingMigrationFlag"),
@@ -154,6 +158,7 @@ func showsServerPendingMigration(vsList []datatypes.Virtual_Guest, cmd *MigrateC
 				utils.FormatBoolPointer(vm.PendingMigrationFlag), utils.FormatStringPointer(vm.DedicatedHost.Name),
 				utils.FormatIntPointer(vm.DedicatedHost.Id))
 		}
-		utils.PrintTable(cmd.UI, table, outputFormat)
+		return utils.PrintTable(cmd.UI, table, outputFormat)
 	}
+	return nil
 }
@@ -2,11 +2,10 @@ package virtual
 
 import (
 	"bytes"
-	"strconv"
 
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
 	"github.com/spf13/cobra"
 
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -33,15 +32,18 @@ func NewMonitoringListCommand(sl *metadata.SoftlayerCommand) (cmd *MonitoringLis
 			return thisCmd.Run(args)
 		},
 	}
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "virtual_guest", func() ([]string, error) {
+		return managers.VirtualGuestCompletions(thisCmd.VirtualServerManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *MonitoringListCommand) Run(args []string) error {
 
-	virtualId, err := strconv.Atoi(args[0])
+	virtualId, err := managers.ResolveVirtualGuestId(cmd.VirtualServerManager, args[0])
 	if err != nil {
-		return slErrors.NewInvalidSoftlayerIdInputError("Virtual server ID")
+		return err
 	}
 
 	outputFormat := cmd.GetOutputFlag()
@@ -52,6 +54,10 @@ func (cmd *MonitoringListCommand) Run(args []string) error {
 		return slErrors.NewAPIError(T("Failed to get virtual server: {{.ID}}.\n", map[string]interface{}{"ID": virtualId}), err.Error(), 2)
 	}
 
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, virtual)
+	}
+
 	table := cmd.UI.Table([]string{T("Name"), T("Value")})
 	table.Add(T("Domain"), utils.FormatStringPointer(virtual.Domain))
 	table.Add(T("Public IP"), utils.FormatStringPointer(virtual.PrimaryIpAddress))
@@ -62,7 +68,7 @@ func (cmd *MonitoringListCommand) Run(args []string) error {
 
 	if monitors := virtual.NetworkMonitors; len(monitors) > 0 {
 		buf := new(bytes.Buffer)
-		monitorTable := terminal.NewTable(buf, []string{T("Id"), T("IpAddress"), T("Status"), T("Type"), T("Notify")})
+		monitorTable := utils.NewTable(buf, []string{T("Id"), T("IpAddress"), T("Status"), T("Type"), T("Notify")})
 		for _, monitor := range monitors {
 			monitorTable.Add(
 				utils.FormatIntPointer(monitor.Id),
@@ -76,6 +82,5 @@ func (cmd *MonitoringListCommand) Run(args []string) error {
 		table.Add("Monitors", buf.String())
 	}
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
@@ -1,10 +1,9 @@
 package virtual
 
 import (
-	"strconv"
-
 	"github.com/spf13/cobra"
 
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -33,14 +32,17 @@ func NewNotifiactionsCommand(sl *metadata.SoftlayerCommand) (cmd *NotifiactionsC
 		},
 	}
 
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "virtual_guest", func() ([]string, error) {
+		return managers.VirtualGuestCompletions(thisCmd.VirtualServerManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *NotifiactionsCommand) Run(args []string) error {
-	virtualServerId, err := strconv.Atoi(args[0])
+	virtualServerId, err := managers.ResolveVirtualGuestId(cmd.VirtualServerManager, args[0])
 	if err != nil {
-		return slErrors.NewInvalidSoftlayerIdInputError("Virtual server ID")
+		return err
 	}
 
 	outputFormat := cmd.GetOutputFlag()
@@ -49,6 +51,9 @@ func (cmd *NotifiactionsCommand) Run(args []string) error {
 	if err != nil {
 		return slErrors.NewAPIError(T("Failed to get User Customer Notifications."), err.Error(), 2)
 	}
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, userCustomers)
+	}
 
 	table := cmd.UI.Table([]string{T("ID"), T("Last Name"), T("First Name"), T("Email"), T("User ID")})
 	for _, userCustomer := range userCustomers {
@@ -61,6 +66,5 @@ func (cmd *NotifiactionsCommand) Run(args []string) error {
 		)
 	}
 
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
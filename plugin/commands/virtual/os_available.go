@@ -46,6 +46,10 @@ func (cmd *OsAvailableCommand) Run(args []string) error {
 		return errors.NewAPIError(T("Failed to list available OS's."), err.Error(), 2)
 	}
 
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, availables)
+	}
+
 	table := cmd.UI.Table([]string{T("Id"), T("KeyName"), T("Description"), T("Hourly"), T("Monthly"), T("Setup")})
 	for _, availableOs := range availables {
 		hourly := "-"
@@ -71,7 +75,9 @@ func (cmd *OsAvailableCommand) Run(args []string) error {
 			setup,
 		)
 	}
-	utils.PrintTable(cmd.UI, table, outputFormat)
+	if err := utils.PrintTable(cmd.UI, table, outputFormat); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -3,11 +3,12 @@ package virtual
 import (
 	"github.com/spf13/cobra"
 
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/cmdutils"
 	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
-	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
 )
 
 type PauseCommand struct {
@@ -15,6 +16,7 @@ type PauseCommand struct {
 	VirtualServerManager managers.VirtualServerManager
 	Command              *cobra.Command
 	Force                bool
+	Selector             cmdutils.ServerSelector
 }
 
 func NewPauseCommand(sl *metadata.SoftlayerCommand) (cmd *PauseCommand) {
@@ -23,24 +25,39 @@ func NewPauseCommand(sl *metadata.SoftlayerCommand) (cmd *PauseCommand) {
 		VirtualServerManager: managers.NewVirtualServerManager(sl.Session),
 	}
 	cobraCmd := &cobra.Command{
-		Use:   "pause " + T("IDENTIFIER"),
+		Use:   "pause " + T("IDENTIFIER") + "...",
 		Short: T("Pause an active virtual server instance"),
-		Args:  metadata.OneArgs,
+		Args:  cmdutils.ServerSelectorArgs(&thisCmd.Selector),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return thisCmd.Run(args)
 		},
 	}
+	cobraCmd.ValidArgsFunction = client.CompleteEveryIdentifier(sl.Session, "virtual_guest", func() ([]string, error) {
+		return managers.VirtualGuestCompletions(thisCmd.VirtualServerManager)
+	})
 	thisCmd.Command = cobraCmd
 	cobraCmd.Flags().BoolVarP(&thisCmd.Force, "force", "f", false, T("Force operation without confirmation"))
+	cmdutils.AddServerSelectorFlags(cobraCmd, &thisCmd.Selector)
 
 	return thisCmd
 }
 
 func (cmd *PauseCommand) Run(args []string) error {
 
-	vsID, err := utils.ResolveVirtualGuestId(args[0])
+	if cmd.Selector.IsBulk(args) {
+		servers, err := selectVirtualServers(cmd.VirtualServerManager, args, cmd.Selector)
+		if err != nil {
+			return err
+		}
+		question := T("This will pause {{.COUNT}} virtual server instances. Continue?", map[string]interface{}{"COUNT": len(servers)})
+		return cmdutils.RunBulk(cmd.SoftlayerCommand, servers, cmd.Force, question, func(id int) error {
+			return cmd.VirtualServerManager.PauseInstance(id)
+		})
+	}
+
+	vsID, err := managers.ResolveVirtualGuestId(cmd.VirtualServerManager, args[0])
 	if err != nil {
-		return slErrors.NewInvalidSoftlayerIdInputError("Virtual server ID")
+		return err
 	}
 
 	subs := map[string]interface{}{"VsId": vsID, "VsID": vsID}
@@ -3,11 +3,12 @@ package virtual
 import (
 	"github.com/spf13/cobra"
 
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/cmdutils"
 	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
-	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
 )
 
 type PowerOffCommand struct {
@@ -17,6 +18,7 @@ type PowerOffCommand struct {
 	Hard                 bool
 	Soft                 bool
 	Force                bool
+	Selector             cmdutils.ServerSelector
 }
 
 func NewPowerOffCommand(sl *metadata.SoftlayerCommand) (cmd *PowerOffCommand) {
@@ -25,30 +27,44 @@ func NewPowerOffCommand(sl *metadata.SoftlayerCommand) (cmd *PowerOffCommand) {
 		VirtualServerManager: managers.NewVirtualServerManager(sl.Session),
 	}
 	cobraCmd := &cobra.Command{
-		Use:   "power-off " + T("IDENTIFIER"),
+		Use:   "power-off " + T("IDENTIFIER") + "...",
 		Short: T("Power off an active virtual server instance"),
-		Args:  metadata.OneArgs,
+		Args:  cmdutils.ServerSelectorArgs(&thisCmd.Selector),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return thisCmd.Run(args)
 		},
 	}
+	cobraCmd.ValidArgsFunction = client.CompleteEveryIdentifier(sl.Session, "virtual_guest", func() ([]string, error) {
+		return managers.VirtualGuestCompletions(thisCmd.VirtualServerManager)
+	})
 	thisCmd.Command = cobraCmd
 	cobraCmd.Flags().BoolVar(&thisCmd.Hard, "hard", false, T("Perform a hard shutdown"))
 	cobraCmd.Flags().BoolVar(&thisCmd.Soft, "soft", false, T("Perform a soft shutdown"))
 	cobraCmd.Flags().BoolVarP(&thisCmd.Force, "force", "f", false, T("Force operation without confirmation"))
+	cmdutils.AddServerSelectorFlags(cobraCmd, &thisCmd.Selector)
 	return thisCmd
 }
 
 func (cmd *PowerOffCommand) Run(args []string) error {
 
-	vsID, err := utils.ResolveVirtualGuestId(args[0])
-	if err != nil {
-		return slErrors.NewInvalidSoftlayerIdInputError("Virtual server ID")
-	}
-
 	if cmd.Hard && cmd.Soft {
 		return slErrors.NewExclusiveFlagsError("--hard", "--soft")
 	}
+	if cmd.Selector.IsBulk(args) {
+		servers, err := selectVirtualServers(cmd.VirtualServerManager, args, cmd.Selector)
+		if err != nil {
+			return err
+		}
+		question := T("This will power off {{.COUNT}} virtual server instances. Continue?", map[string]interface{}{"COUNT": len(servers)})
+		return cmdutils.RunBulk(cmd.SoftlayerCommand, servers, cmd.Force, question, func(id int) error {
+			return cmd.VirtualServerManager.PowerOffInstance(id, cmd.Soft, cmd.Hard)
+		})
+	}
+
+	vsID, err := managers.ResolveVirtualGuestId(cmd.VirtualServerManager, args[0])
+	if err != nil {
+		return err
+	}
 
 	subs := map[string]interface{}{"VsId": vsID, "VsID": vsID}
 	if !cmd.Force {
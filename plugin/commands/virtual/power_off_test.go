@@ -6,7 +6,9 @@ import (
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
 
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/virtual"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
@@ -43,7 +45,7 @@ var _ = Describe("VS poweroff", func() {
 			It("return error", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "abc")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Invalid input for 'Virtual server ID'. It must be a positive integer."))
+				Expect(err.Error()).To(ContainSubstring("Unable to find virtual server instance"))
 			})
 		})
 
@@ -105,5 +107,55 @@ var _ = Describe("VS poweroff", func() {
 				Expect(fakeUI.Outputs()).To(ContainSubstring("Virtual server instance: 1234 was power off."))
 			})
 		})
+		Context("VS poweroff with several servers", func() {
+			It("Powers off every ID after one confirmation", func() {
+				fakeUI.Inputs("Yes")
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "1234", "5678", "1234", "--soft")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeUI.Outputs()).To(ContainSubstring("This will power off 2 virtual server instances. Continue?"))
+				Expect(fakeVSManager.PowerOffInstanceCallCount()).To(Equal(2))
+				ids := []int{}
+				for i := 0; i < 2; i++ {
+					id, soft, hard := fakeVSManager.PowerOffInstanceArgsForCall(i)
+					Expect(soft).To(BeTrue())
+					Expect(hard).To(BeFalse())
+					ids = append(ids, id)
+				}
+				Expect(ids).To(ConsistOf(1234, 5678))
+				Expect(fakeUI.Outputs()).To(MatchRegexp(`5678\s+-\s+Done`))
+			})
+			It("Selects servers with the filters", func() {
+				fakeVSManager.ListInstancesReturns([]datatypes.Virtual_Guest{
+					{Id: sl.Int(11), Hostname: sl.String("web1"), Domain: sl.String("example.com")},
+					{Id: sl.Int(12), Hostname: sl.String("web2"), Domain: sl.String("example.com")},
+				}, nil)
+				fakeVSManager.PowerOffInstanceReturnsOnCall(1, errors.New("Internal Server Error"))
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--tag", "web", "--datacenter", "dal13", "--hostname-pattern", "web*", "-f")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("1 of 2 servers failed."))
+				_, _, _, hostname, datacenter, _, _, _, _, _, _, _, tags, _, _ := fakeVSManager.ListInstancesArgsForCall(0)
+				Expect(hostname).To(Equal("web*"))
+				Expect(datacenter).To(Equal("dal13"))
+				Expect(tags).To(Equal([]string{"web"}))
+				Expect(fakeUI.Outputs()).To(ContainSubstring("web1.example.com"))
+				Expect(fakeUI.Outputs()).To(ContainSubstring("Internal Server Error"))
+			})
+			It("return error when nothing matches", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "--tag", "none", "-f")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("No servers were selected."))
+			})
+			It("return error with identifiers and filters", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "1234", "--tag", "web")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Identifiers and --from-file can't be used with --tag, --datacenter or --hostname-pattern."))
+			})
+			It("return error with a wrong ID", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "1234", "abc")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Unable to find virtual server instance"))
+				Expect(fakeVSManager.PowerOffInstanceCallCount()).To(Equal(0))
+			})
+		})
 	})
 })
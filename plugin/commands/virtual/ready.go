@@ -5,11 +5,10 @@ import (
 
 	"github.com/spf13/cobra"
 
-	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
-	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
 )
 
 type ReadyCommand struct {
@@ -34,15 +33,18 @@ When the transcation is finished the virtual server should be ready for use.`),
 			return thisCmd.Run(args)
 		},
 	}
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "virtual_guest", func() ([]string, error) {
+		return managers.VirtualGuestCompletions(thisCmd.VirtualServerManager)
+	})
 	thisCmd.Command = cobraCmd
 	cobraCmd.Flags().IntVar(&thisCmd.Wait, "wait", 30, T("Wait until the virtual server is finished provisioning for up to X seconds before returning"))
 	return thisCmd
 }
 
 func (cmd *ReadyCommand) Run(args []string) error {
-	vsID, err := utils.ResolveVirtualGuestId(args[0])
+	vsID, err := managers.ResolveVirtualGuestId(cmd.VirtualServerManager, args[0])
 	if err != nil {
-		return slErrors.NewInvalidSoftlayerIdInputError("Virtual server ID")
+		return err
 	}
 
 	until := time.Now().Add(time.Duration(cmd.Wait) * time.Second)
@@ -2,12 +2,16 @@ package virtual_test
 
 import (
 	"errors"
+	"os"
 
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
 
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/virtual"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
@@ -43,7 +47,7 @@ var _ = Describe("VS reboot", func() {
 			It("return error", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "abc")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Invalid input for 'Virtual server ID'. It must be a positive integer."))
+				Expect(err.Error()).To(ContainSubstring("Unable to find virtual server instance"))
 			})
 		})
 
@@ -101,4 +105,22 @@ var _ = Describe("VS reboot", func() {
 			})
 		})
 	})
+	Describe("VS reboot completion", func() {
+		BeforeEach(func() {
+			os.Setenv(client.ENV_SL_CACHE_DIR, GinkgoT().TempDir())
+			fakeVSManager.ListInstancesReturns([]datatypes.Virtual_Guest{
+				{Id: sl.Int(1234), Hostname: sl.String("web1"), Domain: sl.String("example.com")},
+				{Id: sl.Int(5678), Hostname: sl.String("web2"), Domain: sl.String("example.com")},
+			}, nil)
+		})
+		AfterEach(func() {
+			os.Unsetenv(client.ENV_SL_CACHE_DIR)
+		})
+		It("Completes every virtual server that wasn't given yet", func() {
+			completions, _ := cliCommand.Command.ValidArgsFunction(cliCommand.Command, []string{}, "")
+			Expect(completions).To(Equal([]string{"1234\tweb1.example.com", "5678\tweb2.example.com"}))
+			completions, _ = cliCommand.Command.ValidArgsFunction(cliCommand.Command, []string{"5678"}, "")
+			Expect(completions).To(Equal([]string{"1234\tweb1.example.com"}))
+		})
+	})
 })
@@ -3,11 +3,12 @@ package virtual
 import (
 	"github.com/spf13/cobra"
 
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/cmdutils"
 	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
-	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
 )
 
 type ReloadCommand struct {
@@ -18,6 +19,7 @@ type ReloadCommand struct {
 	Image                int
 	Key                  []int
 	Force                bool
+	Wait                 cmdutils.WaitOptions
 }
 
 func NewReloadCommand(sl *metadata.SoftlayerCommand) (cmd *ReloadCommand) {
@@ -40,20 +42,24 @@ EXAMPLE:
 			return thisCmd.Run(args)
 		},
 	}
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "virtual_guest", func() ([]string, error) {
+		return managers.VirtualGuestCompletions(thisCmd.VirtualServerManager)
+	})
 	thisCmd.Command = cobraCmd
 	cobraCmd.Flags().StringVarP(&thisCmd.Postinstall, "postinstall", "i", "", T("Post-install script to download"))
 	cobraCmd.Flags().IntVar(&thisCmd.Image, "image", 0, T("Image ID. The default is to use the current operating system.\nSee: '${COMMAND_NAME} sl image list' for reference"))
 	cobraCmd.Flags().IntSliceVarP(&thisCmd.Key, "key", "k", []int{}, T("The IDs of the SSH keys to add to the root user (multiple occurrence permitted)"))
 	cobraCmd.Flags().BoolVarP(&thisCmd.Force, "force", "f", false, T("Force operation without confirmation"))
+	cmdutils.AddWaitFlags(cobraCmd, &thisCmd.Wait, T("the reload is finished"))
 
 	return thisCmd
 }
 
 func (cmd *ReloadCommand) Run(args []string) error {
 
-	vsID, err := utils.ResolveVirtualGuestId(args[0])
+	vsID, err := managers.ResolveVirtualGuestId(cmd.VirtualServerManager, args[0])
 	if err != nil {
-		return slErrors.NewInvalidSoftlayerIdInputError("Virtual server ID")
+		return err
 	}
 
 	subs := map[string]interface{}{"VsId": vsID, "VsID": vsID, "CommandName": "ibmcloud"}
@@ -68,11 +74,26 @@ func (cmd *ReloadCommand) Run(args []string) error {
 		}
 	}
 
+	lastTransactionId := 0
+	if cmd.Wait.Enabled() {
+		lastTransactionId, err = managers.VirtualGuestLastTransactionId(cmd.VirtualServerManager, vsID)
+		if err != nil {
+			return slErrors.NewAPIError(T("Failed to get virtual server instance: {{.VsID}}.\n", subs), err.Error(), 2)
+		}
+	}
 	err = cmd.VirtualServerManager.ReloadInstance(vsID, cmd.Postinstall, cmd.Key, cmd.Image)
 	if err != nil {
 		return slErrors.NewAPIError(T("Failed to reload virtual server instance: {{.VsID}}.\n", subs), err.Error(), 2)
 	}
 	cmd.UI.Ok()
-	cmd.UI.Print(T("System reloading for virtual server instance: {{.VsId}} is in progress. Run '{{.CommandName}} sl vs ready {{.VsId}}' to check whether it is ready later on.", subs))
+	if !cmd.Wait.Enabled() {
+		cmd.UI.Print(T("System reloading for virtual server instance: {{.VsId}} is in progress. Run '{{.CommandName}} sl vs ready {{.VsId}}' to check whether it is ready later on.", subs))
+		return nil
+	}
+	err = cmdutils.WaitFor(cmd.SoftlayerCommand, cmd.Wait, T("virtual server instance {{.VsId}}", subs), managers.VirtualGuestOperationCheck(cmd.VirtualServerManager, vsID, lastTransactionId))
+	if err != nil {
+		return err
+	}
+	cmd.UI.Print(T("Virtual server instance: {{.VsId}} is ready.", subs))
 	return nil
 }
@@ -2,11 +2,16 @@ package virtual_test
 
 import (
 	"errors"
+	"time"
+
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
 
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/cmdutils"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/virtual"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
@@ -42,7 +47,7 @@ var _ = Describe("VS reload", func() {
 			It("return error", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "abc")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Invalid input for 'Virtual server ID'. It must be a positive integer."))
+				Expect(err.Error()).To(ContainSubstring("Unable to find virtual server instance"))
 			})
 		})
 
@@ -97,5 +102,46 @@ var _ = Describe("VS reload", func() {
 				Expect(fakeUI.Outputs()).To(ContainSubstring("System reloading for virtual server instance: 1234 is in progress."))
 			})
 		})
+		Context("VS reload with --wait", func() {
+			BeforeEach(func() {
+				cmdutils.WaitSleep = func(time.Duration) {}
+				reloading := datatypes.Virtual_Guest{
+					ProvisionDate: &datatypes.Time{},
+					ActiveTransaction: &datatypes.Provisioning_Version1_Transaction{
+						TransactionStatus: &datatypes.Provisioning_Version1_Transaction_Status{Name: sl.String("RELOAD_OS")},
+					},
+				}
+				before := datatypes.Virtual_Guest{
+					ProvisionDate:   &datatypes.Time{},
+					LastTransaction: &datatypes.Provisioning_Version1_Transaction{Id: sl.Int(7)},
+				}
+				after := datatypes.Virtual_Guest{
+					ProvisionDate:   &datatypes.Time{},
+					LastTransaction: &datatypes.Provisioning_Version1_Transaction{Id: sl.Int(8)},
+				}
+				fakeVSManager.GetInstanceReturnsOnCall(0, before, nil)
+				// The reload transaction isn't on the server yet right after the reload
+				fakeVSManager.GetInstanceReturnsOnCall(1, before, nil)
+				fakeVSManager.GetInstanceReturnsOnCall(2, reloading, nil)
+				fakeVSManager.GetInstanceReturnsOnCall(3, after, nil)
+			})
+			AfterEach(func() {
+				cmdutils.WaitSleep = time.Sleep
+			})
+			It("return no error", func() {
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "1234", "-f", "--wait", "600")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeVSManager.GetInstanceCallCount()).To(Equal(4))
+				Expect(fakeUI.Errors()).To(ContainSubstring("Waiting for virtual server instance 1234: Waiting for the operation to start"))
+				Expect(fakeUI.Errors()).To(ContainSubstring("Waiting for virtual server instance 1234: RELOAD_OS"))
+				Expect(fakeUI.Outputs()).To(ContainSubstring("Virtual server instance: 1234 is ready."))
+			})
+			It("return error", func() {
+				fakeVSManager.GetInstanceReturnsOnCall(2, datatypes.Virtual_Guest{}, errors.New("Internal Server Error"))
+				err := testhelpers.RunCobraCommand(cliCommand.Command, "1234", "-f", "--wait", "600")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Internal Server Error"))
+			})
+		})
 	})
 })
@@ -2,12 +2,12 @@ package virtual
 
 import (
 	"fmt"
-	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/softlayer/softlayer-go/datatypes"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -34,15 +34,18 @@ func NewStorageCommand(sl *metadata.SoftlayerCommand) (cmd *StorageCommand) {
 			return thisCmd.Run(args)
 		},
 	}
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "virtual_guest", func() ([]string, error) {
+		return managers.VirtualGuestCompletions(thisCmd.VirtualServerManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *StorageCommand) Run(args []string) error {
 
-	vsID, err := strconv.Atoi(args[0])
+	vsID, err := managers.ResolveVirtualGuestId(cmd.VirtualServerManager, args[0])
 	if err != nil {
-		return slErrors.NewInvalidSoftlayerIdInputError("Virtual server ID")
+		return err
 	}
 
 	outputFormat := cmd.GetOutputFlag()
@@ -73,6 +76,16 @@ func (cmd *StorageCommand) Run(args []string) error {
 		return slErrors.NewAPIError(T("Failed to get the local disks detail for the virtual server {{.ID}}.\n", subs), err.Error(), 2)
 	}
 
+	if outputFormat == "JSON" {
+		return utils.PrintPrettyJSON(cmd.UI, map[string]interface{}{
+			"credentials":     storageCredentials,
+			"iscsi":           iscsiStorageData,
+			"nas":             nasStorageData,
+			"portableStorage": portableStorage,
+			"localDisks":      localDisks,
+		})
+	}
+
 	cmd.UI.Print("Block Storage Details\niSCSI")
 	tableCredentials := cmd.UI.Table([]string{T("Username"), T("Password"), T("IQN")})
 	if storageCredentials.Credential != nil && storageCredentials.Credential.Password != nil {
@@ -81,7 +94,9 @@ func (cmd *StorageCommand) Run(args []string) error {
 			*storageCredentials.Credential.Password,
 			*storageCredentials.Name)
 	}
-	utils.PrintTable(cmd.UI, tableCredentials, outputFormat)
+	if err := utils.PrintTable(cmd.UI, tableCredentials, outputFormat); err != nil {
+		return err
+	}
 
 	tableIscsi := cmd.UI.Table([]string{T("\nLUN name"), T("capacity"), T("Target address"), T("Location"), T("Notes")})
 	for _, iscsi := range iscsiStorageData {
@@ -96,7 +111,9 @@ func (cmd *StorageCommand) Run(args []string) error {
 			*iscsi.AllowedVirtualGuests[0].Datacenter.LongName,
 			notes)
 	}
-	utils.PrintTable(cmd.UI, tableIscsi, outputFormat)
+	if err := utils.PrintTable(cmd.UI, tableIscsi, outputFormat); err != nil {
+		return err
+	}
 
 	cmd.UI.Print("\nPortable Storage")
 	tablePortableStorage := cmd.UI.Table([]string{T("Description"), T("Capacity"), T("Location")})
@@ -106,7 +123,9 @@ func (cmd *StorageCommand) Run(args []string) error {
 			utils.FormatIntPointer(portable.Capacity),
 			*portable.BillingItem.Location.LongName)
 	}
-	utils.PrintTable(cmd.UI, tablePortableStorage, outputFormat)
+	if err := utils.PrintTable(cmd.UI, tablePortableStorage, outputFormat); err != nil {
+		return err
+	}
 
 	cmd.UI.Print("\nFile Storage Details")
 	tableNas := cmd.UI.Table([]string{T("Volume name"), T("capacity"), T("Hostname"), T("Location"), T("Notes")})
@@ -122,7 +141,9 @@ func (cmd *StorageCommand) Run(args []string) error {
 			*nas.AllowedVirtualGuests[0].Datacenter.LongName,
 			notes)
 	}
-	utils.PrintTable(cmd.UI, tableNas, outputFormat)
+	if err := utils.PrintTable(cmd.UI, tableNas, outputFormat); err != nil {
+		return err
+	}
 
 	cmd.UI.Print("\nSystem storage details")
 	tableLocalDisks := cmd.UI.Table([]string{T("Type"), T("Name"), T("Drive"), T("Capacity")})
@@ -133,7 +154,9 @@ func (cmd *StorageCommand) Run(args []string) error {
 			tableLocalDisks.Add(cmd.getLocalType(disk), *disk.MountType, *disk.Device, capacity)
 		}
 	}
-	utils.PrintTable(cmd.UI, tableLocalDisks, outputFormat)
+	if err := utils.PrintTable(cmd.UI, tableLocalDisks, outputFormat); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -6,6 +6,8 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/cmdutils"
 	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -16,6 +18,7 @@ import (
 type UpgradeCommand struct {
 	*metadata.SoftlayerCommand
 	VirtualServerManager managers.VirtualServerManager
+	OrderManager         managers.OrderManager
 	Command              *cobra.Command
 	Cpu                  int
 	Private              bool
@@ -25,12 +28,14 @@ type UpgradeCommand struct {
 	Force                bool
 	AddDisk              int
 	ResizeDisk           string
+	Wait                 cmdutils.WaitOptions
 }
 
 func NewUpgradeCommand(sl *metadata.SoftlayerCommand) (cmd *UpgradeCommand) {
 	thisCmd := &UpgradeCommand{
 		SoftlayerCommand:     sl,
 		VirtualServerManager: managers.NewVirtualServerManager(sl.Session),
+		OrderManager:         managers.NewOrderManager(sl.Session),
 	}
 	cobraCmd := &cobra.Command{
 		Use:   "upgrade " + T("IDENTIFIER"),
@@ -45,6 +50,9 @@ See '${COMMAND_NAME} sl vs options' for flavor keyNames to use.`),
 			return thisCmd.Run(args)
 		},
 	}
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "virtual_guest", func() ([]string, error) {
+		return managers.VirtualGuestCompletions(thisCmd.VirtualServerManager)
+	})
 	thisCmd.Command = cobraCmd
 	cobraCmd.Flags().IntVarP(&thisCmd.Cpu, "cpu", "c", 0, T("Number of CPU cores"))
 	cobraCmd.Flags().BoolVar(&thisCmd.Private, "private", false, T("CPU core will be on a dedicated host server"))
@@ -55,14 +63,15 @@ See '${COMMAND_NAME} sl vs options' for flavor keyNames to use.`),
 	cobraCmd.Flags().BoolVarP(&thisCmd.Force, "force", "f", false, T("Force operation without confirmation"))
 	cobraCmd.Flags().IntVar(&thisCmd.AddDisk, "add-disk", -1, T("Add Hard disk in GB"))
 	cobraCmd.Flags().StringVar(&thisCmd.ResizeDisk, "resize-disk", "", T("Update disk number to size in GB [capacity,diskNumber]. --resize-disk 250,2"))
+	cmdutils.AddWaitFlags(cobraCmd, &thisCmd.Wait, T("the upgrade is finished"))
 	return thisCmd
 }
 
 func (cmd *UpgradeCommand) Run(args []string) error {
 
-	vsID, err := utils.ResolveVirtualGuestId(args[0])
+	vsID, err := managers.ResolveVirtualGuestId(cmd.VirtualServerManager, args[0])
 	if err != nil {
-		return slErrors.NewInvalidSoftlayerIdInputError("Virtual server ID")
+		return err
 	}
 
 	if cmd.Private && cmd.Cpu == 0 {
@@ -106,18 +115,39 @@ func (cmd *UpgradeCommand) Run(args []string) error {
 		}
 		resizeDiskValues = []int{capacity, diskNumber}
 	}
+	lastTransactionId := 0
+	if cmd.Wait.Enabled() {
+		lastTransactionId, err = managers.VirtualGuestLastTransactionId(cmd.VirtualServerManager, vsID)
+		if err != nil {
+			return slErrors.NewAPIError(T("Failed to get virtual server instance: {{.VsID}}.\n", subs), err.Error(), 2)
+		}
+	}
 	orderReceipt, err := cmd.VirtualServerManager.UpgradeInstance(vsID, cmd.Cpu, cmd.Memory, cmd.Network, cmd.AddDisk, resizeDiskValues, cmd.Private, cmd.Flavor)
 	if err != nil {
 		return slErrors.NewAPIError(T("Failed to upgrade virtual server instance: {{.VsID}}.\n", subs), err.Error(), 2)
 	}
 
+	subs["OrderId"] = *orderReceipt.OrderId
+	if outputFormat != "JSON" {
+		cmd.UI.Ok()
+		cmd.UI.Print(T("Order {{.OrderId}} to upgrade virtual server instance: {{.VsId}} was placed.", subs))
+	}
+	if cmd.Wait.Enabled() {
+		// The upgrade transaction only starts once the order is approved
+		check := managers.AllDone(
+			managers.OrderReadyCheck(cmd.OrderManager, *orderReceipt.OrderId),
+			managers.VirtualGuestOperationCheck(cmd.VirtualServerManager, vsID, lastTransactionId),
+		)
+		err = cmdutils.WaitFor(cmd.SoftlayerCommand, cmd.Wait, T("virtual server instance {{.VsId}}", subs), check)
+		if err != nil {
+			return err
+		}
+		if outputFormat != "JSON" {
+			cmd.UI.Print(T("Virtual server instance: {{.VsId}} is ready.", subs))
+		}
+	}
 	if outputFormat == "JSON" {
 		return utils.PrintPrettyJSON(cmd.UI, orderReceipt)
 	}
-
-	cmd.UI.Ok()
-	subs["OrderId"] = *orderReceipt.OrderId
-	cmd.UI.Print(T("Order {{.OrderId}} to upgrade virtual server instance: {{.VsId}} was placed.", subs))
-
 	return nil
 }
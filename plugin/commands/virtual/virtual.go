@@ -35,8 +35,10 @@ func SetupCobraCommands(sl *metadata.SoftlayerCommand) *cobra.Command {
 	cobraCmd.AddCommand(NewCreateOptionsCommand(sl).Command)
 	cobraCmd.AddCommand(NewCredentialsCommand(sl).Command)
 	cobraCmd.AddCommand(NewDetailCommand(sl).Command)
+	cobraCmd.AddCommand(NewDiffCommand(sl).Command)
 	cobraCmd.AddCommand(NewDnsSyncCommand(sl).Command)
 	cobraCmd.AddCommand(NewEditCommand(sl).Command)
+	cobraCmd.AddCommand(NewExportTemplateCommand(sl).Command)
 	cobraCmd.AddCommand(NewListCommand(sl).Command)
 	cobraCmd.AddCommand(NewListHostCommand(sl).Command)
 	cobraCmd.AddCommand(NewMigrateCommand(sl).Command)
@@ -30,8 +30,10 @@ var availableCommands = []string{
 	"create",
 	"credentials",
 	"detail",
+	"diff",
 	"dns-sync",
 	"edit",
+	"export-template",
 	"host-create",
 	"host-list",
 	"list",
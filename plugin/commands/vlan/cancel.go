@@ -1,11 +1,11 @@
 package vlan
 
 import (
-	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -38,14 +38,17 @@ EXAMPLE:
 		},
 	}
 	cobraCmd.Flags().BoolVarP(&thisCmd.Force, "force", "f", false, T("Force operation without confirmation"))
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "vlan", func() ([]string, error) {
+		return managers.VlanCompletions(thisCmd.NetworkManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *CancelCommand) Run(args []string) error {
-	vlanID, err := strconv.Atoi(args[0])
+	vlanID, err := managers.ResolveVlanId(cmd.NetworkManager, args[0])
 	if err != nil {
-		return errors.NewInvalidSoftlayerIdInputError("VLAN ID")
+		return err
 	}
 	if !cmd.Force {
 		confirm, err := cmd.UI.Confirm(T("This will cancel the VLAN: {{.ID}} and cannot be undone. Continue?", map[string]interface{}{"ID": vlanID}))
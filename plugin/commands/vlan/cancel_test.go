@@ -42,7 +42,7 @@ var _ = Describe("VLAN Cancel", func() {
 			It("error resolving vlan ID", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "abc")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Invalid input for 'VLAN ID'. It must be a positive integer."))
+				Expect(err.Error()).To(ContainSubstring("Unable to find VLAN"))
 			})
 		})
 
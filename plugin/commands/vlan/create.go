@@ -43,6 +43,7 @@ EXAMPLE:
 	cobraCmd.Flags().StringVarP(&thisCmd.VlanType, "vlan-type", "t", "", T("The type of the VLAN, either public or private"))
 	cobraCmd.Flags().StringVarP(&thisCmd.Router, "router", "r", "", T("The hostname of the router"))
 	cobraCmd.Flags().StringVarP(&thisCmd.Datacenter, "datacenter", "d", "", T("The short name of the datacenter"))
+	metadata.UseProfileDatacenter(cobraCmd, "datacenter")
 	cobraCmd.Flags().StringVarP(&thisCmd.Name, "name", "n", "", T("The name of the VLAN"))
 	cobraCmd.Flags().BoolVarP(&thisCmd.Force, "force", "f", false, T("Force operation without confirmation"))
 	thisCmd.Command = cobraCmd
@@ -2,10 +2,10 @@ package vlan
 
 import (
 	"bytes"
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
 	"github.com/spf13/cobra"
 
 	"github.com/softlayer/softlayer-go/datatypes"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
@@ -39,14 +39,17 @@ func NewDetailCommand(sl *metadata.SoftlayerCommand) *DetailCommand {
 	}
 	cobraCmd.Flags().BoolVar(&thisCmd.Vs, "no-vs", false, T("Hide virtual server listing"))
 	cobraCmd.Flags().BoolVar(&thisCmd.Hardware, "no-hardware", false, T("Hide hardware listing"))
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "vlan", func() ([]string, error) {
+		return managers.VlanCompletions(thisCmd.NetworkManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *DetailCommand) Run(args []string) error {
-	id, err := utils.ResolveVlanId(args[0])
+	id, err := managers.ResolveVlanId(cmd.NetworkManager, args[0])
 	if err != nil {
-		return errors.NewInvalidSoftlayerIdInputError("VLAN ID")
+		return err
 	}
 
 	outputFormat := cmd.GetOutputFlag()
@@ -81,7 +84,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 		table.Add(T("subnets"), T("none"))
 	} else {
 		buf := new(bytes.Buffer)
-		snTable := terminal.NewTable(buf, []string{T("ID"), T("identifier"), T("netmask"), T("gateway"), T("type"), T("usable_ips")})
+		snTable := utils.NewTable(buf, []string{T("ID"), T("identifier"), T("netmask"), T("gateway"), T("type"), T("usable_ips")})
 		for _, subnet := range subnets {
 			snTable.Add(utils.FormatIntPointer(subnet.Id),
 				utils.FormatStringPointer(subnet.NetworkIdentifier),
@@ -100,7 +103,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 			table.Add(T("virtual servers"), T("none"))
 		} else {
 			buf := new(bytes.Buffer)
-			vsTable := terminal.NewTable(buf, []string{T("Hostname"), T("domain"), T("public_ip"), T("private_ip")})
+			vsTable := utils.NewTable(buf, []string{T("Hostname"), T("domain"), T("public_ip"), T("private_ip")})
 			for _, v := range vs {
 				vsTable.Add(utils.FormatStringPointer(v.Hostname),
 					utils.FormatStringPointer(v.Domain),
@@ -119,7 +122,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 			table.Add(T("hardware"), T("none"))
 		} else {
 			buf := new(bytes.Buffer)
-			hwTable := terminal.NewTable(buf, []string{T("Hostname"), T("domain"), T("public_ip"), T("private_ip")})
+			hwTable := utils.NewTable(buf, []string{T("Hostname"), T("domain"), T("public_ip"), T("private_ip")})
 			for _, h := range hw {
 				hwTable.Add(utils.FormatStringPointer(h.Hostname),
 					utils.FormatStringPointer(h.Domain),
@@ -137,8 +140,7 @@ func (cmd *DetailCommand) Run(args []string) error {
 			table.Add(T("hardware"), buf.String())
 		}
 	}
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
 
 func filter_trunks(trunks []datatypes.Network_Component_Network_Vlan_Trunk) map[int]datatypes.Hardware {
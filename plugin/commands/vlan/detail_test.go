@@ -46,7 +46,7 @@ var _ = Describe("VLAN Detail", func() {
 			It("Error resolving vlan ID", func() {
 				err := testhelpers.RunCobraCommand(cliCommand.Command, "abc")
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("Invalid input for 'VLAN ID'. It must be a positive integer."))
+				Expect(err.Error()).To(ContainSubstring("abc matches more than one VLAN"))
 			})
 		})
 
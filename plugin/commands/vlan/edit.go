@@ -1,11 +1,9 @@
 package vlan
 
 import (
-	"strconv"
-
 	"github.com/spf13/cobra"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
-	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
@@ -37,14 +35,17 @@ EXAMPLE:
 		},
 	}
 	cobraCmd.Flags().StringVarP(&thisCmd.Name, "name", "n", "", T("The name of the VLAN"))
+	cobraCmd.ValidArgsFunction = client.CompleteIdentifiers(sl.Session, "vlan", func() ([]string, error) {
+		return managers.VlanCompletions(thisCmd.NetworkManager)
+	})
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
 
 func (cmd *EditCommand) Run(args []string) error {
-	vlanID, err := strconv.Atoi(args[0])
+	vlanID, err := managers.ResolveVlanId(cmd.NetworkManager, args[0])
 	if err != nil {
-		return slErr.NewInvalidSoftlayerIdInputError("VLAN ID")
+		return err
 	}
 
 	if cmd.Name == "" {
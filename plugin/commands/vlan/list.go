@@ -19,6 +19,7 @@ type ListCommand struct {
 	*metadata.SoftlayerCommand
 	NetworkManager managers.NetworkManager
 	Command        *cobra.Command
+	Paging         metadata.Paging
 	Sortby         string
 	Datacenter     string
 	Number         int
@@ -49,6 +50,7 @@ Note: In field Pod, if add (*) indicated that closed soon`),
 	cobraCmd.Flags().IntVarP(&thisCmd.Number, "number", "n", 0, T("Filter by VLAN number"))
 	cobraCmd.Flags().StringVar(&thisCmd.Name, "name", "", T("Filter by VLAN name"))
 	cobraCmd.Flags().IntVar(&thisCmd.Order, "order", 0, T("Filter by ID of the order that purchased the VLAN"))
+	metadata.AddPagingFlags(cobraCmd, &thisCmd.Paging)
 	thisCmd.Command = cobraCmd
 	return thisCmd
 }
@@ -56,7 +58,7 @@ Note: In field Pod, if add (*) indicated that closed soon`),
 func (cmd *ListCommand) Run(args []string) error {
 	outputFormat := cmd.GetOutputFlag()
 
-	vlans, err := cmd.NetworkManager.ListVlans(cmd.Datacenter, cmd.Number, cmd.Name, cmd.Order, "")
+	vlans, err := cmd.NetworkManager.ListVlans(cmd.Datacenter, cmd.Number, cmd.Name, cmd.Order, "", cmd.Paging)
 	if err != nil {
 		return errors.NewAPIError(T("Failed to list VLANs on your account.\n"), err.Error(), 2)
 	}
@@ -146,8 +148,7 @@ func (cmd *ListCommand) Run(args []string) error {
 			utils.TagRefsToString(vlan.TagReferences),
 		)
 	}
-	utils.PrintTable(cmd.UI, table, outputFormat)
-	return nil
+	return utils.PrintTable(cmd.UI, table, outputFormat)
 }
 
 func getFirewallGateway(vlan datatypes.Network_Vlan) string {
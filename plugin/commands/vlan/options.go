@@ -5,7 +5,6 @@ import (
 
 	"sync"
 
-	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
 	"github.com/spf13/cobra"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
@@ -45,7 +44,7 @@ func (cmd *OptionsCommand) Run(args []string) error {
 	}
 	var datacenternames []string
 	buf := new(bytes.Buffer)
-	dTable := terminal.NewTable(buf, []string{T("datacenter"), T("Hostname")})
+	dTable := utils.NewTable(buf, []string{T("datacenter"), T("Hostname")})
 
 	var wg sync.WaitGroup
 	var mutex sync.Mutex
@@ -62,3 +62,31 @@ func (err *APIError) Error() string {
 	message := err.CliMessage + "\n" + err.APIMessage
 	return message
 }
+
+// ObjectNotFoundError is for an identifier that doesn't match anything on the account
+type ObjectNotFoundError struct {
+	Message string
+}
+
+func NewObjectNotFoundError(message string) *ObjectNotFoundError {
+	return &ObjectNotFoundError{Message: message}
+}
+
+func (err *ObjectNotFoundError) Error() string {
+	return err.Message
+}
+
+// WaitTimeoutError is for a --wait that ran out of time before the operation finished
+type WaitTimeoutError struct {
+	Timeout int
+	Status  string
+}
+
+func NewWaitTimeoutError(timeout int, status string) *WaitTimeoutError {
+	return &WaitTimeoutError{Timeout: timeout, Status: status}
+}
+
+func (err *WaitTimeoutError) Error() string {
+	return T("Stopped waiting after {{.TIMEOUT}} seconds, the operation is still running: {{.STATUS}}",
+		map[string]interface{}{"TIMEOUT": err.Timeout, "STATUS": err.Status})
+}
@@ -0,0 +1,136 @@
+package errors
+
+import (
+	"errors"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/softlayer/softlayer-go/sl"
+
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+)
+
+// Exit codes of the sl commands. Scripts depend on these, so a code never changes meaning.
+const (
+	// Anything that doesn't have its own code
+	EXIT_GENERAL = 1
+	// Wrong arguments or flags
+	EXIT_INVALID_USAGE = 2
+	// SoftLayer_Exception_ObjectNotFound, or an identifier that doesn't match anything
+	EXIT_NOT_FOUND = 3
+	// SoftLayer_Exception_Permission_Denied, bad credentials or an expired login
+	EXIT_PERMISSION_DENIED = 4
+	// The API didn't answer in time, or --wait ran out of time
+	EXIT_TIMEOUT = 5
+	// SoftLayer_Exception_Order_*, the order was not placed
+	EXIT_ORDER_REJECTED = 6
+	// Any other error from the API
+	EXIT_API_ERROR = 7
+)
+
+// The code of each exit code in ErrorDetails
+var exitCodeNames = map[int]string{
+	EXIT_GENERAL:           "error",
+	EXIT_INVALID_USAGE:     "invalid_usage",
+	EXIT_NOT_FOUND:         "not_found",
+	EXIT_PERMISSION_DENIED: "permission_denied",
+	EXIT_TIMEOUT:           "timeout",
+	EXIT_ORDER_REJECTED:    "order_rejected",
+	EXIT_API_ERROR:         "api_error",
+}
+
+// Errors cobra and pflag return for bad arguments or flags, the translated ones from the commands are added in exitCode
+var invalidUsageMessages = []string{
+	"unknown command",
+	"unknown flag",
+	"unknown shorthand flag",
+	"required flag(s)",
+	"invalid argument",
+	"if any flags in the group",
+}
+
+// Most commands only keep the text of an sl.Error (in an APIError), this finds the exception and HTTP status in it again
+var slErrorText = regexp.MustCompile(`(SoftLayer_Exception_\w+): (?s:.*?)\(HTTP (\d+)\)`)
+
+// Client side timeouts, softlayer-go returns these as HTTP 599
+var timeoutMessages = []string{"Client.Timeout exceeded", "context deadline exceeded", "i/o timeout", "(HTTP 599)"}
+
+// What `--output=JSON` prints on stderr for a failed command, as {"error": ErrorDetails}
+type ErrorDetails struct {
+	Code       string `json:"code"`
+	ExitCode   int    `json:"exitCode"`
+	Message    string `json:"message"`
+	Exception  string `json:"exception,omitempty"`
+	HTTPStatus int    `json:"httpStatus,omitempty"`
+}
+
+// Describes err, message is what the user is shown for it (the translated error)
+func GetErrorDetails(err error, message string) ErrorDetails {
+	details := ErrorDetails{ExitCode: EXIT_GENERAL, Message: message}
+	var slError sl.Error
+	if errors.As(err, &slError) {
+		details.Exception = slError.Exception
+		details.HTTPStatus = slError.StatusCode
+	} else if matches := slErrorText.FindStringSubmatch(err.Error()); matches != nil {
+		details.Exception = matches[1]
+		details.HTTPStatus, _ = strconv.Atoi(matches[2])
+	}
+	details.ExitCode = exitCode(err, details.Exception, details.HTTPStatus)
+	details.Code = exitCodeNames[details.ExitCode]
+	return details
+}
+
+// The exit code for err, one of the EXIT_* constants
+func ExitCode(err error) int {
+	return GetErrorDetails(err, err.Error()).ExitCode
+}
+
+func exitCode(err error, exception string, status int) int {
+	var invalidUsage *InvalidUsageError
+	var invalidId *InvalidSoftlayerIdInputError
+	var notFound *ObjectNotFoundError
+	var waitTimeout *WaitTimeoutError
+	switch {
+	case errors.As(err, &invalidUsage), errors.As(err, &invalidId):
+		return EXIT_INVALID_USAGE
+	case errors.As(err, &notFound):
+		return EXIT_NOT_FOUND
+	case errors.As(err, &waitTimeout):
+		return EXIT_TIMEOUT
+	case exception == SL_EXP_OBJ_NOT_FOUND || status == 404:
+		return EXIT_NOT_FOUND
+	case strings.HasPrefix(exception, "SoftLayer_Exception_Permission"),
+		exception == "SoftLayer_Exception_InvalidCredentials",
+		exception == "SoftLayer_Exception_InvalidLegacyToken",
+		status == 401, status == 403:
+		return EXIT_PERMISSION_DENIED
+	case strings.HasPrefix(exception, "SoftLayer_Exception_Order"):
+		return EXIT_ORDER_REJECTED
+	case status == 408, status == 504, status == 599, isTimeout(err):
+		return EXIT_TIMEOUT
+	case exception != "" || status >= 400:
+		return EXIT_API_ERROR
+	}
+	usageMessages := append(invalidUsageMessages, T("Incorrect Usage: "), T("Invalid input for"))
+	for _, usageMessage := range usageMessages {
+		if strings.Contains(err.Error(), usageMessage) {
+			return EXIT_INVALID_USAGE
+		}
+	}
+	return EXIT_GENERAL
+}
+
+func isTimeout(err error) bool {
+	var netError net.Error
+	if errors.As(err, &netError) && netError.Timeout() {
+		return true
+	}
+	for _, timeoutMessage := range timeoutMessages {
+		if strings.Contains(err.Error(), timeoutMessage) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package errors_test
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/sl"
+
+	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+)
+
+type fakeTimeoutError struct{}
+
+func (fakeTimeoutError) Error() string   { return "dial tcp: lookup api.softlayer.com" }
+func (fakeTimeoutError) Timeout() bool   { return true }
+func (fakeTimeoutError) Temporary() bool { return true }
+
+var _ = Describe("Exit codes", func() {
+	notFound := sl.Error{StatusCode: 404, Exception: "SoftLayer_Exception_ObjectNotFound", Message: "Unable to find object with id of '123'."}
+	denied := sl.Error{StatusCode: 500, Exception: "SoftLayer_Exception_Permission_Denied", Message: "You do not have permission."}
+	rejected := sl.Error{StatusCode: 500, Exception: "SoftLayer_Exception_Order_InvalidLocation", Message: "The location provided for this order is invalid."}
+
+	Describe("ExitCode", func() {
+		It("Uses the exception of an sl.Error", func() {
+			Expect(slErr.ExitCode(notFound)).To(Equal(slErr.EXIT_NOT_FOUND))
+			Expect(slErr.ExitCode(denied)).To(Equal(slErr.EXIT_PERMISSION_DENIED))
+			Expect(slErr.ExitCode(rejected)).To(Equal(slErr.EXIT_ORDER_REJECTED))
+			Expect(slErr.ExitCode(sl.Error{StatusCode: 500, Exception: "SoftLayer_Exception_Public", Message: "Oops"})).To(Equal(slErr.EXIT_API_ERROR))
+		})
+		It("Finds the exception in the text of an APIError", func() {
+			err := slErr.NewAPIError("Failed to get virtual server instance: 123.\n", notFound.Error(), 2)
+			Expect(slErr.ExitCode(err)).To(Equal(slErr.EXIT_NOT_FOUND))
+			err = slErr.NewAPIError("Failed to place order.\n", rejected.Error(), 2)
+			Expect(slErr.ExitCode(err)).To(Equal(slErr.EXIT_ORDER_REJECTED))
+		})
+		It("Finds a wrapped sl.Error", func() {
+			err := fmt.Errorf("Failed to reboot: %w", denied)
+			Expect(slErr.ExitCode(err)).To(Equal(slErr.EXIT_PERMISSION_DENIED))
+		})
+		It("Recognizes timeouts", func() {
+			Expect(slErr.ExitCode(sl.Error{StatusCode: 599, Wrapped: fakeTimeoutError{}})).To(Equal(slErr.EXIT_TIMEOUT))
+			Expect(slErr.ExitCode(fakeTimeoutError{})).To(Equal(slErr.EXIT_TIMEOUT))
+			Expect(slErr.ExitCode(errors.New("Get https://api.softlayer.com: net/http: request canceled (Client.Timeout exceeded while awaiting headers)"))).To(Equal(slErr.EXIT_TIMEOUT))
+		})
+		It("Recognizes invalid usage", func() {
+			Expect(slErr.ExitCode(slErr.NewInvalidUsageError("This command requires one argument"))).To(Equal(slErr.EXIT_INVALID_USAGE))
+			Expect(slErr.ExitCode(slErr.NewInvalidSoftlayerIdInputError("Volume ID"))).To(Equal(slErr.EXIT_INVALID_USAGE))
+			Expect(slErr.ExitCode(errors.New(`unknown flag: --bogus`))).To(Equal(slErr.EXIT_INVALID_USAGE))
+			Expect(slErr.ExitCode(errors.New(`invalid argument "x" for "--limit" flag`))).To(Equal(slErr.EXIT_INVALID_USAGE))
+		})
+		It("Recognizes identifiers that match nothing", func() {
+			Expect(slErr.ExitCode(slErr.NewObjectNotFoundError("Unable to find hardware server web1."))).To(Equal(slErr.EXIT_NOT_FOUND))
+		})
+		It("Uses the general exit code for everything else", func() {
+			Expect(slErr.ExitCode(errors.New("2 of 3 servers failed."))).To(Equal(slErr.EXIT_GENERAL))
+		})
+	})
+
+	Describe("GetErrorDetails", func() {
+		It("Describes an API error", func() {
+			err := slErr.NewAPIError("Failed to get virtual server instance: 123.\n", notFound.Error(), 2)
+			details := slErr.GetErrorDetails(err, "translated message")
+			Expect(details).To(Equal(slErr.ErrorDetails{
+				Code:       "not_found",
+				ExitCode:   3,
+				Message:    "translated message",
+				Exception:  "SoftLayer_Exception_ObjectNotFound",
+				HTTPStatus: 404,
+			}))
+		})
+		It("Leaves out the exception of other errors", func() {
+			details := slErr.GetErrorDetails(errors.New("Something broke"), "Something broke")
+			Expect(details.Code).To(Equal("error"))
+			Expect(details.Exception).To(Equal(""))
+			Expect(details.HTTPStatus).To(Equal(0))
+		})
+	})
+})
@@ -20,7 +20,7 @@ type AccountManager interface {
 	GetEventDetail(identifier int, mask string) (datatypes.Notification_Occurrence_Event, error)
 	AckEvent(identifier int) (bool, error)
 	GetInvoiceDetail(identifier int, mask string) ([]datatypes.Billing_Invoice_Item, error)
-	GetInvoices(limit int, closed bool, getAll bool) ([]datatypes.Billing_Invoice, error)
+	GetInvoices(closed bool, paging metadata.Paging) ([]datatypes.Billing_Invoice, error)
 	CancelItem(identifier int) error
 	GetItemDetail(identifier int, mask string) (datatypes.Billing_Item, error)
 	GetItemDetailFromInvoiceItem(identifier int, mask string) (datatypes.Billing_Item, error)
@@ -83,18 +83,10 @@ https://sldn.softlayer.com/reference/services/SoftLayer_Account/getAllTopLevelBi
 */
 func (a accountManager) GetBillingItems(objectMask string, objectFilter string) ([]datatypes.Billing_Item, error) {
 
-	i := 0
-	resourceList := []datatypes.Billing_Item{}
-	for {
-		resp, err := a.AccountService.Mask(objectMask).Filter(objectFilter).Limit(metadata.LIMIT).Offset(i * metadata.LIMIT).GetAllTopLevelBillingItems()
-		i++
-		if err != nil {
-			return []datatypes.Billing_Item{}, err
-		}
-		resourceList = append(resourceList, resp...)
-		if len(resp) < metadata.LIMIT {
-			break
-		}
+	service := a.AccountService.Mask(objectMask).Filter(objectFilter)
+	resourceList, err := ListAllPages[datatypes.Billing_Item](service.Session, "SoftLayer_Account", "getAllTopLevelBillingItems", nil, service.Options)
+	if err != nil {
+		return []datatypes.Billing_Item{}, err
 	}
 	return resourceList, nil
 }
@@ -109,18 +101,10 @@ func (a accountManager) GetInvoiceDetail(identifier int, mask string) ([]datatyp
 	filters := filter.New()
 	filters = append(filters, filter.Path("invoiceTopLevelItems.id").OrderBy("DESC"))
 
-	i := 0
-	resourceList := []datatypes.Billing_Invoice_Item{}
-	for {
-		resp, err := BillingInoviceService.Mask(mask).Filter(filters.Build()).Limit(metadata.LIMIT).Offset(i * metadata.LIMIT).Id(identifier).GetInvoiceTopLevelItems()
-		i++
-		if err != nil {
-			return []datatypes.Billing_Invoice_Item{}, err
-		}
-		resourceList = append(resourceList, resp...)
-		if len(resp) < metadata.LIMIT {
-			break
-		}
+	service := BillingInoviceService.Mask(mask).Filter(filters.Build()).Id(identifier)
+	resourceList, err := ListAllPages[datatypes.Billing_Invoice_Item](service.Session, "SoftLayer_Billing_Invoice", "getInvoiceTopLevelItems", nil, service.Options)
+	if err != nil {
+		return []datatypes.Billing_Invoice_Item{}, err
 	}
 	return resourceList, nil
 }
@@ -181,38 +165,18 @@ func (a accountManager) AckEvent(identifier int) (bool, error) {
 Gets all invoices from the account
 https://sldn.softlayer.com/reference/services/SoftLayer_Account/getInvoices/
 */
-func (a accountManager) GetInvoices(limit int, closed bool, getAll bool) ([]datatypes.Billing_Invoice, error) {
+func (a accountManager) GetInvoices(closed bool, paging metadata.Paging) ([]datatypes.Billing_Invoice, error) {
 	mask := "mask[invoiceTotalAmount, itemCount]"
 	filters := filter.New()
 	filters = append(filters, filter.Path("invoices.id").OrderBy("DESC"))
-	if getAll { // If they want all invoice, included Closed status
+	if paging.All { // If they want all invoice, included Closed status
 		closed = true
 	}
 	if !closed {
 		filters = append(filters, filter.Path("invoices.statusCode").Eq("OPEN"))
 	}
-	resourceList := []datatypes.Billing_Invoice{}
-	if getAll {
-		i := 0
-		for {
-			resp, err := a.AccountService.Mask(mask).Filter(filters.Build()).Limit(metadata.LIMIT).Offset(i * metadata.LIMIT).GetInvoices()
-			i++
-			if err != nil {
-				return []datatypes.Billing_Invoice{}, err
-			}
-			resourceList = append(resourceList, resp...)
-			if len(resp) < metadata.LIMIT {
-				break
-			}
-		}
-	} else {
-		resp, err := a.AccountService.Mask(mask).Filter(filters.Build()).Limit(limit).GetInvoices()
-		if err != nil {
-			return []datatypes.Billing_Invoice{}, err
-		}
-		resourceList = append(resourceList, resp...)
-	}
-	return resourceList, nil
+	service := a.AccountService.Mask(mask).Filter(filters.Build())
+	return ListPages[datatypes.Billing_Invoice](service.Session, "SoftLayer_Account", "getInvoices", nil, service.Options, paging)
 }
 
 /*
@@ -259,18 +223,10 @@ https://sldn.softlayer.com/reference/services/SoftLayer_Account/getActiveVirtual
 func (a accountManager) GetActiveVirtualLicenses(mask string) ([]datatypes.Software_VirtualLicense, error) {
 	filters := filter.New()
 	filters = append(filters, filter.Path("activeVirtualLicenses.id").OrderBy("ASC"))
-	i := 0
-	resourceList := []datatypes.Software_VirtualLicense{}
-	for {
-		resp, err := a.AccountService.Mask(mask).Filter(filters.Build()).Limit(metadata.LIMIT).Offset(i * metadata.LIMIT).GetActiveVirtualLicenses()
-		i++
-		if err != nil {
-			return []datatypes.Software_VirtualLicense{}, err
-		}
-		resourceList = append(resourceList, resp...)
-		if len(resp) < metadata.LIMIT {
-			break
-		}
+	service := a.AccountService.Mask(mask).Filter(filters.Build())
+	resourceList, err := ListAllPages[datatypes.Software_VirtualLicense](service.Session, "SoftLayer_Account", "getActiveVirtualLicenses", nil, service.Options)
+	if err != nil {
+		return []datatypes.Software_VirtualLicense{}, err
 	}
 	return resourceList, nil
 }
@@ -280,18 +236,10 @@ Gets active account software licenses owned by an account
 https://sldn.softlayer.com/reference/services/SoftLayer_Account/getActiveVirtualLicenses/
 */
 func (a accountManager) GetActiveAccountLicenses(mask string) ([]datatypes.Software_AccountLicense, error) {
-	i := 0
-	resourceList := []datatypes.Software_AccountLicense{}
-	for {
-		resp, err := a.AccountService.Mask(mask).Limit(metadata.LIMIT).Offset(i * metadata.LIMIT).GetActiveAccountLicenses()
-		i++
-		if err != nil {
-			return []datatypes.Software_AccountLicense{}, err
-		}
-		resourceList = append(resourceList, resp...)
-		if len(resp) < metadata.LIMIT {
-			break
-		}
+	service := a.AccountService.Mask(mask)
+	resourceList, err := ListAllPages[datatypes.Software_AccountLicense](service.Session, "SoftLayer_Account", "getActiveAccountLicenses", nil, service.Options)
+	if err != nil {
+		return []datatypes.Software_AccountLicense{}, err
 	}
 	return resourceList, nil
 }
@@ -0,0 +1,32 @@
+package managers
+
+import (
+	"github.com/softlayer/softlayer-go/session"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+)
+
+//counterfeiter:generate -o ../testhelpers/ . CacheManager
+type CacheManager interface {
+	ClearCache() (int, error)
+}
+
+type cacheManager struct {
+	Cache *client.ResponseCache
+}
+
+// Uses the response cache of the session, or the default cache directory if the session isn't caching anything.
+func NewCacheManager(session *session.Session) *cacheManager {
+	transport, ok := client.GetCLITransport(session)
+	if ok && transport.Cache != nil {
+		return &cacheManager{Cache: transport.Cache}
+	}
+	return &cacheManager{Cache: client.NewResponseCache(client.GetCacheDir(), 0)}
+}
+
+/*
+Removes every cached API response, returns how many were removed.
+*/
+func (c cacheManager) ClearCache() (int, error) {
+	return c.Cache.Clear()
+}
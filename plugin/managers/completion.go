@@ -0,0 +1,91 @@
+package managers
+
+import (
+	"fmt"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
+)
+
+// Shell completions for the IDENTIFIER of the commands that use the resolvers, see client.CompleteIdentifiers.
+// Each one is "<ID>\t<DESCRIPTION>", the ID always resolves to the same object.
+
+// The ID of every virtual server, with its FQDN as the description
+func VirtualGuestCompletions(manager VirtualServerManager) ([]string, error) {
+	guests, err := manager.ListInstances(false, false, "", "", "", "", "", "", 0, 0, 0, 0, nil, "mask[id,hostname,domain]", metadata.Paging{})
+	if err != nil {
+		return nil, err
+	}
+	completions := []string{}
+	for _, guest := range guests {
+		completions = append(completions, fmt.Sprintf("%d\t%s.%s", utils.IntPointertoInt(guest.Id),
+			utils.StringPointertoString(guest.Hostname), utils.StringPointertoString(guest.Domain)))
+	}
+	return completions, nil
+}
+
+// The ID of every hardware server, with its FQDN as the description
+func HardwareCompletions(manager HardwareServerManager) ([]string, error) {
+	servers, err := manager.ListHardware(nil, 0, 0, "", "", "", 0, "", "", "", 0, "mask[id,hostname,domain]", metadata.Paging{})
+	if err != nil {
+		return nil, err
+	}
+	completions := []string{}
+	for _, server := range servers {
+		completions = append(completions, fmt.Sprintf("%d\t%s.%s", utils.IntPointertoInt(server.Id),
+			utils.StringPointertoString(server.Hostname), utils.StringPointertoString(server.Domain)))
+	}
+	return completions, nil
+}
+
+// The ID of every VLAN, with its DATACENTER:VLAN_NUMBER and name as the description
+func VlanCompletions(manager NetworkManager) ([]string, error) {
+	vlans, err := manager.ListVlans("", 0, "", 0, "mask[id,vlanNumber,name,primaryRouter[datacenter[name]]]", metadata.Paging{})
+	if err != nil {
+		return nil, err
+	}
+	completions := []string{}
+	for _, vlan := range vlans {
+		datacenter := ""
+		if vlan.PrimaryRouter != nil && vlan.PrimaryRouter.Datacenter != nil {
+			datacenter = utils.StringPointertoString(vlan.PrimaryRouter.Datacenter.Name)
+		}
+		description := fmt.Sprintf("%s:%d", datacenter, utils.IntPointertoInt(vlan.VlanNumber))
+		if vlan.Name != nil && *vlan.Name != "" {
+			description = description + " " + *vlan.Name
+		}
+		completions = append(completions, fmt.Sprintf("%d\t%s", utils.IntPointertoInt(vlan.Id), description))
+	}
+	return completions, nil
+}
+
+// The ID of every subnet, with its CIDR as the description
+func SubnetCompletions(manager NetworkManager) ([]string, error) {
+	subnets, err := manager.ListSubnets("", "", 0, "", "", 0, "mask[id,networkIdentifier,cidr]", metadata.Paging{})
+	if err != nil {
+		return nil, err
+	}
+	completions := []string{}
+	for _, subnet := range subnets {
+		completions = append(completions, fmt.Sprintf("%d\t%s/%d", utils.IntPointertoInt(subnet.Id),
+			utils.StringPointertoString(subnet.NetworkIdentifier), utils.IntPointertoInt(subnet.Cidr)))
+	}
+	return completions, nil
+}
+
+// The ID of every global IP, with its IP address as the description
+func GlobalIPCompletions(manager NetworkManager) ([]string, error) {
+	globalIPs, err := manager.ListGlobalIPs(0, 0)
+	if err != nil {
+		return nil, err
+	}
+	completions := []string{}
+	for _, globalIP := range globalIPs {
+		address := ""
+		if globalIP.IpAddress != nil {
+			address = utils.StringPointertoString(globalIP.IpAddress.IpAddress)
+		}
+		completions = append(completions, fmt.Sprintf("%d\t%s", utils.IntPointertoInt(globalIP.Id), address))
+	}
+	return completions, nil
+}
@@ -0,0 +1,68 @@
+package managers_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/sl"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
+)
+
+var _ = Describe("Completions", func() {
+	It("Lists virtual servers", func() {
+		fakeVSManager := new(testhelpers.FakeVirtualServerManager)
+		fakeVSManager.ListInstancesReturns([]datatypes.Virtual_Guest{{Id: sl.Int(1234), Hostname: sl.String("web1"), Domain: sl.String("example.com")}}, nil)
+		completions, err := managers.VirtualGuestCompletions(fakeVSManager)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(completions).To(Equal([]string{"1234\tweb1.example.com"}))
+	})
+	It("Lists hardware servers", func() {
+		fakeHWManager := new(testhelpers.FakeHardwareServerManager)
+		fakeHWManager.ListHardwareReturns([]datatypes.Hardware_Server{
+			{Hardware: datatypes.Hardware{Id: sl.Int(5678), Hostname: sl.String("bare1"), Domain: sl.String("example.com")}},
+		}, nil)
+		completions, err := managers.HardwareCompletions(fakeHWManager)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(completions).To(Equal([]string{"5678\tbare1.example.com"}))
+	})
+	Describe("Network", func() {
+		var fakeNetworkManager *testhelpers.FakeNetworkManager
+		BeforeEach(func() {
+			fakeNetworkManager = new(testhelpers.FakeNetworkManager)
+		})
+		It("Lists VLANs", func() {
+			fakeNetworkManager.ListVlansReturns([]datatypes.Network_Vlan{
+				{Id: sl.Int(1), VlanNumber: sl.Int(1234), Name: sl.String("backend"), PrimaryRouter: &datatypes.Hardware_Router{
+					Hardware_Switch: datatypes.Hardware_Switch{Hardware: datatypes.Hardware{Datacenter: &datatypes.Location{Name: sl.String("dal13")}}},
+				}},
+				{Id: sl.Int(2), VlanNumber: sl.Int(999)},
+			}, nil)
+			completions, err := managers.VlanCompletions(fakeNetworkManager)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(completions).To(Equal([]string{"1\tdal13:1234 backend", "2\t:999"}))
+		})
+		It("Lists subnets", func() {
+			fakeNetworkManager.ListSubnetsReturns([]datatypes.Network_Subnet{{Id: sl.Int(3), NetworkIdentifier: sl.String("10.0.0.0"), Cidr: sl.Int(26)}}, nil)
+			completions, err := managers.SubnetCompletions(fakeNetworkManager)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(completions).To(Equal([]string{"3\t10.0.0.0/26"}))
+		})
+		It("Lists global IPs", func() {
+			fakeNetworkManager.ListGlobalIPsReturns([]datatypes.Network_Subnet_IpAddress_Global{
+				{Id: sl.Int(4), IpAddress: &datatypes.Network_Subnet_IpAddress{IpAddress: sl.String("169.1.1.1")}},
+			}, nil)
+			completions, err := managers.GlobalIPCompletions(fakeNetworkManager)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(completions).To(Equal([]string{"4\t169.1.1.1"}))
+		})
+		It("Returns the error of the list", func() {
+			fakeNetworkManager.ListVlansReturns(nil, errors.New("Internal Server Error"))
+			_, err := managers.VlanCompletions(fakeNetworkManager)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -9,7 +9,6 @@ import (
 	"github.com/softlayer/softlayer-go/session"
 	"github.com/softlayer/softlayer-go/sl"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
-	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
 )
 
@@ -288,18 +287,10 @@ func (d dedicatedhostManager) ListDedicatedHost(name, datacenter, owner string,
 		filters = append(filters, filter.Path("dedicatedHosts.billingItem.orderItem.order.id").Eq(orderId))
 	}
 
-	i := 0
-	resourceList := []datatypes.Virtual_DedicatedHost{}
-	for {
-		resp, err := d.AccountService.Mask(HOST_DEFAULT_MASK).Filter(filters.Build()).Limit(metadata.LIMIT).Offset(i * metadata.LIMIT).GetDedicatedHosts()
-		i++
-		if err != nil {
-			return []datatypes.Virtual_DedicatedHost{}, err
-		}
-		resourceList = append(resourceList, resp...)
-		if len(resp) < metadata.LIMIT {
-			break
-		}
+	service := d.AccountService.Mask(HOST_DEFAULT_MASK).Filter(filters.Build())
+	resourceList, err := ListAllPages[datatypes.Virtual_DedicatedHost](service.Session, "SoftLayer_Account", "getDedicatedHosts", nil, service.Options)
+	if err != nil {
+		return []datatypes.Virtual_DedicatedHost{}, err
 	}
 	return resourceList, nil
 }
@@ -4,10 +4,12 @@ import (
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
 	"github.com/softlayer/softlayer-go/session"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 )
 
 type EventLogManager interface {
-	GetEventLogs(mask string, filter string, limit int) ([]datatypes.Event_Log, error)
+	GetEventLogs(mask string, filter string, paging metadata.Paging) ([]datatypes.Event_Log, error)
 	GetEventLogTypes() ([]string, error)
 }
 
@@ -24,9 +26,10 @@ func NewEventLogManager(session *session.Session) *eventLogManager {
 // Get Event Logs
 // mask: object mask
 // dateFilter: object filter
-// limit: limit of event logs
-func (as eventLogManager) GetEventLogs(mask string, filter string, limit int) ([]datatypes.Event_Log, error) {
-	return as.EventLogService.Limit(limit).Filter(filter).Mask(mask).GetAllObjects()
+// paging: which event logs to get, from the --limit, --offset and --all flags
+func (as eventLogManager) GetEventLogs(mask string, filter string, paging metadata.Paging) ([]datatypes.Event_Log, error) {
+	service := as.EventLogService.Filter(filter).Mask(mask)
+	return ListPages[datatypes.Event_Log](service.Session, "SoftLayer_Event_Log", "getAllObjects", nil, service.Options, paging)
 }
 
 // Get Event Log Types
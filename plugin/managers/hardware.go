@@ -43,7 +43,7 @@ var EXTRA_CATEGORIES = []string{"pri_ipv6_addresses", "static_ipv6_addresses", "
 type HardwareServerManager interface {
 	AuthorizeStorage(id int, storageId string) (bool, error)
 	CancelHardware(hardwareId int, reason string, comment string, immediate bool) error
-	ListHardware(tags []string, cpus int, memory int, hostname string, domain string, datacenter string, nicSpeed int, publicIP string, privateIP string, owner string, orderId int, mask string) ([]datatypes.Hardware_Server, error)
+	ListHardware(tags []string, cpus int, memory int, hostname string, domain string, datacenter string, nicSpeed int, publicIP string, privateIP string, owner string, orderId int, mask string, paging metadata.Paging) ([]datatypes.Hardware_Server, error)
 	GetHardware(hardwareId int, mask string) (datatypes.Hardware_Server, error)
 	GetHardwareFast(hardwareId int) (datatypes.Hardware_Server, error)
 	GetStorageDetails(id int, nasType string) ([]datatypes.Network_Storage, error)
@@ -180,7 +180,8 @@ func (hw hardwareServerManager) CancelHardware(hardwareId int, reason string, co
 // publicIP: filter based on public IP address
 // privateIP: filter based on private IP adress
 // mask: mask to control what properties are returned
-func (hw hardwareServerManager) ListHardware(tags []string, cpus int, memory int, hostname string, domain string, datacenter string, nicSpeed int, publicIP string, privateIP string, owner string, orderId int, mask string) ([]datatypes.Hardware_Server, error) {
+// paging: which of the results to return
+func (hw hardwareServerManager) ListHardware(tags []string, cpus int, memory int, hostname string, domain string, datacenter string, nicSpeed int, publicIP string, privateIP string, owner string, orderId int, mask string, paging metadata.Paging) ([]datatypes.Hardware_Server, error) {
 	if mask == "" {
 		mask = DEFAULT_HARDWARE_MASK
 	}
@@ -225,18 +226,10 @@ func (hw hardwareServerManager) ListHardware(tags []string, cpus int, memory int
 
 	filters = append(filters, filter.Path("hardware.id").OrderBy("DESC"))
 
-	i := 0
-	resourceList := []datatypes.Hardware{}
-	for {
-		resp, err := hw.AccountService.Mask(mask).Filter(filters.Build()).Limit(metadata.LIMIT).Offset(i * metadata.LIMIT).GetHardware()
-		i++
-		if err != nil {
-			return []datatypes.Hardware_Server{}, err
-		}
-		resourceList = append(resourceList, resp...)
-		if len(resp) < metadata.LIMIT {
-			break
-		}
+	service := hw.AccountService.Mask(mask).Filter(filters.Build())
+	resourceList, err := ListPages[datatypes.Hardware](service.Session, "SoftLayer_Account", "getHardware", nil, service.Options, paging)
+	if err != nil {
+		return []datatypes.Hardware_Server{}, err
 	}
 
 	servers := []datatypes.Hardware_Server{}
@@ -274,29 +267,38 @@ func (hw hardwareServerManager) GetHardwareFast(hardwareId int) (datatypes.Hardw
 		return hardware, err
 	}
 	var wg sync.WaitGroup
+	// The goroutines finish in any order, so errors are collected under a lock
+	var errMutex sync.Mutex
+	addError := func(err error) {
+		errMutex.Lock()
+		defer errMutex.Unlock()
+		all_err = errors.Join(all_err, err)
+	}
 
 	wg.Add(10)
 	go func() {
 		defer wg.Done()
+		var err error
 		mask := "id, status, speed, maxSpeed, name, ipmiMacAddress, ipmiIpAddress, macAddress, primaryIpAddress," +
 			"port, primarySubnet[id, netmask, broadcastAddress, networkIdentifier, gateway]," +
 			"uplinkComponent[networkVlanTrunks[networkVlan[networkSpace]]]"
 		hardware.NetworkComponents, err = hw.HardwareService.Id(hardwareId).Mask(mask).GetNetworkComponents()
-		all_err = errors.Join(all_err, err)
+		addError(err)
 
 	}()
 	go func() {
 		defer wg.Done()
+		var err error
 		mask := "id,hardwareComponentModel[hardwareGenericComponentModel[id,hardwareComponentType[keyName]]]"
 		hardware.ActiveComponents, err = hw.HardwareService.Id(hardwareId).Mask(mask).GetActiveComponents()
-		all_err = errors.Join(all_err, err)
+		addError(err)
 
 	}()
 	go func() {
 		defer wg.Done()
 		mask := "mask[softwareLicense[softwareDescription[manufacturer, name, version, referenceCode]],passwords[id,username,password]]"
 		operatingSystem, err := hw.HardwareService.Id(hardwareId).Mask(mask).GetOperatingSystem()
-		all_err = errors.Join(all_err, err)
+		addError(err)
 		if &operatingSystem != nil && err == nil {
 			hardware.OperatingSystem = &operatingSystem
 		}
@@ -307,53 +309,58 @@ func (hw hardwareServerManager) GetHardwareFast(hardwareId int) (datatypes.Hardw
 		mask := "id,nextInvoiceTotalRecurringAmount,nextInvoiceChildren[id,description,categoryCode,nextInvoiceTotalRecurringAmount]," +
 			"orderItem[id,order[id,userRecord[id,username]]]"
 		billingItem, err := hw.HardwareService.Id(hardwareId).Mask(mask).GetBillingItem()
-		all_err = errors.Join(all_err, err)
+		addError(err)
 		if &billingItem != nil && err == nil {
 			hardware.BillingItem = &billingItem
 		}
 	}()
 	go func() {
 		defer wg.Done()
+		var err error
 		mask := "id,tag[name,id]"
 		hardware.TagReferences, err = hw.HardwareService.Id(hardwareId).Mask(mask).GetTagReferences()
-		all_err = errors.Join(all_err, err)
+		addError(err)
 
 	}()
 	go func() {
 		defer wg.Done()
+		var err error
 		mask := "id,vlanNumber,networkSpace,fullyQualifiedName"
 		hardware.NetworkVlans, err = hw.HardwareService.Id(hardwareId).Mask(mask).GetNetworkVlans()
-		all_err = errors.Join(all_err, err)
+		addError(err)
 
 	}()
 	go func() {
 		defer wg.Done()
+		var err error
 		mask := "username,password"
 		hardware.RemoteManagementAccounts, err = hw.HardwareService.Id(hardwareId).Mask(mask).GetRemoteManagementAccounts()
-		all_err = errors.Join(all_err, err)
+		addError(err)
 
 	}()
 	go func() {
 		defer wg.Done()
+		var err error
 		mask := "mask[id,serialNumber,hardwareComponentModel[manufacturer,name,hardwareGenericComponentModel[id,capacity,units]]]"
 		hardware.HardDrives, err = hw.HardwareService.Id(hardwareId).Mask(mask).GetHardDrives()
-		all_err = errors.Join(all_err, err)
+		addError(err)
 	}()
 
 	go func() {
 		defer wg.Done()
 		mask := "mask[allocation[amount]]"
 		bw_detail, err := hw.HardwareService.Id(hardwareId).Mask(mask).GetBandwidthAllotmentDetail()
-		all_err = errors.Join(all_err, err)
+		addError(err)
 		if &bw_detail != nil && err == nil {
 			hardware.BandwidthAllotmentDetail = &bw_detail
 		}
 	}()
 	go func() {
 		defer wg.Done()
+		var err error
 		mask := "mask[amountIn,amountOut,type]"
 		hardware.BillingCycleBandwidthUsage, err = hw.HardwareService.Id(hardwareId).Mask(mask).GetBillingCycleBandwidthUsage()
-		all_err = errors.Join(all_err, err)
+		addError(err)
 	}()
 
 	wg.Wait()
@@ -8,6 +8,7 @@ import (
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
 )
 
@@ -59,7 +60,7 @@ var _ = Describe("HardwareServerManager", func() {
 	Describe("List hardware", func() {
 		Context("List hardware", func() {
 			It("it returns hardware", func() {
-				hws, err := hardwareManager.ListHardware(nil, 0, 0, "", "", "", 0, "", "", "", 0, "")
+				hws, err := hardwareManager.ListHardware(nil, 0, 0, "", "", "", 0, "", "", "", 0, "", metadata.Paging{})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(len(hws)).To(Equal(2))
 				apiCalls := fakeHandler.ApiCallLogs
@@ -71,7 +72,7 @@ var _ = Describe("HardwareServerManager", func() {
 		Context("List Hardware all options", func() {
 			It("Returns a hardware list", func() {
 				hws, err := hardwareManager.ListHardware(
-					[]string{"tag1"}, 1, 2, "hostnametest", "testdomain", "dctest", 10, "1.2.3.4", "5.6.7.8", "testuser", 55, "mask[id]")
+					[]string{"tag1"}, 1, 2, "hostnametest", "testdomain", "dctest", 10, "1.2.3.4", "5.6.7.8", "testuser", 55, "mask[id]", metadata.Paging{})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(len(hws)).To(Equal(2))
 				apiCalls := fakeHandler.ApiCallLogs
@@ -0,0 +1,89 @@
+package managers
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/softlayer/softlayer-go/session"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+)
+
+// Which journal entries ListHistory returns, the zero value is all of them
+type HistoryFilter struct {
+	// Service and Method match when they are part of the name, ignoring case
+	Service string
+	Method  string
+	// Only entries for this object, 0 for any
+	Id int
+	// Entries older than this are skipped
+	Since time.Time
+	// Only the requests that failed
+	FailedOnly bool
+	// Which of the matching entries to return, counted from the newest one
+	Paging metadata.Paging
+}
+
+//counterfeiter:generate -o ../testhelpers/ . HistoryManager
+type HistoryManager interface {
+	ListHistory(filter HistoryFilter) ([]client.JournalEntry, error)
+	JournalFile() string
+}
+
+type historyManager struct {
+	File string
+}
+
+// Reads the journal of the session, or the default journal file if the session isn't writing one.
+func NewHistoryManager(session *session.Session) *historyManager {
+	transport, ok := client.GetCLITransport(session)
+	if ok && transport.Journal != nil {
+		return &historyManager{File: transport.Journal.File}
+	}
+	return &historyManager{File: client.GetJournalFile()}
+}
+
+/*
+Returns the journal entries that match filter, oldest first.
+*/
+func (h historyManager) ListHistory(filter HistoryFilter) ([]client.JournalEntry, error) {
+	entries, err := client.ReadJournal(h.File)
+	if err != nil {
+		return nil, err
+	}
+	// Newest first, so --limit and --offset count back from the last call
+	matches := []client.JournalEntry{}
+	for i := len(entries) - 1; i >= 0; i-- {
+		if filter.Matches(entries[i]) {
+			matches = append(matches, entries[i])
+		}
+	}
+	matches = PageOf(matches, filter.Paging)
+	slices.Reverse(matches)
+	return matches, nil
+}
+
+func (h historyManager) JournalFile() string {
+	return h.File
+}
+
+func (filter HistoryFilter) Matches(entry client.JournalEntry) bool {
+	if filter.Service != "" && !strings.Contains(strings.ToLower(entry.Service), strings.ToLower(filter.Service)) {
+		return false
+	}
+	if filter.Method != "" && !strings.Contains(strings.ToLower(entry.Method), strings.ToLower(filter.Method)) {
+		return false
+	}
+	if filter.Id != 0 && (entry.Id == nil || *entry.Id != filter.Id) {
+		return false
+	}
+	if !filter.Since.IsZero() && entry.Time.Before(filter.Since) {
+		return false
+	}
+	if filter.FailedOnly && entry.Success {
+		return false
+	}
+	return true
+}
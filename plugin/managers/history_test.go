@@ -0,0 +1,83 @@
+package managers_test
+
+import (
+	"errors"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/session"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+)
+
+var _ = Describe("HistoryManager", func() {
+	var (
+		historyManager managers.HistoryManager
+		journal        *client.Journal
+		now            time.Time
+	)
+	BeforeEach(func() {
+		file := filepath.Join(GinkgoT().TempDir(), "journal.jsonl")
+		now = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+		journal = client.NewJournal(file)
+		journal.Now = func() time.Time { return now }
+		transport := &client.CLIRestTransport{RestTransport: &session.RestTransport{}, Journal: journal}
+		historyManager = managers.NewHistoryManager(&session.Session{TransportHandler: transport})
+
+		id := 1234
+		Expect(journal.Record("SoftLayer_Virtual_Guest", "powerOff", &id, nil, nil)).To(Succeed())
+		now = now.Add(time.Hour)
+		Expect(journal.Record("SoftLayer_Hardware_Server", "reloadOperatingSystem", nil, nil, errors.New("failed"))).To(Succeed())
+		now = now.Add(time.Hour)
+		Expect(journal.Record("SoftLayer_Virtual_Guest", "powerOn", &id, nil, nil)).To(Succeed())
+	})
+
+	It("Uses the journal of the session", func() {
+		Expect(historyManager.JournalFile()).To(Equal(journal.File))
+	})
+	It("Lists every entry, oldest first", func() {
+		entries, err := historyManager.ListHistory(managers.HistoryFilter{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(3))
+		Expect(entries[0].Method).To(Equal("powerOff"))
+		Expect(entries[2].Method).To(Equal("powerOn"))
+	})
+	It("Filters by service, method and ID", func() {
+		entries, err := historyManager.ListHistory(managers.HistoryFilter{Service: "virtual_guest", Method: "power", Id: 1234})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(2))
+		entries, err = historyManager.ListHistory(managers.HistoryFilter{Method: "powerOn"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+	})
+	It("Filters by time and outcome", func() {
+		entries, err := historyManager.ListHistory(managers.HistoryFilter{Since: now.Add(-90 * time.Minute)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(2))
+		entries, err = historyManager.ListHistory(managers.HistoryFilter{FailedOnly: true})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].Service).To(Equal("SoftLayer_Hardware_Server"))
+	})
+	It("Keeps the newest entries within Limit", func() {
+		entries, err := historyManager.ListHistory(managers.HistoryFilter{Paging: metadata.Paging{Limit: 2}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(2))
+		Expect(entries[0].Method).To(Equal("reloadOperatingSystem"))
+		Expect(entries[1].Method).To(Equal("powerOn"))
+	})
+	It("Skips the newest entries with Offset", func() {
+		entries, err := historyManager.ListHistory(managers.HistoryFilter{Paging: metadata.Paging{Limit: 1, Offset: 1}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].Method).To(Equal("reloadOperatingSystem"))
+		entries, err = historyManager.ListHistory(managers.HistoryFilter{Paging: metadata.Paging{Offset: 1}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(2))
+		Expect(entries[0].Method).To(Equal("powerOff"))
+	})
+})
@@ -26,8 +26,8 @@ type ImageManager interface {
 	AddLocation(imageId int, locations []datatypes.Location) (bool, error)
 	DeleteLocation(imageId int, locations []datatypes.Location) (bool, error)
 	DeleteImage(imageId int) error
-	ListPrivateImages(name string, mask string) ([]datatypes.Virtual_Guest_Block_Device_Template_Group, error)
-	ListPublicImages(name string, mask string) ([]datatypes.Virtual_Guest_Block_Device_Template_Group, error)
+	ListPrivateImages(name string, mask string, paging metadata.Paging) ([]datatypes.Virtual_Guest_Block_Device_Template_Group, error)
+	ListPublicImages(name string, mask string, paging metadata.Paging) ([]datatypes.Virtual_Guest_Block_Device_Template_Group, error)
 	EditImage(imageId int, name string, note string, tag string) ([]bool, []string)
 	ExportImage(imageId int, config datatypes.Container_Virtual_Guest_Block_Device_Template_Configuration) (bool, error)
 	ImportImage(config datatypes.Container_Virtual_Guest_Block_Device_Template_Configuration) (datatypes.Virtual_Guest_Block_Device_Template_Group, error)
@@ -68,9 +68,10 @@ func (i imageManager) DeleteImage(imageId int) error {
 	return err
 }
 
-// List all public images, fitler by its name
+// List all private images, fitler by its name
 // name: filter based on name
-func (i imageManager) ListPrivateImages(name string, mask string) ([]datatypes.Virtual_Guest_Block_Device_Template_Group, error) {
+// paging: which of the results to return
+func (i imageManager) ListPrivateImages(name string, mask string, paging metadata.Paging) ([]datatypes.Virtual_Guest_Block_Device_Template_Group, error) {
 	filters := filter.New()
 	filters = append(filters, filter.Path("privateBlockDeviceTemplateGroups.id").OrderBy("ASC"))
 
@@ -81,18 +82,10 @@ func (i imageManager) ListPrivateImages(name string, mask string) ([]datatypes.V
 		mask = IMAGE_DEFAULT_MASK
 	}
 
-	n := 0
-	resourceList := []datatypes.Virtual_Guest_Block_Device_Template_Group{}
-	for {
-		resp, err := i.AccountService.Mask(mask).Filter(filters.Build()).Limit(metadata.LIMIT).Offset(n * metadata.LIMIT).GetPrivateBlockDeviceTemplateGroups()
-		n++
-		if err != nil {
-			return []datatypes.Virtual_Guest_Block_Device_Template_Group{}, err
-		}
-		resourceList = append(resourceList, resp...)
-		if len(resp) < metadata.LIMIT {
-			break
-		}
+	service := i.AccountService.Mask(mask).Filter(filters.Build())
+	resourceList, err := ListPages[datatypes.Virtual_Guest_Block_Device_Template_Group](service.Session, "SoftLayer_Account", "getPrivateBlockDeviceTemplateGroups", nil, service.Options, paging)
+	if err != nil {
+		return []datatypes.Virtual_Guest_Block_Device_Template_Group{}, err
 	}
 
 	return resourceList, nil
@@ -100,7 +93,8 @@ func (i imageManager) ListPrivateImages(name string, mask string) ([]datatypes.V
 
 // List all public images,fitler by its name
 // name: filter based on name
-func (i imageManager) ListPublicImages(name string, mask string) ([]datatypes.Virtual_Guest_Block_Device_Template_Group, error) {
+// paging: which of the results to return
+func (i imageManager) ListPublicImages(name string, mask string, paging metadata.Paging) ([]datatypes.Virtual_Guest_Block_Device_Template_Group, error) {
 	filters := filter.New()
 	filters = append(filters, filter.Path("id").OrderBy("ASC"))
 
@@ -111,18 +105,10 @@ func (i imageManager) ListPublicImages(name string, mask string) ([]datatypes.Vi
 		mask = IMAGE_DEFAULT_MASK
 	}
 
-	n := 0
-	resourceList := []datatypes.Virtual_Guest_Block_Device_Template_Group{}
-	for {
-		resp, err := i.ImageService.Mask(IMAGE_DEFAULT_MASK).Filter(filters.Build()).Limit(metadata.LIMIT).Offset(n * metadata.LIMIT).GetPublicImages()
-		n++
-		if err != nil {
-			return []datatypes.Virtual_Guest_Block_Device_Template_Group{}, err
-		}
-		resourceList = append(resourceList, resp...)
-		if len(resp) < metadata.LIMIT {
-			break
-		}
+	service := i.ImageService.Mask(IMAGE_DEFAULT_MASK).Filter(filters.Build())
+	resourceList, err := ListPages[datatypes.Virtual_Guest_Block_Device_Template_Group](service.Session, "SoftLayer_Virtual_Guest_Block_Device_Template_Group", "getPublicImages", nil, service.Options, paging)
+	if err != nil {
+		return []datatypes.Virtual_Guest_Block_Device_Template_Group{}, err
 	}
 
 	return resourceList, nil
@@ -5,6 +5,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/softlayer/softlayer-go/session"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
 )
 
@@ -43,7 +44,7 @@ var _ = Describe("ImageManager", func() {
 
 		Context("List private images under current account", func() {
 			It("It returns a list of private images", func() {
-				images, err := imageManager.ListPrivateImages("", "")
+				images, err := imageManager.ListPrivateImages("", "", metadata.Paging{})
 				Expect(err).ToNot(HaveOccurred())
 				for _, image := range images {
 					Expect(*image.Id).ShouldNot(BeNil())
@@ -64,7 +65,7 @@ var _ = Describe("ImageManager", func() {
 
 		Context("List public images", func() {
 			It("It returns a list of public images", func() {
-				images, err := imageManager.ListPublicImages("", "")
+				images, err := imageManager.ListPublicImages("", "", metadata.Paging{})
 				Expect(err).ToNot(HaveOccurred())
 				for _, image := range images {
 					Expect(*image.Id).ShouldNot(BeNil())
@@ -0,0 +1,163 @@
+package managers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+
+	bmxErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
+)
+
+// The fields of a virtual server template file, which is the JSON of the datatypes.Virtual_Guest given to
+// SoftLayer_Virtual_Guest::createObject. tagReferences isn't used by createObject, vs create sets the tags after the order.
+var InstanceTemplateFields = []string{
+	"blockDeviceTemplateGroup",
+	"blockDevices",
+	"datacenter",
+	"dedicatedAccountHostOnlyFlag",
+	"dedicatedHost",
+	"domain",
+	"hostname",
+	"hourlyBillingFlag",
+	"localDiskFlag",
+	"maxMemory",
+	"networkComponents",
+	"operatingSystemReferenceCode",
+	"placementGroupId",
+	"postInstallScriptUri",
+	"primaryBackendNetworkComponent",
+	"primaryNetworkComponent",
+	"privateNetworkOnlyFlag",
+	"sshKeys",
+	"startCpus",
+	"supplementalCreateObjectOptions",
+	"tagReferences",
+	"transientGuestFlag",
+	"userData",
+}
+
+// Reads a virtual server template file, like the ones from `sl vs export-template` and `sl vs create --export`.
+// Unlike a plain json.Unmarshal, fields that aren't part of a template, values of the wrong type and
+// options that can't be used together are errors.
+func LoadInstanceTemplate(file string) (datatypes.Virtual_Guest, error) {
+	template := datatypes.Virtual_Guest{}
+	data, err := os.ReadFile(file) // #nosec
+	if err != nil {
+		return template, bmxErr.NewInvalidUsageError(T("Failed to read {{.FILE}}: {{.ERROR}}", map[string]interface{}{"FILE": file, "ERROR": err.Error()}))
+	}
+	fields := map[string]json.RawMessage{}
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return template, templateError(file, data, err)
+	}
+	unknown := []string{}
+	for field := range fields {
+		if utils.StringInSlice(field, InstanceTemplateFields) == -1 {
+			unknown = append(unknown, field)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return template, bmxErr.NewInvalidUsageError(T("{{.FILE}} has fields that aren't part of a virtual server template: {{.FIELDS}}. The fields of a template are: {{.VALID}}.",
+			map[string]interface{}{"FILE": file, "FIELDS": strings.Join(unknown, ", "), "VALID": strings.Join(InstanceTemplateFields, ", ")}))
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	err = decoder.Decode(&template)
+	if err != nil {
+		return template, templateError(file, data, err)
+	}
+	if _, err = decoder.Token(); err != io.EOF {
+		return template, bmxErr.NewInvalidUsageError(T("{{.FILE}} has more than one virtual server template.", map[string]interface{}{"FILE": file}))
+	}
+	return template, validateInstanceTemplate(file, template)
+}
+
+// The names of the tags in template
+func InstanceTemplateTags(template datatypes.Virtual_Guest) []string {
+	tags := []string{}
+	for _, reference := range template.TagReferences {
+		if reference.Tag != nil && reference.Tag.Name != nil {
+			tags = append(tags, *reference.Tag.Name)
+		}
+	}
+	return tags
+}
+
+// The tagReferences of a template with these tags
+func InstanceTemplateTagReferences(tags []string) []datatypes.Tag_Reference {
+	references := []datatypes.Tag_Reference{}
+	for _, tag := range tags {
+		name := tag
+		references = append(references, datatypes.Tag_Reference{Tag: &datatypes.Tag{Name: &name}})
+	}
+	return references
+}
+
+func validateInstanceTemplate(file string, template datatypes.Virtual_Guest) error {
+	subs := map[string]interface{}{"FILE": file}
+	flavor := template.SupplementalCreateObjectOptions != nil && template.SupplementalCreateObjectOptions.FlavorKeyName != nil
+	if flavor && (template.StartCpus != nil || template.MaxMemory != nil) {
+		return bmxErr.NewInvalidUsageError(T("{{.FILE}} can't have both supplementalCreateObjectOptions.flavorKeyName and startCpus or maxMemory.", subs))
+	}
+	if template.OperatingSystemReferenceCode != nil && template.BlockDeviceTemplateGroup != nil {
+		return bmxErr.NewInvalidUsageError(T("{{.FILE}} can't have both operatingSystemReferenceCode and blockDeviceTemplateGroup.", subs))
+	}
+	if template.StartCpus != nil && *template.StartCpus <= 0 {
+		subs["FIELD"] = "startCpus"
+		return bmxErr.NewInvalidUsageError(T("{{.FIELD}} in {{.FILE}} must be greater than 0.", subs))
+	}
+	if template.MaxMemory != nil && *template.MaxMemory <= 0 {
+		subs["FIELD"] = "maxMemory"
+		return bmxErr.NewInvalidUsageError(T("{{.FIELD}} in {{.FILE}} must be greater than 0.", subs))
+	}
+	for _, device := range template.BlockDevices {
+		if device.Device == nil || device.DiskImage == nil || device.DiskImage.Capacity == nil {
+			return bmxErr.NewInvalidUsageError(T("Every blockDevices entry in {{.FILE}} needs a device and a diskImage.capacity.", subs))
+		}
+	}
+	return nil
+}
+
+// A clear message for the errors of encoding/json, with the line of the file the error is on
+func templateError(file string, data []byte, err error) error {
+	subs := map[string]interface{}{"FILE": file, "ERROR": err.Error()}
+	var syntaxError *json.SyntaxError
+	var typeError *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxError):
+		subs["LINE"] = lineOf(data, syntaxError.Offset)
+		return bmxErr.NewInvalidUsageError(T("{{.FILE}} is not valid JSON, line {{.LINE}}: {{.ERROR}}", subs))
+	case errors.As(err, &typeError):
+		subs["LINE"] = lineOf(data, typeError.Offset)
+		subs["FIELD"] = typeError.Field
+		subs["TYPE"] = typeError.Type.String()
+		subs["VALUE"] = typeError.Value
+		if typeError.Field == "" {
+			return bmxErr.NewInvalidUsageError(T("{{.FILE}} must have a JSON object, not {{.VALUE}}.", subs))
+		}
+		return bmxErr.NewInvalidUsageError(T("{{.FIELD}} in {{.FILE}} (line {{.LINE}}) must be {{.TYPE}}, not {{.VALUE}}.", subs))
+	case strings.HasPrefix(err.Error(), "json: unknown field "):
+		subs["FIELD"] = strings.TrimPrefix(err.Error(), "json: unknown field ")
+		return bmxErr.NewInvalidUsageError(T("{{.FILE}} has an unknown field {{.FIELD}}.", subs))
+	case err == io.EOF:
+		return bmxErr.NewInvalidUsageError(T("{{.FILE}} is empty.", subs))
+	}
+	return bmxErr.NewInvalidUsageError(T("Failed to read {{.FILE}}: {{.ERROR}}", subs))
+}
+
+// The line number of offset in data
+func lineOf(data []byte, offset int64) int {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+	return bytes.Count(data[:offset], []byte("\n")) + 1
+}
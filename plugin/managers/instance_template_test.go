@@ -0,0 +1,81 @@
+package managers_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+)
+
+var _ = Describe("LoadInstanceTemplate", func() {
+	var templateFile string
+	BeforeEach(func() {
+		templateFile = filepath.Join(GinkgoT().TempDir(), "vs.json")
+	})
+	write := func(content string) {
+		Expect(os.WriteFile(templateFile, []byte(content), 0600)).To(Succeed())
+	}
+
+	It("Reads a template", func() {
+		write(`{
+  "hostname": "web1",
+  "domain": "example.com",
+  "datacenter": {"name": "dal13"},
+  "supplementalCreateObjectOptions": {"flavorKeyName": "B1_2X8X25", "bootMode": "HVM"},
+  "operatingSystemReferenceCode": "UBUNTU_LATEST",
+  "blockDevices": [{"device": "2", "diskImage": {"capacity": 100}}],
+  "primaryBackendNetworkComponent": {"networkVlan": {"id": 1234, "primarySubnet": {"id": 5678}}},
+  "tagReferences": [{"tag": {"name": "web"}}]
+}`)
+		template, err := managers.LoadInstanceTemplate(templateFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*template.Hostname).To(Equal("web1"))
+		Expect(*template.SupplementalCreateObjectOptions.FlavorKeyName).To(Equal("B1_2X8X25"))
+		Expect(*template.PrimaryBackendNetworkComponent.NetworkVlan.PrimarySubnet.Id).To(Equal(5678))
+		Expect(managers.InstanceTemplateTags(template)).To(Equal([]string{"web"}))
+	})
+	It("Refuses fields that aren't part of a template", func() {
+		write(`{"hostname": "web1", "hostnme": "web1", "globalIdentifier": "abc"}`)
+		_, err := managers.LoadInstanceTemplate(templateFile)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("has fields that aren't part of a virtual server template: globalIdentifier, hostnme."))
+	})
+	It("Refuses unknown fields in an object", func() {
+		write(`{"datacenter": {"nme": "dal13"}}`)
+		_, err := managers.LoadInstanceTemplate(templateFile)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`has an unknown field "nme".`))
+	})
+	It("Names the field with the wrong type", func() {
+		write("{\n  \"hostname\": \"web1\",\n  \"startCpus\": \"two\"\n}")
+		_, err := managers.LoadInstanceTemplate(templateFile)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("startCpus in " + templateFile + " (line 3) must be int, not string."))
+	})
+	It("Gives the line of a syntax error", func() {
+		write("{\n  \"hostname\": \"web1\"\n  \"domain\": \"example.com\"\n}")
+		_, err := managers.LoadInstanceTemplate(templateFile)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("is not valid JSON, line 3"))
+	})
+	It("Refuses a flavor with CPU and memory", func() {
+		write(`{"startCpus": 2, "supplementalCreateObjectOptions": {"flavorKeyName": "B1_2X8X25"}}`)
+		_, err := managers.LoadInstanceTemplate(templateFile)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("can't have both supplementalCreateObjectOptions.flavorKeyName and startCpus or maxMemory."))
+	})
+	It("Refuses a template that isn't an object", func() {
+		write(`["web1"]`)
+		_, err := managers.LoadInstanceTemplate(templateFile)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("must have a JSON object, not array."))
+	})
+	It("Returns an error for a missing file", func() {
+		_, err := managers.LoadInstanceTemplate(filepath.Join(filepath.Dir(templateFile), "missing.json"))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Failed to read"))
+	})
+})
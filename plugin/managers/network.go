@@ -37,9 +37,9 @@ type NetworkManager interface {
 	GetSubnet(subnetId int, mask string) (datatypes.Network_Subnet, error)
 	GetVlan(vlanId int, mask string) (datatypes.Network_Vlan, error)
 	IPLookup(ipAddress string) (datatypes.Network_Subnet_IpAddress, error)
-	ListSubnets(identifier string, datacenter string, version int, subnetType string, networkSpace string, order int, mask string) ([]datatypes.Network_Subnet, error)
+	ListSubnets(identifier string, datacenter string, version int, subnetType string, networkSpace string, order int, mask string, paging metadata.Paging) ([]datatypes.Network_Subnet, error)
 	ListGlobalIPs(version int, order int) ([]datatypes.Network_Subnet_IpAddress_Global, error)
-	ListVlans(datacenter string, vlanNum int, name string, order int, mask string) ([]datatypes.Network_Vlan, error)
+	ListVlans(datacenter string, vlanNum int, name string, order int, mask string, paging metadata.Paging) ([]datatypes.Network_Vlan, error)
 	ListDatacenters() (map[int]string, error)
 	ListRouters(dataceterId int, mask string) ([]string, error)
 
@@ -388,7 +388,8 @@ func (n networkManager) IPLookup(ipAddress string) (datatypes.Network_Subnet_IpA
 // subnetType: type of subnet to be filtered
 // networkSpace: vlan space (public or private) to be filtered
 // orderID: ID of order to be filtered
-func (n networkManager) ListSubnets(identifier string, datacenter string, version int, subnetType string, networkSpace string, orderId int, mask string) ([]datatypes.Network_Subnet, error) {
+// paging: which of the results to return
+func (n networkManager) ListSubnets(identifier string, datacenter string, version int, subnetType string, networkSpace string, orderId int, mask string, paging metadata.Paging) ([]datatypes.Network_Subnet, error) {
 	filters := filter.New()
 	filters = append(filters, filter.Path("subnets.id").OrderBy("ASC"))
 	if identifier != "" {
@@ -416,18 +417,10 @@ func (n networkManager) ListSubnets(identifier string, datacenter string, versio
 		subnetMask = mask
 	}
 
-	i := 0
-	resourceList := []datatypes.Network_Subnet{}
-	for {
-		resp, err := n.AccountService.Mask(subnetMask).Filter(filters.Build()).Limit(metadata.LIMIT).Offset(i * metadata.LIMIT).GetSubnets()
-		i++
-		if err != nil {
-			return []datatypes.Network_Subnet{}, err
-		}
-		resourceList = append(resourceList, resp...)
-		if len(resp) < metadata.LIMIT {
-			break
-		}
+	service := n.AccountService.Mask(subnetMask).Filter(filters.Build())
+	resourceList, err := ListPages[datatypes.Network_Subnet](service.Session, "SoftLayer_Account", "getSubnets", nil, service.Options, paging)
+	if err != nil {
+		return []datatypes.Network_Subnet{}, err
 	}
 	return resourceList, nil
 }
@@ -459,7 +452,8 @@ destinationIpAddress[ipAddress,virtualGuest.fullyQualifiedDomainName,hardware.fu
 // vlanNum: number of vlan to be filtered
 // name: name of vlan to be filtered
 // orderId: ID of order to be filtered
-func (n networkManager) ListVlans(datacenter string, vlanNum int, name string, orderId int, mask string) ([]datatypes.Network_Vlan, error) {
+// paging: which of the results to return
+func (n networkManager) ListVlans(datacenter string, vlanNum int, name string, orderId int, mask string, paging metadata.Paging) ([]datatypes.Network_Vlan, error) {
 	DEFAULT_VLAN_MASK := `mask[
 id, vlanNumber, fullyQualifiedName, name, networkSpace, datacenter[name], podName,
 firewallInterfaces, billingItem[id], tagReferences[tag[name]],
@@ -486,18 +480,10 @@ attachedNetworkGateway[id,name]
 		mask = DEFAULT_VLAN_MASK
 	}
 
-	i := 0
-	resourceList := []datatypes.Network_Vlan{}
-	for {
-		resp, err := n.AccountService.Mask(mask).Filter(filters.Build()).Limit(metadata.LIMIT).Offset(i * metadata.LIMIT).GetNetworkVlans()
-		i++
-		if err != nil {
-			return []datatypes.Network_Vlan{}, err
-		}
-		resourceList = append(resourceList, resp...)
-		if len(resp) < metadata.LIMIT {
-			break
-		}
+	service := n.AccountService.Mask(mask).Filter(filters.Build())
+	resourceList, err := ListPages[datatypes.Network_Vlan](service.Session, "SoftLayer_Account", "getNetworkVlans", nil, service.Options, paging)
+	if err != nil {
+		return []datatypes.Network_Vlan{}, err
 	}
 	return resourceList, nil
 }
@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/softlayer/softlayer-go/session"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
 )
 
@@ -44,7 +45,7 @@ var _ = Describe("NetworkManager", func() {
 	Describe("Get all vlans", func() {
 		Context("Get all vlans under current account", func() {
 			It("It returns a list of vlans", func() {
-				vlans, err := networkManager.ListVlans("", 0, "", 0, "")
+				vlans, err := networkManager.ListVlans("", 0, "", 0, "", metadata.Paging{})
 				Expect(err).ToNot(HaveOccurred())
 				for _, vlan := range vlans {
 					Expect(*vlan.Id).ShouldNot(BeNil())
@@ -60,7 +61,7 @@ var _ = Describe("NetworkManager", func() {
 	Describe("Get all subnets", func() {
 		Context("Get all subnets under current account", func() {
 			It("It returns a list of subnets", func() {
-				subnets, err := networkManager.ListSubnets("", "", 0, "", "", 0, "")
+				subnets, err := networkManager.ListSubnets("", "", 0, "", "", 0, "", metadata.Paging{})
 				Expect(err).ToNot(HaveOccurred())
 				for _, subnet := range subnets {
 					Expect(*subnet.Id).ShouldNot(BeNil())
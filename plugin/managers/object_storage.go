@@ -7,7 +7,6 @@ import (
 	"github.com/softlayer/softlayer-go/filter"
 	"github.com/softlayer/softlayer-go/services"
 	"github.com/softlayer/softlayer-go/session"
-	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 )
 
 //counterfeiter:generate -o ../testhelpers/ . ObjectStorageManager
@@ -47,18 +46,10 @@ func (a objectStorageManager) GetAccounts(mask string) ([]datatypes.Network_Stor
 	filters := filter.New()
 	filters = append(filters, filter.Path("id").OrderBy("ASC"))
 
-	i := 0
-	resourceList := []datatypes.Network_Storage{}
-	for {
-		resp, err := AccountService.Mask(mask).Filter(filters.Build()).Limit(metadata.LIMIT).Offset(i * metadata.LIMIT).GetHubNetworkStorage()
-		i++
-		if err != nil {
-			return []datatypes.Network_Storage{}, err
-		}
-		resourceList = append(resourceList, resp...)
-		if len(resp) < metadata.LIMIT {
-			break
-		}
+	service := AccountService.Mask(mask).Filter(filters.Build())
+	resourceList, err := ListAllPages[datatypes.Network_Storage](service.Session, "SoftLayer_Account", "getHubNetworkStorage", nil, service.Options)
+	if err != nil {
+		return []datatypes.Network_Storage{}, err
 	}
 	return resourceList, nil
 }
@@ -0,0 +1,173 @@
+package managers
+
+import (
+	"sync"
+
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+)
+
+// How many pages ListAllPages requests at the same time
+var PaginatorWorkers = 5
+
+// Returns every result of a list method, requesting metadata.LIMIT results at a time.
+// The first page tells how many results there are, the rest of the pages are then requested by
+// PaginatorWorkers at once. Results are kept in the order the API returns them, and the first error stops everything.
+// options has the mask, filter and id for the request, usually from the Options of a service, like
+//
+//	service := hw.AccountService.Mask(mask).Filter(filters.Build())
+//	hardware, err := ListAllPages[datatypes.Hardware](service.Session, "SoftLayer_Account", "getHardware", nil, service.Options)
+func ListAllPages[T any](sess session.SLSession, service string, method string, args []interface{}, options sl.Options) ([]T, error) {
+	return listPages[T](sess, service, method, args, options, 0, 0)
+}
+
+// Like ListAllPages, but only returns the results paging asks for (from the --limit, --offset and --all flags)
+func ListPages[T any](sess session.SLSession, service string, method string, args []interface{}, options sl.Options, paging metadata.Paging) ([]T, error) {
+	if paging.All {
+		return ListAllPages[T](sess, service, method, args, options)
+	}
+	return listPages[T](sess, service, method, args, options, paging.Offset, paging.Limit)
+}
+
+// Returns paging's part of results, for lists that can't be paged by the API
+func PageOf[T any](results []T, paging metadata.Paging) []T {
+	if paging.All {
+		return results
+	}
+	if paging.Offset >= len(results) {
+		return []T{}
+	}
+	results = results[paging.Offset:]
+	if paging.Limit > 0 && paging.Limit < len(results) {
+		results = results[:paging.Limit]
+	}
+	return results
+}
+
+// Returns count results starting at start, 0 for count means all of them
+func listPages[T any](sess session.SLSession, service string, method string, args []interface{}, options sl.Options, start int, count int) ([]T, error) {
+	pageSize := metadata.LIMIT
+	if count > 0 && count < pageSize {
+		pageSize = count
+	}
+	firstOptions := pageOptions(options, pageSize, start)
+	first := []T{}
+	err := sess.DoRequest(service, method, args, &firstOptions, &first)
+	if err != nil {
+		return []T{}, err
+	}
+	if len(first) < pageSize || len(first) == count {
+		return first, nil
+	}
+	// Without a total (the XML-RPC transport doesn't get one) pages have to be requested one after another
+	if firstOptions.TotalItems == 0 {
+		return listPagesInSeries(sess, service, method, args, options, start, count, first)
+	}
+	end := firstOptions.TotalItems
+	if count > 0 {
+		end = min(end, start+count)
+	}
+	pageCount := max((end-start+pageSize-1)/pageSize, 1)
+	pages := make([][]T, pageCount)
+	pages[0] = first
+
+	var (
+		waitGroup sync.WaitGroup
+		mutex     sync.Mutex
+		firstErr  error
+	)
+	pageNumbers := make(chan int)
+	workers := min(PaginatorWorkers, pageCount-1)
+	for w := 0; w < workers; w++ {
+		waitGroup.Add(1)
+		go func(workerSess session.SLSession) {
+			defer waitGroup.Done()
+			for page := range pageNumbers {
+				offset := start + page*pageSize
+				pageOpts := pageOptions(options, pageLimit(pageSize, offset, start, count), offset)
+				result := []T{}
+				err := workerSess.DoRequest(service, method, args, &pageOpts, &result)
+				mutex.Lock()
+				if err != nil && firstErr == nil {
+					firstErr = err
+				}
+				pages[page] = result
+				mutex.Unlock()
+			}
+		}(workerSession(sess))
+	}
+	for page := 1; page < pageCount; page++ {
+		mutex.Lock()
+		failed := firstErr != nil
+		mutex.Unlock()
+		if failed {
+			break
+		}
+		pageNumbers <- page
+	}
+	close(pageNumbers)
+	waitGroup.Wait()
+	if firstErr != nil {
+		return []T{}, firstErr
+	}
+
+	results := []T{}
+	for _, page := range pages {
+		results = append(results, page...)
+	}
+	// Anything created since the first page was requested is on pages after the ones we know about
+	lastPage := pageCount - 1
+	lastLimit := pageLimit(pageSize, start+lastPage*pageSize, start, count)
+	if len(pages[lastPage]) == lastLimit && (count == 0 || len(results) < count) {
+		return listPagesInSeries(sess, service, method, args, options, start, count, results)
+	}
+	return results, nil
+}
+
+// Requests the pages after the ones in results, one at a time, until a page isn't full or there are count results
+func listPagesInSeries[T any](sess session.SLSession, service string, method string, args []interface{}, options sl.Options, start int, count int, results []T) ([]T, error) {
+	for count == 0 || len(results) < count {
+		pageSize := metadata.LIMIT
+		if count > 0 {
+			pageSize = min(pageSize, count-len(results))
+		}
+		pageOpts := pageOptions(options, pageSize, start+len(results))
+		page := []T{}
+		err := sess.DoRequest(service, method, args, &pageOpts, &page)
+		if err != nil {
+			return []T{}, err
+		}
+		results = append(results, page...)
+		if len(page) < pageSize {
+			break
+		}
+	}
+	return results, nil
+}
+
+// How many results to ask for on the page at offset, so no more than count are requested
+func pageLimit(pageSize int, offset int, start int, count int) int {
+	if count == 0 {
+		return pageSize
+	}
+	return min(pageSize, start+count-offset)
+}
+
+func pageOptions(options sl.Options, limit int, offset int) sl.Options {
+	options.TotalItems = 0
+	options.SetLimit(limit)
+	options.SetOffset(offset)
+	return options
+}
+
+// session.Session.DoRequest saves the LastCall, so every worker gets its own copy of the session
+func workerSession(sess session.SLSession) session.SLSession {
+	realSession, ok := sess.(*session.Session)
+	if !ok {
+		return sess
+	}
+	sessionCopy := *realSession
+	return &sessionCopy
+}
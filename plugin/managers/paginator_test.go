@@ -0,0 +1,200 @@
+package managers_test
+
+import (
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+)
+
+// Pages through Total hardware ids, the same way the REST API does
+type pagingHandler struct {
+	Total     int
+	SendTotal bool
+	FailAt    int
+	mutex     sync.Mutex
+	Offsets   []int
+	Masks     []string
+}
+
+func (h *pagingHandler) DoRequest(sess *session.Session, service string, method string, args []interface{}, options *sl.Options, pResult interface{}) error {
+	h.mutex.Lock()
+	h.Offsets = append(h.Offsets, *options.Offset)
+	h.Masks = append(h.Masks, options.Mask)
+	h.mutex.Unlock()
+	if h.FailAt > 0 && *options.Offset == h.FailAt {
+		return sl.Error{StatusCode: 500, Exception: "SoftLayer_Exception_Public", Message: "Page failed"}
+	}
+	result := pResult.(*[]datatypes.Hardware)
+	for id := *options.Offset; id < h.Total && id < *options.Offset+*options.Limit; id++ {
+		*result = append(*result, datatypes.Hardware{Id: sl.Int(id)})
+	}
+	if h.SendTotal {
+		options.SetTotalItems(h.Total)
+	}
+	return nil
+}
+
+var _ = Describe("ListAllPages", func() {
+	var (
+		handler *pagingHandler
+		sess    *session.Session
+		options sl.Options
+	)
+	BeforeEach(func() {
+		handler = &pagingHandler{Total: metadata.LIMIT*7 + 3, SendTotal: true}
+		sess = &session.Session{TransportHandler: handler}
+		options = sl.Options{Mask: "mask[id]"}
+	})
+	It("Gets every page in order", func() {
+		result, err := managers.ListAllPages[datatypes.Hardware](sess, "SoftLayer_Account", "getHardware", nil, options)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hardwareIds(result)).To(Equal(idRange(0, handler.Total)))
+		Expect(handler.Offsets).To(HaveLen(8))
+		Expect(handler.Offsets[0]).To(Equal(0))
+		Expect(handler.Masks).To(HaveEach("mask[id]"))
+	})
+	It("Stops after one page when there aren't more", func() {
+		handler.Total = 3
+		result, err := managers.ListAllPages[datatypes.Hardware](sess, "SoftLayer_Account", "getHardware", nil, options)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hardwareIds(result)).To(Equal([]int{0, 1, 2}))
+		Expect(handler.Offsets).To(Equal([]int{0}))
+	})
+	It("Requests pages one at a time without a total", func() {
+		handler.SendTotal = false
+		result, err := managers.ListAllPages[datatypes.Hardware](sess, "SoftLayer_Account", "getHardware", nil, options)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hardwareIds(result)).To(Equal(idRange(0, handler.Total)))
+		Expect(handler.Offsets).To(Equal([]int{0, 50, 100, 150, 200, 250, 300, 350}))
+	})
+	It("Keeps going when there are more results than the first page said", func() {
+		handler.Total = metadata.LIMIT * 4
+		sess.TransportHandler = &growingHandler{pagingHandler: handler, FirstTotal: metadata.LIMIT * 2}
+		result, err := managers.ListAllPages[datatypes.Hardware](sess, "SoftLayer_Account", "getHardware", nil, options)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hardwareIds(result)).To(Equal(idRange(0, handler.Total)))
+	})
+	It("Returns the first error", func() {
+		handler.FailAt = metadata.LIMIT * 3
+		result, err := managers.ListAllPages[datatypes.Hardware](sess, "SoftLayer_Account", "getHardware", nil, options)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Page failed"))
+		Expect(result).To(BeEmpty())
+	})
+	It("Returns an error from the first page", func() {
+		sess.TransportHandler = &failingHandler{}
+		_, err := managers.ListAllPages[datatypes.Hardware](sess, "SoftLayer_Account", "getHardware", nil, options)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("ListPages", func() {
+	var (
+		handler *pagingHandler
+		sess    *session.Session
+		options sl.Options
+	)
+	BeforeEach(func() {
+		handler = &pagingHandler{Total: metadata.LIMIT*7 + 3, SendTotal: true}
+		sess = &session.Session{TransportHandler: handler}
+		options = sl.Options{Mask: "mask[id]"}
+	})
+	It("Gets every result without paging", func() {
+		result, err := managers.ListPages[datatypes.Hardware](sess, "SoftLayer_Account", "getHardware", nil, options, metadata.Paging{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hardwareIds(result)).To(Equal(idRange(0, handler.Total)))
+	})
+	It("Gets every result with --all", func() {
+		result, err := managers.ListPages[datatypes.Hardware](sess, "SoftLayer_Account", "getHardware", nil, options, metadata.Paging{All: true})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hardwareIds(result)).To(Equal(idRange(0, handler.Total)))
+	})
+	It("Gets a page smaller than LIMIT with one request", func() {
+		result, err := managers.ListPages[datatypes.Hardware](sess, "SoftLayer_Account", "getHardware", nil, options, metadata.Paging{Limit: 10, Offset: 20})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hardwareIds(result)).To(Equal(idRange(20, 30)))
+		Expect(handler.Offsets).To(Equal([]int{20}))
+	})
+	It("Gets a page bigger than LIMIT", func() {
+		result, err := managers.ListPages[datatypes.Hardware](sess, "SoftLayer_Account", "getHardware", nil, options, metadata.Paging{Limit: 120, Offset: 5})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hardwareIds(result)).To(Equal(idRange(5, 125)))
+		Expect(handler.Offsets).To(ConsistOf(5, 55, 105))
+	})
+	It("Gets a page bigger than LIMIT without a total", func() {
+		handler.SendTotal = false
+		result, err := managers.ListPages[datatypes.Hardware](sess, "SoftLayer_Account", "getHardware", nil, options, metadata.Paging{Limit: 120, Offset: 5})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hardwareIds(result)).To(Equal(idRange(5, 125)))
+		Expect(handler.Offsets).To(Equal([]int{5, 55, 105}))
+	})
+	It("Gets the results after the offset", func() {
+		result, err := managers.ListPages[datatypes.Hardware](sess, "SoftLayer_Account", "getHardware", nil, options, metadata.Paging{Offset: 300})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hardwareIds(result)).To(Equal(idRange(300, handler.Total)))
+	})
+	It("Gets nothing past the last result", func() {
+		result, err := managers.ListPages[datatypes.Hardware](sess, "SoftLayer_Account", "getHardware", nil, options, metadata.Paging{Limit: 10, Offset: 1000})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(BeEmpty())
+	})
+})
+
+var _ = Describe("PageOf", func() {
+	results := []int{0, 1, 2, 3, 4, 5}
+	It("Returns everything without paging", func() {
+		Expect(managers.PageOf(results, metadata.Paging{})).To(Equal(results))
+		Expect(managers.PageOf(results, metadata.Paging{All: true})).To(Equal(results))
+	})
+	It("Returns the page", func() {
+		Expect(managers.PageOf(results, metadata.Paging{Limit: 2, Offset: 3})).To(Equal([]int{3, 4}))
+		Expect(managers.PageOf(results, metadata.Paging{Limit: 10, Offset: 4})).To(Equal([]int{4, 5}))
+		Expect(managers.PageOf(results, metadata.Paging{Offset: 2})).To(Equal([]int{2, 3, 4, 5}))
+	})
+	It("Returns nothing past the end", func() {
+		Expect(managers.PageOf(results, metadata.Paging{Offset: 6})).To(BeEmpty())
+	})
+})
+
+func hardwareIds(hardware []datatypes.Hardware) []int {
+	result := []int{}
+	for _, hw := range hardware {
+		result = append(result, *hw.Id)
+	}
+	return result
+}
+
+func idRange(start int, end int) []int {
+	result := []int{}
+	for id := start; id < end; id++ {
+		result = append(result, id)
+	}
+	return result
+}
+
+// Reports FirstTotal on the first page, like results were added while paging
+type growingHandler struct {
+	*pagingHandler
+	FirstTotal int
+}
+
+func (h *growingHandler) DoRequest(sess *session.Session, service string, method string, args []interface{}, options *sl.Options, pResult interface{}) error {
+	err := h.pagingHandler.DoRequest(sess, service, method, args, options, pResult)
+	if *options.Offset == 0 {
+		options.SetTotalItems(h.FirstTotal)
+	}
+	return err
+}
+
+type failingHandler struct{}
+
+func (h *failingHandler) DoRequest(sess *session.Session, service string, method string, args []interface{}, options *sl.Options, pResult interface{}) error {
+	return sl.Error{StatusCode: 500, Message: "First page failed"}
+}
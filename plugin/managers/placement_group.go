@@ -5,7 +5,6 @@ import (
 	"github.com/softlayer/softlayer-go/filter"
 	"github.com/softlayer/softlayer-go/services"
 	"github.com/softlayer/softlayer-go/session"
-	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 )
 
 //counterfeiter:generate -o ../testhelpers/ . PlaceGroupManager
@@ -40,21 +39,12 @@ func (p placeGroupManager) List(mask string) ([]datatypes.Virtual_PlacementGroup
 		mask = "mask[id, name, createDate, rule, guestCount, backendRouter[id, hostname]]"
 	}
 
-	i := 0
 	filters := filter.New()
 	filters = append(filters, filter.Path("placementGroups.id").OrderBy("DESC"))
-	resourceList := []datatypes.Virtual_PlacementGroup{}
-	for {
-		resp, err := p.Account.Mask(mask).Filter(filters.Build()).Limit(metadata.LIMIT).Offset(i * metadata.LIMIT).GetPlacementGroups()
-		i++
-		if err != nil {
-			return []datatypes.Virtual_PlacementGroup{}, err
-		}
-		resourceList = append(resourceList, resp...)
-
-		if len(resp) < metadata.LIMIT {
-			break
-		}
+	service := p.Account.Mask(mask).Filter(filters.Build())
+	resourceList, err := ListAllPages[datatypes.Virtual_PlacementGroup](service.Session, "SoftLayer_Account", "getPlacementGroups", nil, service.Options)
+	if err != nil {
+		return []datatypes.Virtual_PlacementGroup{}, err
 	}
 	return resourceList, nil
 
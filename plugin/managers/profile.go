@@ -0,0 +1,87 @@
+package managers
+
+import (
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/plugin"
+	"github.com/softlayer/softlayer-go/session"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+)
+
+//counterfeiter:generate -o ../testhelpers/ . ProfileManager
+type ProfileManager interface {
+	ListProfiles() ([]client.Profile, error)
+	GetProfile(name string) (client.Profile, error)
+	SaveProfile(profile client.Profile) error
+	GetActiveProfile() (string, error)
+	SetActiveProfile(name string) error
+}
+
+type profileManager struct {
+	Session *session.Session
+}
+
+func NewProfileManager(session *session.Session) *profileManager {
+	return &profileManager{
+		Session: session,
+	}
+}
+
+// Profiles live in the plugin config, which is reached through the plugin context of the session transport
+func (p profileManager) config() (plugin.PluginConfig, error) {
+	transport, ok := client.GetCLITransport(p.Session)
+	if !ok || transport.Context == nil {
+		return nil, errors.New(T("The plugin config is not available."))
+	}
+	return transport.Context.PluginConfig(), nil
+}
+
+/*
+Every saved profile, sorted by name
+*/
+func (p profileManager) ListProfiles() ([]client.Profile, error) {
+	config, err := p.config()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetProfileList(config)
+}
+
+func (p profileManager) GetProfile(name string) (client.Profile, error) {
+	config, err := p.config()
+	if err != nil {
+		return client.Profile{}, err
+	}
+	return client.GetProfile(config, name)
+}
+
+/*
+Adds a profile, or replaces the one with the same name
+*/
+func (p profileManager) SaveProfile(profile client.Profile) error {
+	config, err := p.config()
+	if err != nil {
+		return err
+	}
+	return client.SaveProfile(config, profile)
+}
+
+/*
+The profile picked with `sl config profile-use`, empty if there isn't one
+*/
+func (p profileManager) GetActiveProfile() (string, error) {
+	config, err := p.config()
+	if err != nil {
+		return "", err
+	}
+	return client.GetActiveProfile(config), nil
+}
+
+func (p profileManager) SetActiveProfile(name string) error {
+	config, err := p.config()
+	if err != nil {
+		return err
+	}
+	return client.SetActiveProfile(config, name)
+}
@@ -0,0 +1,189 @@
+package managers
+
+import (
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
+)
+
+// The resolvers turn the IDENTIFIER argument of a command into an ID. A number is always used as the ID,
+// anything else is looked up and has to match exactly one object, the same way GetVolumeId works for volumes.
+
+// Only letters, digits and hyphens in dot separated labels. Anything else (like web* or ~web) would be turned
+// into a pattern by utils.QueryFilter, and a pattern that happens to match one server must not resolve to it.
+var hostnamePattern = regexp.MustCompile(`^[A-Za-z0-9-]+(\.[A-Za-z0-9-]+)*$`)
+
+// identifier can be an ID, a hostname, a FQDN, or a public or private IP address
+func ResolveVirtualGuestId(manager VirtualServerManager, identifier string) (int, error) {
+	if id, err := strconv.Atoi(identifier); err == nil {
+		return id, nil
+	}
+	mask := "mask[id]"
+	guests := []datatypes.Virtual_Guest{}
+	if net.ParseIP(identifier) != nil {
+		public, err := manager.ListInstances(false, false, "", "", "", identifier, "", "", 0, 0, 0, 0, nil, mask, metadata.Paging{})
+		if err != nil {
+			return 0, err
+		}
+		private, err := manager.ListInstances(false, false, "", "", "", "", identifier, "", 0, 0, 0, 0, nil, mask, metadata.Paging{})
+		if err != nil {
+			return 0, err
+		}
+		guests = append(public, private...)
+	} else {
+		hostname, domain, err := splitFQDN(identifier)
+		if err != nil {
+			return 0, err
+		}
+		guests, err = manager.ListInstances(false, false, domain, hostname, "", "", "", "", 0, 0, 0, 0, nil, mask, metadata.Paging{})
+		if err != nil {
+			return 0, err
+		}
+	}
+	ids := []int{}
+	for _, guest := range guests {
+		ids = append(ids, utils.IntPointertoInt(guest.Id))
+	}
+	return onlyId(T("virtual server instance"), identifier, ids)
+}
+
+// identifier can be an ID, a hostname, a FQDN, or a public or private IP address
+func ResolveHardwareId(manager HardwareServerManager, identifier string) (int, error) {
+	if id, err := strconv.Atoi(identifier); err == nil {
+		return id, nil
+	}
+	mask := "mask[id]"
+	servers := []datatypes.Hardware_Server{}
+	if net.ParseIP(identifier) != nil {
+		public, err := manager.ListHardware(nil, 0, 0, "", "", "", 0, identifier, "", "", 0, mask, metadata.Paging{})
+		if err != nil {
+			return 0, err
+		}
+		private, err := manager.ListHardware(nil, 0, 0, "", "", "", 0, "", identifier, "", 0, mask, metadata.Paging{})
+		if err != nil {
+			return 0, err
+		}
+		servers = append(public, private...)
+	} else {
+		hostname, domain, err := splitFQDN(identifier)
+		if err != nil {
+			return 0, err
+		}
+		servers, err = manager.ListHardware(nil, 0, 0, hostname, domain, "", 0, "", "", "", 0, mask, metadata.Paging{})
+		if err != nil {
+			return 0, err
+		}
+	}
+	ids := []int{}
+	for _, server := range servers {
+		ids = append(ids, utils.IntPointertoInt(server.Id))
+	}
+	return onlyId(T("hardware server"), identifier, ids)
+}
+
+// identifier can be an ID, DATACENTER:VLAN_NUMBER like dal13:1234, or the name of the VLAN
+func ResolveVlanId(manager NetworkManager, identifier string) (int, error) {
+	if id, err := strconv.Atoi(identifier); err == nil {
+		return id, nil
+	}
+	mask := "mask[id]"
+	var vlans []datatypes.Network_Vlan
+	var err error
+	datacenter, number, found := strings.Cut(identifier, ":")
+	vlanNumber, numberErr := strconv.Atoi(number)
+	if found && numberErr == nil && vlanNumber > 0 {
+		vlans, err = manager.ListVlans(datacenter, vlanNumber, "", 0, mask, metadata.Paging{})
+	} else {
+		vlans, err = manager.ListVlans("", 0, identifier, 0, mask, metadata.Paging{})
+	}
+	if err != nil {
+		return 0, err
+	}
+	ids := []int{}
+	for _, vlan := range vlans {
+		ids = append(ids, utils.IntPointertoInt(vlan.Id))
+	}
+	return onlyId(T("VLAN"), identifier, ids)
+}
+
+// identifier can be an ID, a CIDR like 10.0.0.0/26, or the network address of the subnet
+func ResolveSubnetId(manager NetworkManager, identifier string) (int, error) {
+	if id, err := strconv.Atoi(identifier); err == nil {
+		return id, nil
+	}
+	network, cidr, isCIDR := strings.Cut(identifier, "/")
+	if net.ParseIP(network) == nil {
+		return 0, errors.NewInvalidUsageError(T("Invalid input for '{{.Name}}'. It must be an ID, a CIDR or a network address.", map[string]interface{}{"Name": "Subnet ID"}))
+	}
+	subnets, err := manager.ListSubnets(network, "", 0, "", "", 0, "mask[id,networkIdentifier,cidr]", metadata.Paging{})
+	if err != nil {
+		return 0, err
+	}
+	ids := []int{}
+	for _, subnet := range subnets {
+		if isCIDR && strconv.Itoa(utils.IntPointertoInt(subnet.Cidr)) != cidr {
+			continue
+		}
+		ids = append(ids, utils.IntPointertoInt(subnet.Id))
+	}
+	return onlyId(T("subnet"), identifier, ids)
+}
+
+// identifier can be an ID or the IP address of the global IP
+func ResolveGlobalIPId(manager NetworkManager, identifier string) (int, error) {
+	if id, err := strconv.Atoi(identifier); err == nil {
+		return id, nil
+	}
+	if net.ParseIP(identifier) == nil {
+		return 0, errors.NewInvalidUsageError(T("Invalid input for '{{.Name}}'. It must be an ID or an IP address.", map[string]interface{}{"Name": "Globalip ID"}))
+	}
+	globalIPs, err := manager.ListGlobalIPs(0, 0)
+	if err != nil {
+		return 0, err
+	}
+	ids := []int{}
+	for _, globalIP := range globalIPs {
+		if globalIP.IpAddress != nil && utils.StringPointertoString(globalIP.IpAddress.IpAddress) == identifier {
+			ids = append(ids, utils.IntPointertoInt(globalIP.Id))
+		}
+	}
+	return onlyId(T("global IP"), identifier, ids)
+}
+
+// "web1.example.com" is hostname web1 in domain example.com, "web1" is just a hostname
+func splitFQDN(identifier string) (string, string, error) {
+	if !hostnamePattern.MatchString(identifier) {
+		return "", "", errors.NewInvalidUsageError(T("Invalid input for '{{.Name}}'. It must be an ID, a hostname, a FQDN or an IP address.", map[string]interface{}{"Name": identifier}))
+	}
+	hostname, domain, _ := strings.Cut(identifier, ".")
+	return hostname, domain, nil
+}
+
+// The ID when ids has exactly one, the same object can show up more than once (like a server with the same public and private IP)
+func onlyId(kind string, identifier string, ids []int) (int, error) {
+	unique := []int{}
+	uniqueText := []string{}
+	for _, id := range ids {
+		if utils.IntInSlice(id, unique) == -1 {
+			unique = append(unique, id)
+			uniqueText = append(uniqueText, strconv.Itoa(id))
+		}
+	}
+	subs := map[string]interface{}{"KIND": kind, "IDENTIFIER": identifier}
+	if len(unique) == 0 {
+		return 0, errors.NewObjectNotFoundError(T("Unable to find {{.KIND}} {{.IDENTIFIER}}.", subs))
+	}
+	if len(unique) > 1 {
+		subs["IDS"] = strings.Join(uniqueText, ", ")
+		return 0, errors.New(T("{{.IDENTIFIER}} matches more than one {{.KIND}}: {{.IDS}}. Use one of the IDs instead.", subs))
+	}
+	return unique[0], nil
+}
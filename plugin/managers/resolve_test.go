@@ -0,0 +1,174 @@
+package managers_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/sl"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
+)
+
+var _ = Describe("Resolvers", func() {
+	Describe("ResolveVirtualGuestId", func() {
+		var fakeVSManager *testhelpers.FakeVirtualServerManager
+		BeforeEach(func() {
+			fakeVSManager = new(testhelpers.FakeVirtualServerManager)
+			fakeVSManager.ListInstancesReturns([]datatypes.Virtual_Guest{{Id: sl.Int(1234)}}, nil)
+		})
+		It("Uses a number as the ID", func() {
+			id, err := managers.ResolveVirtualGuestId(fakeVSManager, "5678")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id).To(Equal(5678))
+			Expect(fakeVSManager.ListInstancesCallCount()).To(Equal(0))
+		})
+		It("Finds a server by hostname", func() {
+			id, err := managers.ResolveVirtualGuestId(fakeVSManager, "web1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id).To(Equal(1234))
+			_, _, domain, hostname, _, _, _, _, _, _, _, _, _, _, _ := fakeVSManager.ListInstancesArgsForCall(0)
+			Expect(hostname).To(Equal("web1"))
+			Expect(domain).To(Equal(""))
+		})
+		It("Finds a server by FQDN", func() {
+			_, err := managers.ResolveVirtualGuestId(fakeVSManager, "web1.dev.example.com")
+			Expect(err).NotTo(HaveOccurred())
+			_, _, domain, hostname, _, _, _, _, _, _, _, _, _, _, _ := fakeVSManager.ListInstancesArgsForCall(0)
+			Expect(hostname).To(Equal("web1"))
+			Expect(domain).To(Equal("dev.example.com"))
+		})
+		It("Finds a server by public or private IP address", func() {
+			id, err := managers.ResolveVirtualGuestId(fakeVSManager, "10.1.2.3")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id).To(Equal(1234))
+			Expect(fakeVSManager.ListInstancesCallCount()).To(Equal(2))
+			_, _, _, _, _, publicIP, _, _, _, _, _, _, _, _, _ := fakeVSManager.ListInstancesArgsForCall(0)
+			Expect(publicIP).To(Equal("10.1.2.3"))
+			_, _, _, _, _, _, privateIP, _, _, _, _, _, _, _, _ := fakeVSManager.ListInstancesArgsForCall(1)
+			Expect(privateIP).To(Equal("10.1.2.3"))
+		})
+		It("Fails when nothing matches", func() {
+			fakeVSManager.ListInstancesReturns([]datatypes.Virtual_Guest{}, nil)
+			_, err := managers.ResolveVirtualGuestId(fakeVSManager, "web1")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("Unable to find virtual server instance web1."))
+		})
+		It("Fails when more than one server matches", func() {
+			fakeVSManager.ListInstancesReturns([]datatypes.Virtual_Guest{{Id: sl.Int(1)}, {Id: sl.Int(2)}}, nil)
+			_, err := managers.ResolveVirtualGuestId(fakeVSManager, "web1")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("web1 matches more than one virtual server instance: 1, 2. Use one of the IDs instead."))
+		})
+		It("Rejects patterns instead of matching with them", func() {
+			for _, identifier := range []string{"web*", "*web*", "~web", "^=web", "web1.*.com"} {
+				_, err := managers.ResolveVirtualGuestId(fakeVSManager, identifier)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("It must be an ID, a hostname, a FQDN or an IP address."))
+			}
+			Expect(fakeVSManager.ListInstancesCallCount()).To(Equal(0))
+		})
+		It("Returns API errors", func() {
+			fakeVSManager.ListInstancesReturns(nil, errors.New("Internal Server Error"))
+			_, err := managers.ResolveVirtualGuestId(fakeVSManager, "web1")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Internal Server Error"))
+		})
+	})
+
+	Describe("ResolveHardwareId", func() {
+		var fakeHardwareManager *testhelpers.FakeHardwareServerManager
+		BeforeEach(func() {
+			fakeHardwareManager = new(testhelpers.FakeHardwareServerManager)
+			fakeHardwareManager.ListHardwareReturns([]datatypes.Hardware_Server{{Hardware: datatypes.Hardware{Id: sl.Int(99)}}}, nil)
+		})
+		It("Finds a server by FQDN", func() {
+			id, err := managers.ResolveHardwareId(fakeHardwareManager, "bare1.example.com")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id).To(Equal(99))
+			_, _, _, hostname, domain, _, _, _, _, _, _, _, _ := fakeHardwareManager.ListHardwareArgsForCall(0)
+			Expect(hostname).To(Equal("bare1"))
+			Expect(domain).To(Equal("example.com"))
+		})
+		It("Rejects patterns instead of matching with them", func() {
+			_, err := managers.ResolveHardwareId(fakeHardwareManager, "bare*")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Invalid input for 'bare*'"))
+			Expect(fakeHardwareManager.ListHardwareCallCount()).To(Equal(0))
+		})
+		It("Counts a server with the same public and private IP address once", func() {
+			id, err := managers.ResolveHardwareId(fakeHardwareManager, "10.1.2.3")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id).To(Equal(99))
+		})
+	})
+
+	Describe("ResolveVlanId", func() {
+		var fakeNetworkManager *testhelpers.FakeNetworkManager
+		BeforeEach(func() {
+			fakeNetworkManager = new(testhelpers.FakeNetworkManager)
+			fakeNetworkManager.ListVlansReturns([]datatypes.Network_Vlan{{Id: sl.Int(555)}}, nil)
+		})
+		It("Finds a VLAN by datacenter and number", func() {
+			id, err := managers.ResolveVlanId(fakeNetworkManager, "dal13:1234")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id).To(Equal(555))
+			datacenter, number, name, _, _, _ := fakeNetworkManager.ListVlansArgsForCall(0)
+			Expect(datacenter).To(Equal("dal13"))
+			Expect(number).To(Equal(1234))
+			Expect(name).To(Equal(""))
+		})
+		It("Finds a VLAN by name", func() {
+			_, err := managers.ResolveVlanId(fakeNetworkManager, "backend")
+			Expect(err).NotTo(HaveOccurred())
+			datacenter, number, name, _, _, _ := fakeNetworkManager.ListVlansArgsForCall(0)
+			Expect(datacenter).To(Equal(""))
+			Expect(number).To(Equal(0))
+			Expect(name).To(Equal("backend"))
+		})
+	})
+
+	Describe("ResolveSubnetId", func() {
+		var fakeNetworkManager *testhelpers.FakeNetworkManager
+		BeforeEach(func() {
+			fakeNetworkManager = new(testhelpers.FakeNetworkManager)
+			fakeNetworkManager.ListSubnetsReturns([]datatypes.Network_Subnet{
+				{Id: sl.Int(1), NetworkIdentifier: sl.String("10.0.0.0"), Cidr: sl.Int(26)},
+				{Id: sl.Int(2), NetworkIdentifier: sl.String("10.0.0.0"), Cidr: sl.Int(24)},
+			}, nil)
+		})
+		It("Finds a subnet by CIDR", func() {
+			id, err := managers.ResolveSubnetId(fakeNetworkManager, "10.0.0.0/24")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id).To(Equal(2))
+			network, _, _, _, _, _, _, _ := fakeNetworkManager.ListSubnetsArgsForCall(0)
+			Expect(network).To(Equal("10.0.0.0"))
+		})
+		It("Fails when the network address matches more than one subnet", func() {
+			_, err := managers.ResolveSubnetId(fakeNetworkManager, "10.0.0.0")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("matches more than one subnet: 1, 2"))
+		})
+		It("Fails for something that isn't a network", func() {
+			_, err := managers.ResolveSubnetId(fakeNetworkManager, "backend")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("It must be an ID, a CIDR or a network address."))
+			Expect(fakeNetworkManager.ListSubnetsCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("ResolveGlobalIPId", func() {
+		It("Finds a global IP by address", func() {
+			fakeNetworkManager := new(testhelpers.FakeNetworkManager)
+			fakeNetworkManager.ListGlobalIPsReturns([]datatypes.Network_Subnet_IpAddress_Global{
+				{Id: sl.Int(7), IpAddress: &datatypes.Network_Subnet_IpAddress{IpAddress: sl.String("169.1.1.1")}},
+				{Id: sl.Int(8), IpAddress: &datatypes.Network_Subnet_IpAddress{IpAddress: sl.String("169.1.1.2")}},
+			}, nil)
+			id, err := managers.ResolveGlobalIPId(fakeNetworkManager, "169.1.1.2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id).To(Equal(8))
+		})
+	})
+})
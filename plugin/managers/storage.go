@@ -78,7 +78,7 @@ type StorageManager interface {
 	GetReplicationPartners(volumeId int) ([]datatypes.Network_Storage, error)
 	GetReplicationLocations(volumeId int) ([]datatypes.Location, error)
 
-	ListVolumes(volumeType string, datacenter string, username string, storageType string, notes string, orderId int, mask string) ([]datatypes.Network_Storage, error)
+	ListVolumes(volumeType string, datacenter string, username string, storageType string, notes string, orderId int, mask string, paging metadata.Paging) ([]datatypes.Network_Storage, error)
 	GetVolumeDetails(volumeType string, volumeId int, mask string) (datatypes.Network_Storage, error)
 	GetVolumeByUsername(username string) ([]datatypes.Network_Storage, error)
 	OrderVolume(volumeType string, location string, storageType string, osType string, size int, tier float64, iops int, snapshotSize int, billing bool) (datatypes.Container_Product_Order_Receipt, error)
@@ -317,7 +317,8 @@ func (s storageManager) GetReplicationLocations(volumeId int) ([]datatypes.Locat
 // username: Name of volume.
 // storageType: Type of volume: Endurance or Performance
 // orderId: ID of order
-func (s storageManager) ListVolumes(volumeType string, datacenter string, username string, storageType string, notes string, orderId int, mask string) ([]datatypes.Network_Storage, error) {
+// paging: which of the results to return
+func (s storageManager) ListVolumes(volumeType string, datacenter string, username string, storageType string, notes string, orderId int, mask string, paging metadata.Paging) ([]datatypes.Network_Storage, error) {
 	filters := filter.New()
 	if volumeType == VOLUME_TYPE_BLOCK {
 		filters = append(filters, filter.Path("iscsiNetworkStorage.id").OrderBy("ASC"))
@@ -344,22 +345,15 @@ func (s storageManager) ListVolumes(volumeType string, datacenter string, userna
 			filters = append(filters, filter.Path("iscsiNetworkStorage.billingItem.orderItem.order.id").Eq(orderId))
 		}
 
-		i := 0
-		resourceList := []datatypes.Network_Storage{}
 		// Shortcut the pagination because filtering by DC is bugged, remove this when CORE-1820 is released.
 		if datacenter != "" {
-			return s.AccountService.Mask(mask).Filter(filters.Build()).GetIscsiNetworkStorage()
+			resourceList, err := s.AccountService.Mask(mask).Filter(filters.Build()).GetIscsiNetworkStorage()
+			return PageOf(resourceList, paging), err
 		}
-		for {
-			resp, err := s.AccountService.Mask(mask).Filter(filters.Build()).Limit(metadata.LIMIT).Offset(i * metadata.LIMIT).GetIscsiNetworkStorage()
-			i++
-			if err != nil {
-				return []datatypes.Network_Storage{}, err
-			}
-			resourceList = append(resourceList, resp...)
-			if len(resp) < metadata.LIMIT {
-				break
-			}
+		service := s.AccountService.Mask(mask).Filter(filters.Build())
+		resourceList, err := ListPages[datatypes.Network_Storage](service.Session, "SoftLayer_Account", "getIscsiNetworkStorage", nil, service.Options, paging)
+		if err != nil {
+			return []datatypes.Network_Storage{}, err
 		}
 
 		return resourceList, nil
@@ -389,22 +383,15 @@ func (s storageManager) ListVolumes(volumeType string, datacenter string, userna
 			filters = append(filters, filter.Path("nasNetworkStorage.billingItem.orderItem.order.id").Eq(orderId))
 		}
 
-		i := 0
-		var resourceList []datatypes.Network_Storage
 		// Shortcut the pagination because filtering by DC is bugged, remove this when CORE-1820 is released.
 		if datacenter != "" {
-			return s.AccountService.Mask(mask).Filter(filters.Build()).GetNasNetworkStorage()
+			resourceList, err := s.AccountService.Mask(mask).Filter(filters.Build()).GetNasNetworkStorage()
+			return PageOf(resourceList, paging), err
 		}
-		for {
-			resp, err := s.AccountService.Mask(mask).Filter(filters.Build()).Limit(metadata.LIMIT).Offset(i * metadata.LIMIT).GetNasNetworkStorage()
-			i++
-			if err != nil {
-				return []datatypes.Network_Storage{}, err
-			}
-			resourceList = append(resourceList, resp...)
-			if len(resp) < metadata.LIMIT {
-				break
-			}
+		service := s.AccountService.Mask(mask).Filter(filters.Build())
+		resourceList, err := ListPages[datatypes.Network_Storage](service.Session, "SoftLayer_Account", "getNasNetworkStorage", nil, service.Options, paging)
+		if err != nil {
+			return []datatypes.Network_Storage{}, err
 		}
 
 		return resourceList, nil
@@ -978,7 +965,7 @@ func (s storageManager) GetVolumeId(identifier string, storageType string) (int,
 	// If there was an error, identifier is likely a string and username, search the API for it
 	if err != nil {
 		// Maybe this is a volume username
-		volumes, err := s.ListVolumes(storageType, "", identifier, "", "", 0, "mask[id,username]")
+		volumes, err := s.ListVolumes(storageType, "", identifier, "", "", 0, "mask[id,username]", metadata.Paging{})
 		if err != nil {
 			// API error
 			return 0, err
@@ -6,6 +6,7 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 	"github.com/softlayer/softlayer-go/session"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
 )
 
@@ -107,7 +108,7 @@ var _ = Describe("StorageManager", func() {
 	Describe("ListBlockVolumes", func() {
 		Context("ListBlockVolumes under current account", func() {
 			It("Block Happy Path", func() {
-				volumes, err := StorageManager.ListVolumes("block", "", "", "", "", 0, "")
+				volumes, err := StorageManager.ListVolumes("block", "", "", "", "", 0, "", metadata.Paging{})
 				Expect(err).ToNot(HaveOccurred())
 				Expect(len(volumes)).Should(BeNumerically(">", 0))
 				for _, volume := range volumes {
@@ -123,7 +124,7 @@ var _ = Describe("StorageManager", func() {
 				}))
 			})
 			It("File Happy Path", func() {
-				volumes, err := StorageManager.ListVolumes("file", "", "", "", "", 0, "")
+				volumes, err := StorageManager.ListVolumes("file", "", "", "", "", 0, "", metadata.Paging{})
 				Expect(err).ToNot(HaveOccurred())
 				Expect(len(volumes)).Should(BeNumerically(">", 0))
 				for _, volume := range volumes {
@@ -141,7 +142,7 @@ var _ = Describe("StorageManager", func() {
 		})
 		Context("Issue822 - Special case for ListVolumes with a datacenter filter", func() {
 			It("Block: No Result Limit", func() {
-				_, err := StorageManager.ListVolumes("block", "dal10", "", "", "", 0, "")
+				_, err := StorageManager.ListVolumes("block", "dal10", "", "", "", 0, "", metadata.Paging{})
 				Expect(err).ToNot(HaveOccurred())
 				apiCalls := fakeHandler.ApiCallLogs
 				Expect(len(apiCalls)).To(Equal(1))
@@ -154,7 +155,7 @@ var _ = Describe("StorageManager", func() {
 				}))
 			})
 			It("File: No Result Limit", func() {
-				_, err := StorageManager.ListVolumes("file", "dal10", "", "", "", 0, "")
+				_, err := StorageManager.ListVolumes("file", "dal10", "", "", "", 0, "", metadata.Paging{})
 				Expect(err).ToNot(HaveOccurred())
 				apiCalls := fakeHandler.ApiCallLogs
 				Expect(len(apiCalls)).To(Equal(1))
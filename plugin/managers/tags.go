@@ -8,7 +8,6 @@ import (
 	"github.com/softlayer/softlayer-go/services"
 	"github.com/softlayer/softlayer-go/session"
 	"github.com/softlayer/softlayer-go/sl"
-	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
 )
 
@@ -45,20 +44,11 @@ func (tag tagsManager) GetTagByTagName(tagName string) ([]datatypes.Tag, error)
 
 func (tag tagsManager) ListTags() ([]datatypes.Tag, error) {
 
-	tags := []datatypes.Tag{}
 	objectMask := "mask[id,name,referenceCount]"
-	i := 0
-	for {
-		resp, err := tag.TagService.Mask(objectMask).Limit(metadata.LIMIT).Offset(i * metadata.LIMIT).
-			GetAttachedTagsForCurrentUser()
-		i++
-		if err != nil {
-			return tags, err
-		}
-		tags = append(tags, resp...)
-		if len(resp) < metadata.LIMIT {
-			break
-		}
+	service := tag.TagService.Mask(objectMask)
+	tags, err := ListAllPages[datatypes.Tag](service.Session, "SoftLayer_Tag", "getAttachedTagsForCurrentUser", nil, service.Options)
+	if err != nil {
+		return tags, err
 	}
 
 	return tags, nil
@@ -66,20 +56,11 @@ func (tag tagsManager) ListTags() ([]datatypes.Tag, error) {
 
 func (tag tagsManager) ListEmptyTags() ([]datatypes.Tag, error) {
 
-	tags := []datatypes.Tag{}
 	objectMask := "mask[id,name,referenceCount]"
-	i := 0
-	for {
-		resp, err := tag.TagService.Mask(objectMask).Limit(metadata.LIMIT).Offset(i * metadata.LIMIT).
-			GetUnattachedTagsForCurrentUser()
-		i++
-		if err != nil {
-			return tags, err
-		}
-		tags = append(tags, resp...)
-		if len(resp) < metadata.LIMIT {
-			break
-		}
+	service := tag.TagService.Mask(objectMask)
+	tags, err := ListAllPages[datatypes.Tag](service.Session, "SoftLayer_Tag", "getUnattachedTagsForCurrentUser", nil, service.Options)
+	if err != nil {
+		return tags, err
 	}
 	return tags, nil
 }
@@ -89,19 +70,10 @@ func (tag tagsManager) GetTagReferences(tagId int) ([]datatypes.Tag_Reference, e
 	filters = append(filters, filter.Path("references.id").OrderBy("ASC"))
 
 	objectMask := "mask[tagType]"
-	tagReferences := []datatypes.Tag_Reference{}
-	i := 0
-	for {
-		references, err := tag.TagService.Mask(objectMask).Filter(filters.Build()).Limit(metadata.LIMIT).Offset(i * metadata.LIMIT).
-			Id(tagId).GetReferences()
-		i++
-		if err != nil {
-			return references, err
-		}
-		tagReferences = append(tagReferences, references...)
-		if len(references) < metadata.LIMIT {
-			break
-		}
+	service := tag.TagService.Mask(objectMask).Filter(filters.Build()).Id(tagId)
+	tagReferences, err := ListAllPages[datatypes.Tag_Reference](service.Session, "SoftLayer_Tag", "getReferences", nil, service.Options)
+	if err != nil {
+		return []datatypes.Tag_Reference{}, err
 	}
 
 	return tagReferences, nil
@@ -13,6 +13,7 @@ import (
 	"github.com/softlayer/softlayer-go/services"
 	"github.com/softlayer/softlayer-go/session"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
 )
 
@@ -27,8 +28,8 @@ type TicketManager interface {
 	AddUpdate(ticketId int, content string) error
 	GetSubjects() (*[]datatypes.Ticket_Subject, error)
 	ListTickets() ([]datatypes.Ticket, error)
-	ListOpenTickets() ([]datatypes.Ticket, error)
-	ListCloseTickets() ([]datatypes.Ticket, error)
+	ListOpenTickets(paging metadata.Paging) ([]datatypes.Ticket, error)
+	ListCloseTickets(paging metadata.Paging) ([]datatypes.Ticket, error)
 	AttachFileToTicket(ticketId int, name string, path string) error
 	Summary() (*TicketSummary, error)
 	GetText() (string, error)
@@ -187,13 +188,13 @@ func (ticket ticketManager) ListTickets() ([]datatypes.Ticket, error) {
 	return tickets, err
 }
 
-func (ticket ticketManager) ListOpenTickets() ([]datatypes.Ticket, error) {
-	tickets, err := ticket.AccountService.Mask(mask).GetOpenTickets()
-	return tickets, err
+func (ticket ticketManager) ListOpenTickets(paging metadata.Paging) ([]datatypes.Ticket, error) {
+	service := ticket.AccountService.Mask(mask)
+	return ListPages[datatypes.Ticket](service.Session, "SoftLayer_Account", "getOpenTickets", nil, service.Options, paging)
 }
-func (ticket ticketManager) ListCloseTickets() ([]datatypes.Ticket, error) {
-	tickets, err := ticket.AccountService.Mask(mask).GetClosedTickets()
-	return tickets, err
+func (ticket ticketManager) ListCloseTickets(paging metadata.Paging) ([]datatypes.Ticket, error) {
+	service := ticket.AccountService.Mask(mask)
+	return ListPages[datatypes.Ticket](service.Session, "SoftLayer_Account", "getClosedTickets", nil, service.Options, paging)
 }
 
 func (ticket ticketManager) AttachFileToTicket(ticketId int, name string, path string) error {
@@ -16,6 +16,7 @@ import (
 	"github.com/softlayer/softlayer-go/sl"
 	slErrors "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
 )
 
@@ -28,7 +29,7 @@ const (
 // See product information here: https://www.ibm.com/cloud-computing/bluemix/block-storage, https://www.ibm.com/cloud-computing/bluemix/file-storage
 //counterfeiter:generate -o ../testhelpers/ . UserManager
 type UserManager interface {
-	ListUsers(mask string) ([]datatypes.User_Customer, error)
+	ListUsers(mask string, paging metadata.Paging) ([]datatypes.User_Customer, error)
 	GetUser(userId int, mask string) (datatypes.User_Customer, error)
 	GetCurrentUser() (datatypes.User_Customer, error)
 	GetAllPermission() ([]datatypes.User_Customer_CustomerPermission_Permission, error)
@@ -88,11 +89,12 @@ func NewUserManager(session *session.Session) *userManager {
 	}
 }
 
-func (u userManager) ListUsers(mask string) ([]datatypes.User_Customer, error) {
+func (u userManager) ListUsers(mask string, paging metadata.Paging) ([]datatypes.User_Customer, error) {
 	if mask == "" {
 		mask = LIST_USER_MASK
 	}
-	return u.AccountService.Mask(mask).GetUsers()
+	service := u.AccountService.Mask(mask)
+	return ListPages[datatypes.User_Customer](service.Session, "SoftLayer_Account", "getUsers", nil, service.Options, paging)
 }
 
 func (u userManager) GetUser(userId int, mask string) (datatypes.User_Customer, error) {
@@ -8,6 +8,7 @@ import (
 	"github.com/softlayer/softlayer-go/session"
 
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
 )
 
@@ -31,7 +32,7 @@ var _ = Describe("User", func() {
 				UserManager = managers.NewUserManager(fakeSLSession)
 			})
 			It("Return users", func() {
-				users, err := UserManager.ListUsers("")
+				users, err := UserManager.ListUsers("", metadata.Paging{})
 				Expect(err).ToNot(HaveOccurred())
 				Expect(*users[0].Username).To(Equal("IBM27821"))
 				Expect(*users[0].HardwareCount).To(Equal(uint(1111)))
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"errors"
 
-	"io/ioutil"
 	"math"
 	"os"
 	"strconv"
@@ -75,8 +74,9 @@ type VirtualServerManager interface {
 	GetInstance(id int, mask string) (datatypes.Virtual_Guest, error)
 	GetDedicatedHost(hostId int) (datatypes.Virtual_DedicatedHost, error)
 	GetLikedInstance(virtualGuest *datatypes.Virtual_Guest, id int) (*datatypes.Virtual_Guest, error)
+	ExportInstanceTemplate(id int) (datatypes.Virtual_Guest, error)
 	CaptureImage(vsId int, imageName string, imageNote string, imageBlockDevices []datatypes.Virtual_Guest_Block_Device) (datatypes.Virtual_Guest_Block_Device_Template_Group, error)
-	ListInstances(hourly bool, monthly bool, domain string, hostname string, datacenter string, publicIP string, privateIP string, owner string, cpu int, memory int, network int, orderId int, tags []string, mask string) ([]datatypes.Virtual_Guest, error)
+	ListInstances(hourly bool, monthly bool, domain string, hostname string, datacenter string, publicIP string, privateIP string, owner string, cpu int, memory int, network int, orderId int, tags []string, mask string, paging metadata.Paging) ([]datatypes.Virtual_Guest, error)
 	GetInstances(mask string, objFilter filter.Filters) ([]datatypes.Virtual_Guest, error)
 	PauseInstance(id int) error
 	PowerOnInstance(id int) error
@@ -531,11 +531,11 @@ func (vs virtualServerManager) GenerateInstanceCreationTemplate(virtualGuest *da
 }
 
 func getParamsFromTemplate(virtualGuest *datatypes.Virtual_Guest, templateFile string) (*datatypes.Virtual_Guest, error) {
-	data, err := ioutil.ReadFile(templateFile) // #nosec
+	template, err := LoadInstanceTemplate(templateFile)
 	if err != nil {
 		return &datatypes.Virtual_Guest{}, err
 	}
-	err = json.Unmarshal(data, virtualGuest)
+	*virtualGuest = template
 	return virtualGuest, nil
 }
 
@@ -734,6 +734,140 @@ func (vs virtualServerManager) GetLikedInstance(virtualGuest *datatypes.Virtual_
 	return virtualGuest, nil
 }
 
+// Returns the creation template of an existing virtual server, with everything needed to order the same server again:
+// the flavor (or CPU and memory), OS or image, disks, network speed, VLANs, subnets, security groups, SSH keys,
+// placement group, boot mode, userdata and tags. It loads back with LoadInstanceTemplate.
+func (vs virtualServerManager) ExportInstanceTemplate(id int) (datatypes.Virtual_Guest, error) {
+	networkMask := "networkVlan.id,primaryIpAddressRecord.subnetId,securityGroupBindings.securityGroup.id"
+	mask := "id,hostname,domain,maxCpu,maxMemory,hourlyBillingFlag,localDiskFlag,dedicatedAccountHostOnlyFlag," +
+		"privateNetworkOnlyFlag,transientGuestFlag,postInstallScriptUri,placementGroupId,operatingSystemReferenceCode," +
+		"datacenter.name,dedicatedHost.id,blockDeviceTemplateGroup.globalIdentifier,billingItem.orderItem.preset.keyName," +
+		"blockDevices[device,mountType,diskImage.capacity],networkComponents.maxSpeed," +
+		"primaryNetworkComponent[" + networkMask + "],primaryBackendNetworkComponent[" + networkMask + "]," +
+		"sshKeys.id,tagReferences.tag.name,userData.value"
+	guest, err := vs.GetInstance(id, mask)
+	if err != nil {
+		return datatypes.Virtual_Guest{}, err
+	}
+	bootMode, err := vs.VirtualGuestService.Id(id).GetBootMode()
+	if err != nil {
+		return datatypes.Virtual_Guest{}, err
+	}
+
+	template := datatypes.Virtual_Guest{
+		Hostname:                     guest.Hostname,
+		Domain:                       guest.Domain,
+		HourlyBillingFlag:            guest.HourlyBillingFlag,
+		LocalDiskFlag:                guest.LocalDiskFlag,
+		PrivateNetworkOnlyFlag:       guest.PrivateNetworkOnlyFlag,
+		TransientGuestFlag:           guest.TransientGuestFlag,
+		PostInstallScriptUri:         guest.PostInstallScriptUri,
+		PlacementGroupId:             guest.PlacementGroupId,
+		OperatingSystemReferenceCode: guest.OperatingSystemReferenceCode,
+	}
+	if guest.Datacenter != nil {
+		template.Datacenter = &datatypes.Location{Name: guest.Datacenter.Name}
+	}
+	if guest.BlockDeviceTemplateGroup != nil && guest.BlockDeviceTemplateGroup.GlobalIdentifier != nil {
+		template.BlockDeviceTemplateGroup = &datatypes.Virtual_Guest_Block_Device_Template_Group{
+			GlobalIdentifier: guest.BlockDeviceTemplateGroup.GlobalIdentifier,
+		}
+		template.OperatingSystemReferenceCode = nil
+	}
+	if guest.DedicatedHost != nil && guest.DedicatedHost.Id != nil {
+		template.DedicatedHost = &datatypes.Virtual_DedicatedHost{Id: guest.DedicatedHost.Id}
+	}
+
+	flavor := ""
+	if guest.BillingItem != nil && guest.BillingItem.OrderItem != nil && guest.BillingItem.OrderItem.Preset != nil {
+		flavor = utils.StringPointertoString(guest.BillingItem.OrderItem.Preset.KeyName)
+	}
+	if flavor != "" {
+		template.SupplementalCreateObjectOptions = &datatypes.Virtual_Guest_SupplementalCreateObjectOptions{
+			FlavorKeyName: sl.String(flavor),
+		}
+	} else {
+		template.StartCpus = guest.MaxCpu
+		template.MaxMemory = guest.MaxMemory
+		template.DedicatedAccountHostOnlyFlag = guest.DedicatedAccountHostOnlyFlag
+	}
+	if bootMode != "" {
+		if template.SupplementalCreateObjectOptions == nil {
+			template.SupplementalCreateObjectOptions = &datatypes.Virtual_Guest_SupplementalCreateObjectOptions{}
+		}
+		template.SupplementalCreateObjectOptions.BootMode = sl.String(bootMode)
+	}
+
+	// Device 1 is the swap disk, and the first disk of a flavor comes with the flavor
+	for _, device := range guest.BlockDevices {
+		if device.Device == nil || device.DiskImage == nil || device.DiskImage.Capacity == nil {
+			continue
+		}
+		if *device.Device == "1" || (flavor != "" && *device.Device == "0") {
+			continue
+		}
+		if device.MountType != nil && *device.MountType != "Disk" {
+			continue
+		}
+		template.BlockDevices = append(template.BlockDevices, datatypes.Virtual_Guest_Block_Device{
+			Device:    device.Device,
+			DiskImage: &datatypes.Virtual_Disk_Image{Capacity: device.DiskImage.Capacity},
+		})
+	}
+
+	maxSpeed := 0
+	for _, component := range guest.NetworkComponents {
+		maxSpeed = max(maxSpeed, utils.IntPointertoInt(component.MaxSpeed))
+	}
+	if maxSpeed > 0 {
+		template.NetworkComponents = []datatypes.Virtual_Guest_Network_Component{{MaxSpeed: sl.Int(maxSpeed)}}
+	}
+	if guest.PrivateNetworkOnlyFlag == nil || !*guest.PrivateNetworkOnlyFlag {
+		template.PrimaryNetworkComponent = templateNetworkComponent(guest.PrimaryNetworkComponent)
+	}
+	template.PrimaryBackendNetworkComponent = templateNetworkComponent(guest.PrimaryBackendNetworkComponent)
+
+	for _, key := range guest.SshKeys {
+		template.SshKeys = append(template.SshKeys, datatypes.Security_Ssh_Key{Id: key.Id})
+	}
+	for _, attribute := range guest.UserData {
+		if attribute.Value != nil {
+			template.UserData = append(template.UserData, datatypes.Virtual_Guest_Attribute{Value: attribute.Value})
+		}
+	}
+	tags := InstanceTemplateTags(guest)
+	if len(tags) > 0 {
+		template.TagReferences = InstanceTemplateTagReferences(tags)
+	}
+	return template, nil
+}
+
+// The VLAN, subnet and security groups of a network component, nil when it has none of them
+func templateNetworkComponent(component *datatypes.Virtual_Guest_Network_Component) *datatypes.Virtual_Guest_Network_Component {
+	if component == nil {
+		return nil
+	}
+	templateComponent := datatypes.Virtual_Guest_Network_Component{}
+	if component.NetworkVlan != nil && component.NetworkVlan.Id != nil {
+		templateComponent.NetworkVlan = &datatypes.Network_Vlan{Id: component.NetworkVlan.Id}
+		if component.PrimaryIpAddressRecord != nil && component.PrimaryIpAddressRecord.SubnetId != nil {
+			templateComponent.NetworkVlan.PrimarySubnet = &datatypes.Network_Subnet{Id: component.PrimaryIpAddressRecord.SubnetId}
+		}
+	}
+	for _, binding := range component.SecurityGroupBindings {
+		if binding.SecurityGroup != nil && binding.SecurityGroup.Id != nil {
+			templateComponent.SecurityGroupBindings = append(templateComponent.SecurityGroupBindings,
+				datatypes.Virtual_Network_SecurityGroup_NetworkComponentBinding{
+					SecurityGroup: &datatypes.Network_SecurityGroup{Id: binding.SecurityGroup.Id},
+				})
+		}
+	}
+	if templateComponent.NetworkVlan == nil && len(templateComponent.SecurityGroupBindings) == 0 {
+		return nil
+	}
+	return &templateComponent
+}
+
 // Capture one or all disks from a VS to a SoftLayer image.
 // vsId: ID of instance
 // imageName: name of the image to be created
@@ -757,7 +891,8 @@ func (vs virtualServerManager) CaptureImage(vsId int, imageName string, imageNot
 // network: filter based on network speed (in MBPS)
 // orderId: filter based on the ID of the order which purchased this instance
 // tags: filter based on list of tags
-func (vs virtualServerManager) ListInstances(hourly bool, monthly bool, domain string, hostname string, datacenter string, publicIP string, privateIP string, owner string, cpu int, memory int, network int, orderID int, tags []string, mask string) ([]datatypes.Virtual_Guest, error) {
+// paging: which of the results to return
+func (vs virtualServerManager) ListInstances(hourly bool, monthly bool, domain string, hostname string, datacenter string, publicIP string, privateIP string, owner string, cpu int, memory int, network int, orderID int, tags []string, mask string, paging metadata.Paging) ([]datatypes.Virtual_Guest, error) {
 	filters := filter.New()
 	filters = append(filters, filter.Path("virtualGuests.id").OrderBy("DESC"))
 	if domain != "" {
@@ -803,50 +938,26 @@ func (vs virtualServerManager) ListInstances(hourly bool, monthly bool, domain s
 	}
 
 	if hourly == false && monthly == true {
-		i := 0
-		resourceList := []datatypes.Virtual_Guest{}
-		for {
-			resp, err := vs.AccountService.Mask(mask).Filter(filters.Build()).Limit(metadata.LIMIT).Offset(i * metadata.LIMIT).GetMonthlyVirtualGuests()
-			i++
-			if err != nil {
-				return []datatypes.Virtual_Guest{}, err
-			}
-			resourceList = append(resourceList, resp...)
-			if len(resp) < metadata.LIMIT {
-				break
-			}
+		service := vs.AccountService.Mask(mask).Filter(filters.Build())
+		resourceList, err := ListPages[datatypes.Virtual_Guest](service.Session, "SoftLayer_Account", "getMonthlyVirtualGuests", nil, service.Options, paging)
+		if err != nil {
+			return []datatypes.Virtual_Guest{}, err
 		}
 		return resourceList, nil
 
 	} else if hourly == true && monthly == false {
-		i := 0
-		resourceList := []datatypes.Virtual_Guest{}
-		for {
-			resp, err := vs.AccountService.Mask(mask).Filter(filters.Build()).Limit(metadata.LIMIT).Offset(i * metadata.LIMIT).GetHourlyVirtualGuests()
-			i++
-			if err != nil {
-				return []datatypes.Virtual_Guest{}, err
-			}
-			resourceList = append(resourceList, resp...)
-			if len(resp) < metadata.LIMIT {
-				break
-			}
+		service := vs.AccountService.Mask(mask).Filter(filters.Build())
+		resourceList, err := ListPages[datatypes.Virtual_Guest](service.Session, "SoftLayer_Account", "getHourlyVirtualGuests", nil, service.Options, paging)
+		if err != nil {
+			return []datatypes.Virtual_Guest{}, err
 		}
 		return resourceList, nil
 	}
 
-	i := 0
-	resourceList := []datatypes.Virtual_Guest{}
-	for {
-		resp, err := vs.AccountService.Mask(mask).Filter(filters.Build()).Limit(metadata.LIMIT).Offset(i * metadata.LIMIT).GetVirtualGuests()
-		i++
-		if err != nil {
-			return []datatypes.Virtual_Guest{}, err
-		}
-		resourceList = append(resourceList, resp...)
-		if len(resp) < metadata.LIMIT {
-			break
-		}
+	service := vs.AccountService.Mask(mask).Filter(filters.Build())
+	resourceList, err := ListPages[datatypes.Virtual_Guest](service.Session, "SoftLayer_Account", "getVirtualGuests", nil, service.Options, paging)
+	if err != nil {
+		return []datatypes.Virtual_Guest{}, err
 	}
 	return resourceList, nil
 
@@ -863,18 +974,10 @@ func (vs virtualServerManager) GetInstances(mask string, objFilter filter.Filter
 		filters = objFilter
 	}
 
-	i := 0
-	resourceList := []datatypes.Virtual_Guest{}
-	for {
-		resp, err := vs.AccountService.Mask(mask).Filter(filters.Build()).Limit(metadata.LIMIT).Offset(i * metadata.LIMIT).GetVirtualGuests()
-		i++
-		if err != nil {
-			return []datatypes.Virtual_Guest{}, err
-		}
-		resourceList = append(resourceList, resp...)
-		if len(resp) < metadata.LIMIT {
-			break
-		}
+	service := vs.AccountService.Mask(mask).Filter(filters.Build())
+	resourceList, err := ListAllPages[datatypes.Virtual_Guest](service.Session, "SoftLayer_Account", "getVirtualGuests", nil, service.Options)
+	if err != nil {
+		return []datatypes.Virtual_Guest{}, err
 	}
 	return resourceList, nil
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/session"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
 )
 
@@ -155,6 +156,36 @@ var _ = Describe("VirtualServerManager", func() {
 		})
 	})
 
+	Describe("Export instance template", func() {
+		It("Returns what is needed to order the same instance", func() {
+			template, err := vsManager.ExportInstanceTemplate(25804753)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(template.Id).To(BeNil())
+			Expect(*template.Hostname).To(Equal("wilma2"))
+			Expect(*template.Domain).To(Equal("wilma.org"))
+			Expect(*template.Datacenter.Name).To(Equal("par01"))
+			Expect(*template.StartCpus).To(Equal(2))
+			Expect(*template.MaxMemory).To(Equal(2048))
+			Expect(*template.OperatingSystemReferenceCode).To(Equal("CENTOS_7_64"))
+			Expect(*template.SupplementalCreateObjectOptions.BootMode).To(Equal("HVM"))
+			Expect(template.BlockDevices).To(HaveLen(1))
+			Expect(*template.BlockDevices[0].Device).To(Equal("0"))
+			Expect(*template.BlockDevices[0].DiskImage.Capacity).To(Equal(25))
+			Expect(*template.NetworkComponents[0].MaxSpeed).To(Equal(10))
+			Expect(*template.PrimaryNetworkComponent.NetworkVlan.Id).To(Equal(1421723))
+			Expect(*template.PrimaryBackendNetworkComponent.NetworkVlan.Id).To(Equal(1421725))
+			Expect(*template.UserData[0].Value).To(Equal("myuserdata"))
+			Expect(managers.InstanceTemplateTags(template)).To(Equal([]string{"production"}))
+			Expect(fakeHandler.ApiCallLogs[0].Options.Mask).To(ContainSubstring("billingItem.orderItem.preset.keyName"))
+		})
+		It("Returns the API error", func() {
+			fakeHandler.AddApiError("SoftLayer_Virtual_Guest", "getObject", 500, "Internal Server Error")
+			_, err := vsManager.ExportInstanceTemplate(25804753)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Internal Server Error"))
+		})
+	})
+
 	Describe("Capture instance to an image", func() {
 		Context("Capture instance to an image", func() {
 			It("It returns no err and a transaction", func() {
@@ -345,7 +376,7 @@ var _ = Describe("VirtualServerManager", func() {
 	Describe("List virtual guest instance under current acount", func() {
 		Context("List all virtual guest instance under current acount", func() {
 			It("It returns a list of virtual guest instances", func() {
-				vss, err := vsManager.ListInstances(false, false, "", "", "", "", "", "", 0, 0, 0, 0, nil, "")
+				vss, err := vsManager.ListInstances(false, false, "", "", "", "", "", "", 0, 0, 0, 0, nil, "", metadata.Paging{})
 				Expect(err).ToNot(HaveOccurred())
 				for _, vs := range vss {
 					Expect(*vs.Account.Id).To(Equal(278444))
@@ -355,7 +386,7 @@ var _ = Describe("VirtualServerManager", func() {
 		})
 		Context("List hourly-billed virtual guest instance under current acount", func() {
 			It("It returns a list of hourly-billed virtual guest instances", func() {
-				vss, err := vsManager.ListInstances(true, false, "", "", "", "", "", "", 0, 0, 0, 0, nil, "")
+				vss, err := vsManager.ListInstances(true, false, "", "", "", "", "", "", 0, 0, 0, 0, nil, "", metadata.Paging{})
 				Expect(err).ToNot(HaveOccurred())
 				for _, vs := range vss {
 					Expect(*vs.Account.Id).To(Equal(278444))
@@ -365,7 +396,7 @@ var _ = Describe("VirtualServerManager", func() {
 		})
 		Context("List monthly-billed virtual guest instance under current acount", func() {
 			It("It returns a list of monthly-billed virtual guest instances", func() {
-				vss, err := vsManager.ListInstances(false, true, "", "", "", "", "", "", 0, 0, 0, 0, nil, "")
+				vss, err := vsManager.ListInstances(false, true, "", "", "", "", "", "", 0, 0, 0, 0, nil, "", metadata.Paging{})
 				Expect(err).ToNot(HaveOccurred())
 				for _, vs := range vss {
 					Expect(*vs.Account.Id).To(Equal(278444))
@@ -377,7 +408,7 @@ var _ = Describe("VirtualServerManager", func() {
 			It("Builds object filters properly", func() {
 				vss, err := vsManager.ListInstances(
 					false, false, "testdomain", "hostnametest", "dctest", "1.2.3.4", "5.6.7.8", "testuser",
-					10, 22, 99, 777, []string{"tag1"}, "mask[id]",
+					10, 22, 99, 777, []string{"tag1"}, "mask[id]", metadata.Paging{},
 				)
 				Expect(len(vss)).To(Equal(4))
 				Expect(err).ToNot(HaveOccurred())
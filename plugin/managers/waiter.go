@@ -0,0 +1,265 @@
+package managers
+
+import (
+	"fmt"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
+)
+
+// The checks for cmdutils.WaitFor. Each one looks at the active transactions and
+// provisioning dates of an object.
+
+// Checks once whether an operation finished. status tells how far along it is, for the progress output.
+type WaitCheck func() (done bool, status string, err error)
+
+const (
+	// Billing_Order statuses
+	ORDER_STATUS_APPROVED  = "APPROVED"
+	ORDER_STATUS_COMPLETE  = "COMPLETE"
+	ORDER_STATUS_CANCELLED = "CANCELLED"
+	// Provisioning_Version1_Transaction_Status of a finished transaction
+	TRANSACTION_STATUS_COMPLETE = "COMPLETE"
+	// Virtual_Guest_Power_State of a server that isn't running
+	POWER_STATE_HALTED = "HALTED"
+	POWER_STATE_PAUSED = "PAUSED"
+
+	VIRTUAL_GUEST_WAIT_MASK = "mask[id,provisionDate,powerState[keyName],activeTransaction[id,transactionStatus[name]],lastTransaction[id]]"
+	HARDWARE_WAIT_MASK      = "mask[id,provisionDate,activeTransaction[id,transactionStatus[name]],lastTransaction[id]]"
+)
+
+// Done when every check is done. The checks run in order, and stop at the first one that isn't.
+func AllDone(checks ...WaitCheck) WaitCheck {
+	return func() (bool, string, error) {
+		for _, check := range checks {
+			done, status, err := check()
+			if err != nil || !done {
+				return done, status, err
+			}
+		}
+		return true, "", nil
+	}
+}
+
+// Done when the virtual server is provisioned, has no active transaction and isn't halted or paused
+func VirtualGuestReadyCheck(manager VirtualServerManager, id int) WaitCheck {
+	return func() (bool, string, error) {
+		guest, err := manager.GetInstance(id, VIRTUAL_GUEST_WAIT_MASK)
+		if err != nil {
+			return false, "", err
+		}
+		return virtualGuestReady(guest)
+	}
+}
+
+// Like VirtualGuestReadyCheck, for an operation on a server that was ready already (a reload or an upgrade).
+// Its transaction usually isn't on the server yet when the API call returns, so the server only counts as ready
+// once a transaction was active, or its last transaction isn't lastTransactionId anymore (see VirtualGuestLastTransactionId).
+func VirtualGuestOperationCheck(manager VirtualServerManager, id int, lastTransactionId int) WaitCheck {
+	started := false
+	return func() (bool, string, error) {
+		guest, err := manager.GetInstance(id, VIRTUAL_GUEST_WAIT_MASK)
+		if err != nil {
+			return false, "", err
+		}
+		started = started || guest.ActiveTransaction != nil || transactionId(guest.LastTransaction) != lastTransactionId
+		if !started {
+			return false, T("Waiting for the operation to start"), nil
+		}
+		return virtualGuestReady(guest)
+	}
+}
+
+// The ID of the last transaction of the virtual server, 0 if it never had one. Taken before an operation for VirtualGuestOperationCheck.
+func VirtualGuestLastTransactionId(manager VirtualServerManager, id int) (int, error) {
+	guest, err := manager.GetInstance(id, "mask[id,lastTransaction[id]]")
+	if err != nil {
+		return 0, err
+	}
+	return transactionId(guest.LastTransaction), nil
+}
+
+// Done when the hardware server is provisioned and has no active transaction
+func HardwareReadyCheck(manager HardwareServerManager, id int) WaitCheck {
+	return func() (bool, string, error) {
+		hardware, err := manager.GetHardware(id, HARDWARE_WAIT_MASK)
+		if err != nil {
+			return false, "", err
+		}
+		return hardwareReady(hardware)
+	}
+}
+
+// Like HardwareReadyCheck, for an operation on a server that was ready already (a reload), see VirtualGuestOperationCheck
+func HardwareOperationCheck(manager HardwareServerManager, id int, lastTransactionId int) WaitCheck {
+	started := false
+	return func() (bool, string, error) {
+		hardware, err := manager.GetHardware(id, HARDWARE_WAIT_MASK)
+		if err != nil {
+			return false, "", err
+		}
+		started = started || hardware.ActiveTransaction != nil || transactionId(hardware.LastTransaction) != lastTransactionId
+		if !started {
+			return false, T("Waiting for the operation to start"), nil
+		}
+		return hardwareReady(hardware)
+	}
+}
+
+// The ID of the last transaction of the hardware server, 0 if it never had one. Taken before an operation for HardwareOperationCheck.
+func HardwareLastTransactionId(manager HardwareServerManager, id int) (int, error) {
+	hardware, err := manager.GetHardware(id, "mask[id,lastTransaction[id]]")
+	if err != nil {
+		return 0, err
+	}
+	return transactionId(hardware.LastTransaction), nil
+}
+
+// Done when the volume has no active transaction and isn't being provisioned.
+// volumeType is VOLUME_TYPE_BLOCK or VOLUME_TYPE_FILE
+func VolumeReadyCheck(manager StorageManager, volumeType string, id int) WaitCheck {
+	mask := "mask[id,activeTransactionCount,activeTransactions[id,transactionStatus[name]],isProvisionInProgress]"
+	return func() (bool, string, error) {
+		volume, err := manager.GetVolumeDetails(volumeType, id, mask)
+		if err != nil {
+			return false, "", err
+		}
+		if len(volume.ActiveTransactions) > 0 {
+			return false, transactionStatus(&volume.ActiveTransactions[0]), nil
+		}
+		if volume.ActiveTransactionCount != nil && *volume.ActiveTransactionCount > 0 {
+			return false, T("{{.COUNT}} active transactions", map[string]interface{}{"COUNT": *volume.ActiveTransactionCount}), nil
+		}
+		if volume.IsProvisionInProgress != nil && *volume.IsProvisionInProgress {
+			return false, T("Provisioning"), nil
+		}
+		return true, "", nil
+	}
+}
+
+// Like VolumeReadyCheck, for an operation on a volume that was ready already (a snapshot restore). Volumes don't have
+// a last transaction to compare with, so the volume only counts as ready after one of its checks wasn't.
+func VolumeOperationCheck(manager StorageManager, volumeType string, id int) WaitCheck {
+	ready := VolumeReadyCheck(manager, volumeType, id)
+	started := false
+	return func() (bool, string, error) {
+		done, status, err := ready()
+		if err != nil {
+			return false, "", err
+		}
+		if !done {
+			started = true
+			return false, status, nil
+		}
+		if !started {
+			return false, T("Waiting for the operation to start"), nil
+		}
+		return true, "", nil
+	}
+}
+
+// Done when the image has been copied to its datacenters and none of the copies has a transaction
+func ImageReadyCheck(manager ImageManager, id int) WaitCheck {
+	return func() (bool, string, error) {
+		image, err := manager.GetImage(id)
+		if err != nil {
+			return false, "", err
+		}
+		if len(image.Children) == 0 {
+			return false, utils.StringPointertoString(statusName(image.Status)), nil
+		}
+		for _, child := range image.Children {
+			if child.Transaction != nil {
+				return false, transactionStatus(child.Transaction), nil
+			}
+		}
+		return true, "", nil
+	}
+}
+
+// Done when the order is approved and everything on it is provisioned. A cancelled order is an error.
+func OrderReadyCheck(manager OrderManager, orderId int) WaitCheck {
+	mask := "mask[id,status,orderTopLevelItems[id,description,billingItem[id,provisionTransaction[id,transactionStatus[name]]]]]"
+	return func() (bool, string, error) {
+		order, err := manager.GetOrderDetail(orderId, mask)
+		if err != nil {
+			return false, "", err
+		}
+		status := utils.StringPointertoString(order.Status)
+		if status == ORDER_STATUS_CANCELLED {
+			return false, status, errors.New(T("Order {{.OrderID}} was cancelled.", map[string]interface{}{"OrderID": orderId}))
+		}
+		if status != ORDER_STATUS_APPROVED && status != ORDER_STATUS_COMPLETE {
+			return false, status, nil
+		}
+		for _, item := range order.OrderTopLevelItems {
+			description := utils.StringPointertoString(item.Description)
+			if item.BillingItem == nil {
+				return false, fmt.Sprintf("%s: %s", description, T("Waiting for the billing item")), nil
+			}
+			transaction := item.BillingItem.ProvisionTransaction
+			if transaction != nil && utils.StringPointertoString(transactionStatusName(transaction)) != TRANSACTION_STATUS_COMPLETE {
+				return false, fmt.Sprintf("%s: %s", description, transactionStatus(transaction)), nil
+			}
+		}
+		return true, "", nil
+	}
+}
+
+func virtualGuestReady(guest datatypes.Virtual_Guest) (bool, string, error) {
+	if guest.ActiveTransaction != nil {
+		return false, transactionStatus(guest.ActiveTransaction), nil
+	}
+	if guest.ProvisionDate == nil {
+		return false, T("Provisioning"), nil
+	}
+	if guest.PowerState != nil {
+		powerState := utils.StringPointertoString(guest.PowerState.KeyName)
+		if powerState == POWER_STATE_HALTED || powerState == POWER_STATE_PAUSED {
+			return false, powerState, nil
+		}
+	}
+	return true, "", nil
+}
+
+func hardwareReady(hardware datatypes.Hardware_Server) (bool, string, error) {
+	if hardware.ActiveTransaction != nil {
+		return false, transactionStatus(hardware.ActiveTransaction), nil
+	}
+	if hardware.ProvisionDate == nil {
+		return false, T("Provisioning"), nil
+	}
+	return true, "", nil
+}
+
+func transactionId(transaction *datatypes.Provisioning_Version1_Transaction) int {
+	if transaction == nil {
+		return 0
+	}
+	return utils.IntPointertoInt(transaction.Id)
+}
+
+func transactionStatus(transaction *datatypes.Provisioning_Version1_Transaction) string {
+	name := transactionStatusName(transaction)
+	if name == nil {
+		return T("Active transaction")
+	}
+	return *name
+}
+
+func transactionStatusName(transaction *datatypes.Provisioning_Version1_Transaction) *string {
+	if transaction == nil || transaction.TransactionStatus == nil {
+		return nil
+	}
+	return transaction.TransactionStatus.Name
+}
+
+func statusName(status *datatypes.Virtual_Guest_Block_Device_Template_Group_Status) *string {
+	if status == nil {
+		return nil
+	}
+	return status.Name
+}
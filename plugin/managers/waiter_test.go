@@ -0,0 +1,250 @@
+package managers_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/sl"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
+)
+
+var _ = Describe("Wait checks", func() {
+	activeTransaction := &datatypes.Provisioning_Version1_Transaction{
+		Id:                sl.Int(1),
+		TransactionStatus: &datatypes.Provisioning_Version1_Transaction_Status{Name: sl.String("CLOUD_CONFIGURE")},
+	}
+	provisioned := &datatypes.Time{}
+
+	Describe("VirtualGuestReadyCheck", func() {
+		var fakeVSManager *testhelpers.FakeVirtualServerManager
+		BeforeEach(func() {
+			fakeVSManager = new(testhelpers.FakeVirtualServerManager)
+		})
+		It("Waits for the active transaction", func() {
+			fakeVSManager.GetInstanceReturns(datatypes.Virtual_Guest{ProvisionDate: provisioned, ActiveTransaction: activeTransaction}, nil)
+			done, status, err := managers.VirtualGuestReadyCheck(fakeVSManager, 1234)()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(done).To(BeFalse())
+			Expect(status).To(Equal("CLOUD_CONFIGURE"))
+			id, _ := fakeVSManager.GetInstanceArgsForCall(0)
+			Expect(id).To(Equal(1234))
+		})
+		It("Waits for the provision date", func() {
+			fakeVSManager.GetInstanceReturns(datatypes.Virtual_Guest{}, nil)
+			done, status, _ := managers.VirtualGuestReadyCheck(fakeVSManager, 1234)()
+			Expect(done).To(BeFalse())
+			Expect(status).To(Equal("Provisioning"))
+		})
+		It("Is done once the server is provisioned", func() {
+			fakeVSManager.GetInstanceReturns(datatypes.Virtual_Guest{ProvisionDate: provisioned}, nil)
+			done, _, err := managers.VirtualGuestReadyCheck(fakeVSManager, 1234)()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(done).To(BeTrue())
+		})
+		It("Isn't done while the server is halted or paused", func() {
+			for _, powerState := range []string{"HALTED", "PAUSED"} {
+				fakeVSManager.GetInstanceReturns(datatypes.Virtual_Guest{
+					ProvisionDate: provisioned,
+					PowerState:    &datatypes.Virtual_Guest_Power_State{KeyName: sl.String(powerState)},
+				}, nil)
+				done, status, _ := managers.VirtualGuestReadyCheck(fakeVSManager, 1234)()
+				Expect(done).To(BeFalse())
+				Expect(status).To(Equal(powerState))
+			}
+		})
+	})
+
+	Describe("VirtualGuestOperationCheck", func() {
+		var fakeVSManager *testhelpers.FakeVirtualServerManager
+		lastTransaction := func(id int) *datatypes.Provisioning_Version1_Transaction {
+			return &datatypes.Provisioning_Version1_Transaction{Id: sl.Int(id)}
+		}
+		BeforeEach(func() {
+			fakeVSManager = new(testhelpers.FakeVirtualServerManager)
+		})
+		It("Isn't done before the operation's transaction shows up", func() {
+			fakeVSManager.GetInstanceReturns(datatypes.Virtual_Guest{ProvisionDate: provisioned, LastTransaction: lastTransaction(7)}, nil)
+			done, status, err := managers.VirtualGuestOperationCheck(fakeVSManager, 1234, 7)()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(done).To(BeFalse())
+			Expect(status).To(Equal("Waiting for the operation to start"))
+		})
+		It("Is done once a transaction was active and the server is ready", func() {
+			check := managers.VirtualGuestOperationCheck(fakeVSManager, 1234, 7)
+			fakeVSManager.GetInstanceReturns(datatypes.Virtual_Guest{ProvisionDate: provisioned, ActiveTransaction: activeTransaction}, nil)
+			done, status, _ := check()
+			Expect(done).To(BeFalse())
+			Expect(status).To(Equal("CLOUD_CONFIGURE"))
+			// A transaction that ran between two checks only shows up as the last transaction
+			fakeVSManager.GetInstanceReturns(datatypes.Virtual_Guest{ProvisionDate: provisioned, LastTransaction: lastTransaction(7)}, nil)
+			done, _, _ = check()
+			Expect(done).To(BeTrue())
+		})
+		It("Is done when the last transaction changed", func() {
+			fakeVSManager.GetInstanceReturns(datatypes.Virtual_Guest{ProvisionDate: provisioned, LastTransaction: lastTransaction(8)}, nil)
+			done, _, err := managers.VirtualGuestOperationCheck(fakeVSManager, 1234, 7)()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(done).To(BeTrue())
+		})
+		It("Finds the last transaction before the operation", func() {
+			fakeVSManager.GetInstanceReturns(datatypes.Virtual_Guest{LastTransaction: lastTransaction(7)}, nil)
+			id, err := managers.VirtualGuestLastTransactionId(fakeVSManager, 1234)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id).To(Equal(7))
+			fakeVSManager.GetInstanceReturns(datatypes.Virtual_Guest{}, nil)
+			id, _ = managers.VirtualGuestLastTransactionId(fakeVSManager, 1234)
+			Expect(id).To(Equal(0))
+		})
+	})
+
+	Describe("HardwareReadyCheck", func() {
+		It("Is done once the server is provisioned", func() {
+			fakeHardwareManager := new(testhelpers.FakeHardwareServerManager)
+			fakeHardwareManager.GetHardwareReturns(datatypes.Hardware_Server{Hardware: datatypes.Hardware{ProvisionDate: provisioned}}, nil)
+			done, _, err := managers.HardwareReadyCheck(fakeHardwareManager, 99)()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(done).To(BeTrue())
+		})
+	})
+
+	Describe("HardwareOperationCheck", func() {
+		It("Is done once the last transaction changed and the server is ready", func() {
+			fakeHardwareManager := new(testhelpers.FakeHardwareServerManager)
+			check := managers.HardwareOperationCheck(fakeHardwareManager, 99, 7)
+			fakeHardwareManager.GetHardwareReturns(datatypes.Hardware_Server{Hardware: datatypes.Hardware{
+				ProvisionDate:   provisioned,
+				LastTransaction: &datatypes.Provisioning_Version1_Transaction{Id: sl.Int(7)},
+			}}, nil)
+			done, status, _ := check()
+			Expect(done).To(BeFalse())
+			Expect(status).To(Equal("Waiting for the operation to start"))
+			fakeHardwareManager.GetHardwareReturns(datatypes.Hardware_Server{Hardware: datatypes.Hardware{
+				ProvisionDate:   provisioned,
+				LastTransaction: &datatypes.Provisioning_Version1_Transaction{Id: sl.Int(8)},
+			}}, nil)
+			done, _, err := check()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(done).To(BeTrue())
+		})
+	})
+
+	Describe("VolumeOperationCheck", func() {
+		It("Is done after the volume had a transaction", func() {
+			fakeStorageManager := new(testhelpers.FakeStorageManager)
+			check := managers.VolumeOperationCheck(fakeStorageManager, "block", 5)
+			fakeStorageManager.GetVolumeDetailsReturns(datatypes.Network_Storage{ActiveTransactionCount: sl.Uint(0)}, nil)
+			done, status, _ := check()
+			Expect(done).To(BeFalse())
+			Expect(status).To(Equal("Waiting for the operation to start"))
+			fakeStorageManager.GetVolumeDetailsReturns(datatypes.Network_Storage{ActiveTransactionCount: sl.Uint(1)}, nil)
+			done, _, _ = check()
+			Expect(done).To(BeFalse())
+			fakeStorageManager.GetVolumeDetailsReturns(datatypes.Network_Storage{ActiveTransactionCount: sl.Uint(0)}, nil)
+			done, _, err := check()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(done).To(BeTrue())
+		})
+	})
+
+	Describe("VolumeReadyCheck", func() {
+		It("Waits for the active transactions", func() {
+			fakeStorageManager := new(testhelpers.FakeStorageManager)
+			fakeStorageManager.GetVolumeDetailsReturns(datatypes.Network_Storage{ActiveTransactionCount: sl.Uint(2)}, nil)
+			done, status, err := managers.VolumeReadyCheck(fakeStorageManager, "block", 5)()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(done).To(BeFalse())
+			Expect(status).To(Equal("2 active transactions"))
+			volumeType, id, _ := fakeStorageManager.GetVolumeDetailsArgsForCall(0)
+			Expect(volumeType).To(Equal("block"))
+			Expect(id).To(Equal(5))
+
+			fakeStorageManager.GetVolumeDetailsReturns(datatypes.Network_Storage{ActiveTransactionCount: sl.Uint(0)}, nil)
+			done, _, _ = managers.VolumeReadyCheck(fakeStorageManager, "block", 5)()
+			Expect(done).To(BeTrue())
+		})
+	})
+
+	Describe("ImageReadyCheck", func() {
+		It("Waits until no copy of the image has a transaction", func() {
+			fakeImageManager := new(testhelpers.FakeImageManager)
+			fakeImageManager.GetImageReturns(datatypes.Virtual_Guest_Block_Device_Template_Group{
+				Children: []datatypes.Virtual_Guest_Block_Device_Template_Group{{}, {Transaction: activeTransaction}},
+			}, nil)
+			done, status, _ := managers.ImageReadyCheck(fakeImageManager, 7)()
+			Expect(done).To(BeFalse())
+			Expect(status).To(Equal("CLOUD_CONFIGURE"))
+
+			fakeImageManager.GetImageReturns(datatypes.Virtual_Guest_Block_Device_Template_Group{
+				Children: []datatypes.Virtual_Guest_Block_Device_Template_Group{{}, {}},
+			}, nil)
+			done, _, _ = managers.ImageReadyCheck(fakeImageManager, 7)()
+			Expect(done).To(BeTrue())
+		})
+	})
+
+	Describe("OrderReadyCheck", func() {
+		var fakeOrderManager *testhelpers.FakeOrderManager
+		BeforeEach(func() {
+			fakeOrderManager = new(testhelpers.FakeOrderManager)
+		})
+		It("Waits for approval", func() {
+			fakeOrderManager.GetOrderDetailReturns(datatypes.Billing_Order{Status: sl.String("PENDING_AUTO_APPROVAL")}, nil)
+			done, status, err := managers.OrderReadyCheck(fakeOrderManager, 11)()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(done).To(BeFalse())
+			Expect(status).To(Equal("PENDING_AUTO_APPROVAL"))
+		})
+		It("Waits for the provisioning transactions", func() {
+			fakeOrderManager.GetOrderDetailReturns(datatypes.Billing_Order{
+				Status: sl.String("APPROVED"),
+				OrderTopLevelItems: []datatypes.Billing_Order_Item{{
+					Description: sl.String("Dual Intel Xeon"),
+					BillingItem: &datatypes.Billing_Item{ProvisionTransaction: activeTransaction},
+				}},
+			}, nil)
+			done, status, _ := managers.OrderReadyCheck(fakeOrderManager, 11)()
+			Expect(done).To(BeFalse())
+			Expect(status).To(Equal("Dual Intel Xeon: CLOUD_CONFIGURE"))
+		})
+		It("Is done when every item is provisioned", func() {
+			complete := &datatypes.Provisioning_Version1_Transaction{TransactionStatus: &datatypes.Provisioning_Version1_Transaction_Status{Name: sl.String("COMPLETE")}}
+			fakeOrderManager.GetOrderDetailReturns(datatypes.Billing_Order{
+				Status: sl.String("COMPLETE"),
+				OrderTopLevelItems: []datatypes.Billing_Order_Item{
+					{BillingItem: &datatypes.Billing_Item{ProvisionTransaction: complete}},
+					{BillingItem: &datatypes.Billing_Item{}},
+				},
+			}, nil)
+			done, _, err := managers.OrderReadyCheck(fakeOrderManager, 11)()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(done).To(BeTrue())
+		})
+		It("Fails for a cancelled order", func() {
+			fakeOrderManager.GetOrderDetailReturns(datatypes.Billing_Order{Status: sl.String("CANCELLED")}, nil)
+			_, _, err := managers.OrderReadyCheck(fakeOrderManager, 11)()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("Order 11 was cancelled."))
+		})
+	})
+
+	Describe("AllDone", func() {
+		It("Stops at the first check that isn't done", func() {
+			calls := 0
+			first := func() (bool, string, error) { calls++; return false, "first", nil }
+			second := func() (bool, string, error) { calls++; return true, "", nil }
+			done, status, _ := managers.AllDone(second, first, second)()
+			Expect(done).To(BeFalse())
+			Expect(status).To(Equal("first"))
+			Expect(calls).To(Equal(2))
+		})
+		It("Returns errors", func() {
+			failing := func() (bool, string, error) { return false, "", errors.New("Internal Server Error") }
+			_, _, err := managers.AllDone(failing)()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
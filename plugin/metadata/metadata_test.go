@@ -1,13 +1,17 @@
 package metadata_test
 
 import (
+	"io"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"testing"
 
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
+	"github.com/spf13/cobra"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/testhelpers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
 )
 
 func TestMetadata(t *testing.T) {
@@ -45,6 +49,35 @@ var _ = Describe("SL Metadata Tests", func() {
 				Expect(result.String()).To(Equal("JSON"))
 				Expect(result.Type()).To(Equal("string"))
 			})
+			It("Setting YAML and template output", func() {
+				slCommand := metadata.NewSoftlayerCommand(fakeUI, fakeSession)
+				err := slCommand.OutputFlag.Set("yaml")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(slCommand.OutputFlag.String()).To(Equal("YAML"))
+				Expect(slCommand.GetOutputFlag()).To(Equal("JSON"))
+				Expect(utils.StructuredOutput.Format).To(Equal(utils.StructuredYAML))
+				err = slCommand.OutputFlag.Set("template={{.Id}} {{.Hostname}}")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(slCommand.OutputFlag.String()).To(Equal("TEMPLATE"))
+				Expect(slCommand.GetOutputFlag()).To(Equal("JSON"))
+				Expect(utils.StructuredOutput.Template).NotTo(BeNil())
+				err = slCommand.OutputFlag.Set("csv")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(slCommand.GetOutputFlag()).To(Equal("CSV"))
+				Expect(utils.StructuredOutput.Format).To(Equal(utils.StructuredJSON))
+			})
+			It("Setting --query", func() {
+				slCommand := metadata.NewSoftlayerCommand(fakeUI, fakeSession)
+				Expect(slCommand.GetOutputFlag()).To(Equal(""))
+				err := slCommand.QueryFlag.Set("[].id")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(slCommand.QueryFlag.String()).To(Equal("[].id"))
+				Expect(slCommand.GetOutputFlag()).To(Equal("JSON"))
+				Expect(utils.StructuredOutput.Query).NotTo(BeNil())
+				err = slCommand.QueryFlag.Set("[?id==")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Invalid query"))
+			})
 		})
 		Context("Can create a New SoftLayer Command", func() {
 			It("NewSoftlayerCommand", func() {
@@ -60,4 +93,84 @@ var _ = Describe("SL Metadata Tests", func() {
 		})
 	})
 
+	Describe("Paging flags", func() {
+		var (
+			paging metadata.Paging
+			cmd    *cobra.Command
+		)
+		BeforeEach(func() {
+			paging = metadata.Paging{}
+			cmd = &cobra.Command{Use: "list", RunE: func(cmd *cobra.Command, args []string) error { return nil }}
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+			metadata.AddPagingFlags(cmd, &paging)
+		})
+		It("Defaults to every result", func() {
+			cmd.SetArgs([]string{})
+			Expect(cmd.Execute()).To(Succeed())
+			Expect(paging).To(Equal(metadata.Paging{}))
+		})
+		It("Sets limit and offset", func() {
+			cmd.SetArgs([]string{"--limit", "10", "--offset", "20"})
+			Expect(cmd.Execute()).To(Succeed())
+			Expect(paging).To(Equal(metadata.Paging{Limit: 10, Offset: 20}))
+		})
+		It("Sets all", func() {
+			cmd.SetArgs([]string{"--all"})
+			Expect(cmd.Execute()).To(Succeed())
+			Expect(paging.All).To(BeTrue())
+		})
+		It("Rejects negative numbers", func() {
+			cmd.SetArgs([]string{"--limit", "-1"})
+			err := cmd.Execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("The value must be 0 or a positive integer."))
+			cmd.SetArgs([]string{"--offset", "abc"})
+			Expect(cmd.Execute()).NotTo(Succeed())
+		})
+		It("Rejects --all with --limit or --offset", func() {
+			cmd.SetArgs([]string{"--all", "--limit", "5"})
+			err := cmd.Execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("all"))
+			cmd.SetArgs([]string{"--all", "--offset", "5"})
+			Expect(cmd.Execute()).NotTo(Succeed())
+		})
+		It("Can default to the first results", func() {
+			limited := metadata.Paging{}
+			limitedCmd := &cobra.Command{Use: "list", RunE: func(cmd *cobra.Command, args []string) error { return nil }}
+			metadata.AddLimitedPagingFlags(limitedCmd, &limited, 50, "l")
+			limitedCmd.SetArgs([]string{})
+			Expect(limitedCmd.Execute()).To(Succeed())
+			Expect(limited).To(Equal(metadata.Paging{Limit: 50}))
+			Expect(limitedCmd.Flags().Lookup("all").Usage).To(ContainSubstring("instead of the first 50"))
+			limitedCmd.SetArgs([]string{"--all"})
+			Expect(limitedCmd.Execute()).To(Succeed())
+			Expect(limited.All).To(BeTrue())
+		})
+		It("Keeps the old --limit of limited lists working", func() {
+			limited := metadata.Paging{}
+			limitedCmd := &cobra.Command{Use: "list", RunE: func(cmd *cobra.Command, args []string) error { return nil }}
+			limitedCmd.SetOut(io.Discard)
+			limitedCmd.SetErr(io.Discard)
+			metadata.AddLimitedPagingFlags(limitedCmd, &limited, 50, "l")
+			limitedCmd.SetArgs([]string{"-l", "10"})
+			Expect(limitedCmd.Execute()).To(Succeed())
+			Expect(limited).To(Equal(metadata.Paging{Limit: 10}))
+			limitedCmd.SetArgs([]string{"--limit", "-1"})
+			Expect(limitedCmd.Execute()).To(Succeed())
+			Expect(limited.All).To(BeTrue())
+			limitedCmd.SetArgs([]string{"--limit", "-2"})
+			Expect(limitedCmd.Execute()).NotTo(Succeed())
+		})
+		It("Takes --all with --limit on limited lists", func() {
+			limited := metadata.Paging{}
+			limitedCmd := &cobra.Command{Use: "list", RunE: func(cmd *cobra.Command, args []string) error { return nil }}
+			metadata.AddLimitedPagingFlags(limitedCmd, &limited, 50, "")
+			limitedCmd.SetArgs([]string{"--limit", "10", "--all"})
+			Expect(limitedCmd.Execute()).To(Succeed())
+			Expect(limited).To(Equal(metadata.Paging{Limit: 10, All: true}))
+			Expect(limitedCmd.Flags().Lookup("limit").Shorthand).To(Equal(""))
+		})
+	})
 })
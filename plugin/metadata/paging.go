@@ -0,0 +1,97 @@
+package metadata
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+)
+
+// Which results a list command shows, from the --limit, --offset and --all flags.
+// The zero value is every result, which is what list commands show when none of the flags are given.
+type Paging struct {
+	// Most results to show, 0 for no limit
+	Limit int
+	// Results to skip before the first one shown
+	Offset int
+	// Every result, Limit and Offset are ignored when it is set
+	All bool
+}
+
+// Adds --limit, --offset and --all to a list command, the values end up in paging.
+func AddPagingFlags(cmd *cobra.Command, paging *Paging) {
+	cmd.Flags().Var(&nonNegativeIntFlag{Value: &paging.Limit}, "limit",
+		T("Show at most this many results, 0 shows all of them"))
+	cmd.Flags().Var(&nonNegativeIntFlag{Value: &paging.Offset}, "offset",
+		T("Skip this many results before the first one shown"))
+	cmd.Flags().BoolVar(&paging.All, "all", false,
+		T("Show every result, this is the default without --limit and --offset"))
+	cmd.MarkFlagsMutuallyExclusive("all", "limit")
+	cmd.MarkFlagsMutuallyExclusive("all", "offset")
+}
+
+// Like AddPagingFlags, for lists that are too long to show all of by default (like event logs).
+// Without any of the flags the first limit results are shown.
+// These commands had a --limit of their own before the paging flags were shared, so scripts written for them
+// keep working: shorthand is the shorthand their --limit had (empty for none), --limit -1 is a deprecated
+// way of saying --all, and --all can be given with --limit and --offset, it wins over them.
+func AddLimitedPagingFlags(cmd *cobra.Command, paging *Paging, limit int, shorthand string) {
+	paging.Limit = limit
+	cmd.Flags().VarP(&limitFlag{Paging: paging}, "limit", shorthand,
+		T("Show at most this many results, 0 shows all of them. -1 is the same as --all and is deprecated"))
+	cmd.Flags().Var(&nonNegativeIntFlag{Value: &paging.Offset}, "offset",
+		T("Skip this many results before the first one shown"))
+	cmd.Flags().BoolVar(&paging.All, "all", false,
+		T("Show every result instead of the first {{.LIMIT}}, there may be a lot of them", map[string]interface{}{"LIMIT": limit}))
+}
+
+// pflag.Value for the paging flags, which can't be negative
+type nonNegativeIntFlag struct {
+	Value *int
+}
+
+func (f *nonNegativeIntFlag) String() string {
+	if f.Value == nil {
+		return "0"
+	}
+	return strconv.Itoa(*f.Value)
+}
+
+func (f *nonNegativeIntFlag) Set(value string) error {
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 0 {
+		return errors.NewInvalidUsageError(T("The value must be 0 or a positive integer."))
+	}
+	*f.Value = number
+	return nil
+}
+
+func (f *nonNegativeIntFlag) Type() string {
+	return "int"
+}
+
+// pflag.Value for the --limit of AddLimitedPagingFlags, which takes -1 for every result
+type limitFlag struct {
+	Paging *Paging
+}
+
+func (f *limitFlag) String() string {
+	if f.Paging == nil {
+		return "0"
+	}
+	return strconv.Itoa(f.Paging.Limit)
+}
+
+func (f *limitFlag) Set(value string) error {
+	if value == "-1" {
+		f.Paging.All = true
+		return nil
+	}
+	return (&nonNegativeIntFlag{Value: &f.Paging.Limit}).Set(value)
+}
+
+func (f *limitFlag) Type() string {
+	return "int"
+}
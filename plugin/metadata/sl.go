@@ -8,8 +8,10 @@ import (
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/plugin"
 
 	"github.com/softlayer/softlayer-go/session"
+	"github.com/spf13/cobra"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
 )
 
 var (
@@ -24,29 +26,58 @@ var (
 
 const OutputJSON = "JSON"
 const OutputCSV = "CSV"
+const OutputYAML = "YAML"
+const OutputTemplate = "TEMPLATE"
 
 var SupportedOutputFormat = []string{
 	OutputJSON,
 	OutputCSV,
+	OutputYAML,
 	//define supported output format here in UPPER case...
 }
 
+// --output=template=<GO TEMPLATE>, the template itself is case sensitive so it is handled on its own.
+const OutputTemplatePrefix = "TEMPLATE="
+
+// Flags with this annotation are set to the datacenter of the active profile when they aren't given.
+// Only used on commands that create something, where the datacenter is required.
+const ProfileDatacenterAnnotation = "sl_profile_datacenter"
+
+// Marks the flagName flag of cmd with ProfileDatacenterAnnotation
+func UseProfileDatacenter(cmd *cobra.Command, flagName string) {
+	_ = cmd.Flags().SetAnnotation(flagName, ProfileDatacenterAnnotation, []string{"true"})
+}
+
 // SoftLayer Base Command
 type SoftlayerCommand struct {
 	UI         terminal.UI
 	Session    *session.Session
 	OutputFlag *CobraOutputFlag
+	QueryFlag  *CobraQueryFlag
 }
 
 func NewSoftlayerCommand(ui terminal.UI, session *session.Session) *SoftlayerCommand {
+	utils.ResetStructuredOutput()
 	return &SoftlayerCommand{
-		UI:         ui,
+		UI:         utils.NewStructuredUI(ui),
 		Session:    session,
 		OutputFlag: &CobraOutputFlag{""},
+		QueryFlag:  &CobraQueryFlag{""},
 	}
 }
+
+// YAML and TEMPLATE output are reported as JSON. Commands only need to know they should print structured data,
+// utils.PrintPrettyJSON and utils.PrintTable take care of the actual format.
+// --query without --output also means JSON, since queries only work on structured data.
 func (slcmd *SoftlayerCommand) GetOutputFlag() string {
-	return slcmd.OutputFlag.String()
+	output := slcmd.OutputFlag.String()
+	if output == OutputYAML || output == OutputTemplate {
+		return OutputJSON
+	}
+	if output == "" && slcmd.QueryFlag != nil && slcmd.QueryFlag.String() != "" {
+		return OutputJSON
+	}
+	return output
 }
 
 // SoftLayer Storage Command
@@ -85,21 +116,63 @@ func (o *CobraOutputFlag) String() string {
 	return o.Value
 }
 
+// True if p is something --output accepts
+func IsValidOutputFormat(p string) bool {
+	if strings.HasPrefix(strings.ToUpper(p), OutputTemplatePrefix) {
+		return true
+	}
+	return utils.StringInSlice(strings.ToUpper(p), SupportedOutputFormat) != -1
+}
+
 func (o *CobraOutputFlag) Set(p string) error {
+	if strings.HasPrefix(strings.ToUpper(p), OutputTemplatePrefix) {
+		err := utils.SetStructuredOutput(utils.StructuredTemplate, p[len(OutputTemplatePrefix):])
+		if err != nil {
+			return err
+		}
+		o.Value = OutputTemplate
+		return nil
+	}
 	p = strings.ToUpper(p)
 	for _, supported := range SupportedOutputFormat {
 		if p == supported {
 			o.Value = p
-			return nil
+			if p == OutputYAML {
+				return utils.SetStructuredOutput(utils.StructuredYAML, "")
+			}
+			return utils.SetStructuredOutput(utils.StructuredJSON, "")
 		}
 	}
-	return errors.NewInvalidUsageError(T("Invalid output format, only JSON is supported now."))
+	subs := map[string]interface{}{"FORMATS": strings.Join(append(SupportedOutputFormat, "template=<TEMPLATE>"), ", ")}
+	return errors.NewInvalidUsageError(T("Invalid output format, supported formats are: {{.FORMATS}}", subs))
 }
 
 func (o *CobraOutputFlag) Type() string {
 	return "string"
 }
 
+// --query flag, a JMESPath expression that gets checked when the flag is parsed.
+type CobraQueryFlag struct {
+	Value string
+}
+
+func (q *CobraQueryFlag) String() string {
+	return q.Value
+}
+
+func (q *CobraQueryFlag) Set(p string) error {
+	err := utils.SetStructuredQuery(p)
+	if err != nil {
+		return err
+	}
+	q.Value = p
+	return nil
+}
+
+func (q *CobraQueryFlag) Type() string {
+	return "string"
+}
+
 func GetVersion() plugin.VersionType {
 	versionSplit := strings.Split(PLUGIN_VERSION, ".")
 	var err error
@@ -1,6 +1,7 @@
 package plugin
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"reflect"
@@ -8,6 +9,7 @@ import (
 	"strings"
 	"bytes"
 	"text/template"
+	"time"
 
 	trace "github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/trace"
 
@@ -19,14 +21,19 @@ import (
 	"github.com/softlayer/softlayer-go/session"
 
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/client"
+	slErr "github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
 	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/account"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/bandwidth"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/batch"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/block"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/cache"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/callapi"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/cdn"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/completion"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/config"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/dedicatedhost"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/dns"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/email"
@@ -35,7 +42,9 @@ import (
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/firewall"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/globalip"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/hardware"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/history"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/image"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/inventory"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/licenses"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/commands/loadbal"
 
@@ -132,7 +141,7 @@ func (sl *SoftlayerPlugin) GetMetadata() plugin.PluginMetadata {
 		Name:       metadata.NS_SL_NAME,
 		Namespaces: Namespaces(),
 		// TODO change this to convert cobra commands to pluginCommands... maybe see if another plugin does this already???
-		Commands:      cobraToCLIMeta(GetTopCobraCommand(sl.ui, sl.session), metadata.NS_SL_NAME),
+		Commands:      append(cobraToCLIMeta(GetTopCobraCommand(sl.ui, sl.session), metadata.NS_SL_NAME), completionRequestMeta()),
 		Version:       metadata.GetVersion(),
 		SDKVersion:    metadata.GetSDKVersion(),
 		MinCliVersion: metadata.GetMinCLI(),
@@ -150,19 +159,36 @@ func (sl *SoftlayerPlugin) Run(context plugin.PluginContext, args []string) {
 	terminal.UserAskedForColors = context.ColorEnabled()
 	terminal.InitColorSupport()
 	sl.ui = terminal.NewStdUI()
-	sl.session, _ = client.NewSoftlayerClientSessionFromConfig(context)
-
-	cobraCommand := GetTopCobraCommand(sl.ui, sl.session)
 
 	// When the command comes in from the ibmcloud-cli it has `sl` in the Namespace, which we need to remove
 	args = append(strings.Split(context.CommandNamespace(), " "), args...)
 	if args[0] == "sl" || args[0] == "" {
 		args = args[1:]
 	}
+
+	// The session is made before cobra parses the flags, so --profile is looked for here
+	var sessionErr error
+	sl.session, sessionErr = client.NewSoftlayerClientSessionWithProfile(context, profileFlagValue(args))
+	jsonErrors := jsonOutputRequested(args)
+	if sessionErr != nil {
+		sl.fail(sessionErr, sessionErr.Error(), jsonErrors)
+	}
+
+	cobraCommand := GetTopCobraCommand(sl.ui, sl.session)
+	if transport, ok := client.GetCLITransport(sl.session); ok && transport.Journal != nil {
+		transport.Journal.CommandLine = client.RedactCommandLine(append([]string{"sl"}, args...), flagShorthands(cobraCommand, args))
+	}
 	// Gives Cobra the args we were given
 	cobraCommand.SetArgs(args)
 	cobraErr := cobraCommand.Execute()
 	if cobraErr != nil {
+		// Commands stop at the first request --dry-run blocks, that is the expected outcome, not a failure.
+		transport, ok := client.GetCLITransport(sl.session)
+		if ok && transport.DryRun && transport.IsDryRunError(cobraErr) {
+			sl.ui.Ok()
+			sl.ui.Print(T("Dry run, no changes were made."))
+			return
+		}
 		cobraErrorString := fmt.Sprintf("%v", cobraErr)
 		// Since we surpress the help message on errors, lets show the help message if the error is 'unknown flag'
 		helpTextTriggers := []string{
@@ -178,12 +204,34 @@ func (sl *SoftlayerPlugin) Run(context plugin.PluginContext, args []string) {
 				_ = realCommand.Help()
 			}
 		}
-		sl.ui.Failed(terminal.FailureColor(TranslateError(cobraErrorString)))
-		os.Exit(1)
+		sl.fail(cobraErr, TranslateError(cobraErrorString), jsonErrors)
 	}
 
 }
 
+// Prints message and exits with the exit code for err. With --output=JSON the error is printed
+// as a JSON object on stderr instead, so scripts can read the output of a failed command.
+func (sl *SoftlayerPlugin) fail(err error, message string, jsonErrors bool) {
+	details := slErr.GetErrorDetails(err, message)
+	if jsonErrors {
+		errorJSON, _ := json.MarshalIndent(map[string]slErr.ErrorDetails{"error": details}, "", "    ")
+		fmt.Fprintln(os.Stderr, string(errorJSON))
+	} else {
+		sl.ui.Failed(terminal.FailureColor(message))
+	}
+	os.Exit(details.ExitCode)
+}
+
+// Cobra only adds its hidden __complete command when it is run, the shell completion scripts need ibmcloud to pass it along.
+func completionRequestMeta() plugin.Command {
+	return plugin.Command{
+		Namespace:   metadata.NS_SL_NAME,
+		Name:        cobra.ShellCompRequestCmd,
+		Description: T("Request shell completion choices for the specified command-line"),
+		Hidden:      true,
+	}
+}
+
 // This function helps to translate errors coming from Cobra, the common ones in any case.
 // If you update this, update the version in testhelpers/fake_command_runner.go as well.
 // Or make this a util if we update it a lot
@@ -228,7 +276,10 @@ func TranslateError(errorMessage string) string {
 func Namespaces() []plugin.Namespace {
 	return []plugin.Namespace{
 		metadata.SoftlayerNamespace(),
+		batch.BatchNamespace(),
 		block.BlockNamespace(),
+		cache.CacheNamespace(),
+		config.ConfigNamespace(),
 		file.FileNamespace(),
 		dns.DnsNamespace(),
 		eventlog.EventLogNamespace(),
@@ -237,6 +288,7 @@ func Namespaces() []plugin.Namespace {
 		globalip.GlobalIpNamespace(),
 		hardware.HardwareNamespace(),
 		image.ImageNamespace(),
+		inventory.InventoryNamespace(),
 		licenses.LicensesNamespace(),
 		loadbal.LoadbalNamespace(),
 		nas.NasNetworkStorageNamespace(),
@@ -344,6 +396,7 @@ func GetTopCobraCommand(ui terminal.UI, session *session.Session) *cobra.Command
 
 	slCommand := metadata.NewSoftlayerCommand(ui, session)
 	helpFlag := false
+	dryRunFlag := false
 	cobraCmd := &cobra.Command{
 		Use:           "sl",
 		Short:         T("Manage Classic infrastructure services"),
@@ -351,6 +404,9 @@ func GetTopCobraCommand(ui terminal.UI, session *session.Session) *cobra.Command
 		RunE:          nil,
 		SilenceUsage:  true, // Surpresses help text on errors
 		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return applyGlobalFlags(cmd, ui, session)
+		},
 	}
 	// This is to mock the `ibmcloud` usage string. Not perfect, but its close to what you can expect
 	cobra.AddTemplateFunc("UsageCommandString", UsageCommandString)
@@ -368,17 +424,46 @@ func GetTopCobraCommand(ui terminal.UI, session *session.Session) *cobra.Command
 	cobraCmd.AddCommand(versionCommand)
 
 	// Persistent Flags
-	cobraCmd.PersistentFlags().Var(slCommand.OutputFlag, "output", T("Specify output format, only JSON is supported now."))
+	outputSubs := map[string]interface{}{"EXAMPLE": "--output='template={{.Id}} {{.Hostname}}'"}
+	cobraCmd.PersistentFlags().Var(slCommand.OutputFlag, "output",
+		T("Specify output format: JSON, CSV, YAML or template=<GO TEMPLATE>. Templates run once for each item of a list, for example {{.EXAMPLE}}", outputSubs))
+	cobraCmd.PersistentFlags().Var(slCommand.QueryFlag, "query",
+		T("JMESPath query to filter JSON, YAML or template output, for example --query 'primaryIpAddress'. Implies --output=JSON"))
+	cobraCmd.PersistentFlags().BoolVar(&dryRunFlag, "dry-run", false,
+		T("Only send read requests to the API. Requests that would make changes are printed instead of sent."))
+	retrySubs := map[string]interface{}{"CONFIG": client.SoftlayerRetries}
+	cobraCmd.PersistentFlags().Int("retries", client.DefaultRetries,
+		T("How many times to retry read requests that fail with a rate limit, gateway error, timeout or connection reset. Overrides the {{.CONFIG}} plugin config.", retrySubs))
+	retryWaitSubs := map[string]interface{}{"CONFIG": client.SoftlayerRetryWait}
+	cobraCmd.PersistentFlags().Int("retry-wait", int(client.DefaultRetryWait.Seconds()),
+		T("Seconds to wait before the first retry, doubled for each attempt after that. Overrides the {{.CONFIG}} plugin config.", retryWaitSubs))
+	maxRPSSubs := map[string]interface{}{"CONFIG": client.SoftlayerMaxRPS}
+	cobraCmd.PersistentFlags().Float64("max-rps", 0,
+		T("Send at most this many API requests per second, like 0.5 or 10. 0 means there is no limit. Overrides the {{.CONFIG}} plugin config.", maxRPSSubs))
+	cacheSubs := map[string]interface{}{"CONFIG": client.SoftlayerCacheTTL}
+	cobraCmd.PersistentFlags().Int("cache-ttl", 0,
+		T("Cache product package, price and location lookups for this many seconds, 0 turns the cache off. Overrides the {{.CONFIG}} plugin config.", cacheSubs))
+	profileSubs := map[string]interface{}{"ENV": client.ENV_SL_PROFILE}
+	cobraCmd.PersistentFlags().String("profile", "",
+		T("Profile to use for this command, see '${COMMAND_NAME} sl config profile-list'. Overrides {{.ENV}} and the profile in use.", profileSubs))
 	// This is needed so we can translate the help text
 	cobraCmd.PersistentFlags().BoolVarP(&helpFlag, "help", "h", false, T("Usage information."))
 
 	// Commands
 	cobraCmd.AddCommand(callapi.NewCallAPICommand(slCommand).Command) // single command
+	cobraCmd.AddCommand(completion.NewCompletionCommand(slCommand).Command) // single command
+	cobraCmd.AddCommand(history.NewHistoryCommand(slCommand).Command) // single command
 	cobraCmd.AddCommand(account.SetupCobraCommands(slCommand))
 	cobraCmd.AddCommand(bandwidth.SetupCobraCommands(slCommand))
+	cobraCmd.AddCommand(batch.SetupCobraCommands(slCommand, func(stepUI terminal.UI) *cobra.Command {
+		return GetTopCobraCommand(stepUI, session)
+	}))
+	cobraCmd.AddCommand(cache.SetupCobraCommands(slCommand))
+	cobraCmd.AddCommand(config.SetupCobraCommands(slCommand))
 	cobraCmd.AddCommand(email.SetupCobraCommands(slCommand))
 	cobraCmd.AddCommand(image.SetupCobraCommands(slCommand))
 	cobraCmd.AddCommand(hardware.SetupCobraCommands(slCommand))
+	cobraCmd.AddCommand(inventory.SetupCobraCommands(slCommand))
 	cobraCmd.AddCommand(reports.SetupCobraCommands(slCommand))
 	cobraCmd.AddCommand(eventlog.SetupCobraCommands(slCommand))
 	cobraCmd.AddCommand(user.SetupCobraCommands(slCommand))
@@ -407,3 +492,134 @@ func GetTopCobraCommand(ui terminal.UI, session *session.Session) *cobra.Command
 
 	return cobraCmd
 }
+
+// Applies the global --dry-run, --retries, --retry-wait, --max-rps and --cache-ttl flags to the session transport,
+// and the output format and datacenter of the profile to flags that weren't given.
+// Commands like `dns import` have their own --dry-run flag, which shadows the global one,
+// so flags are looked up on the command being run.
+func applyGlobalFlags(cmd *cobra.Command, ui terminal.UI, session *session.Session) error {
+	transport, ok := client.GetCLITransport(session)
+	if !ok {
+		return nil
+	}
+	if transport.Profile != nil {
+		err := applyProfileDefaults(cmd, *transport.Profile)
+		if err != nil {
+			return err
+		}
+	}
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err == nil && dryRun {
+		transport.DryRun = true
+		transport.DryRunOutput = ui.Writer()
+	}
+	if cmd.Flags().Changed("retries") {
+		retries, err := cmd.Flags().GetInt("retries")
+		if err != nil || retries < 0 {
+			return slErr.NewInvalidUsageError(T("--retries must be 0 or more."))
+		}
+		transport.Retries = retries
+	}
+	if cmd.Flags().Changed("retry-wait") {
+		retryWait, err := cmd.Flags().GetInt("retry-wait")
+		if err != nil || retryWait < 1 {
+			return slErr.NewInvalidUsageError(T("--retry-wait must be 1 or more."))
+		}
+		transport.RetryWait = time.Duration(retryWait) * time.Second
+	}
+	if cmd.Flags().Changed("max-rps") {
+		maxRPS, err := cmd.Flags().GetFloat64("max-rps")
+		if err != nil || maxRPS < 0 {
+			return slErr.NewInvalidUsageError(T("--max-rps must be 0 or more."))
+		}
+		transport.RateLimiter = nil
+		if maxRPS > 0 {
+			transport.RateLimiter = client.NewRateLimiter(maxRPS)
+		}
+	}
+	if cmd.Flags().Changed("cache-ttl") {
+		cacheTTL, err := cmd.Flags().GetInt("cache-ttl")
+		if err != nil || cacheTTL < 0 {
+			return slErr.NewInvalidUsageError(T("--cache-ttl must be 0 or more."))
+		}
+		transport.Cache = nil
+		if cacheTTL > 0 {
+			transport.Cache = client.NewResponseCache(client.GetCacheDir(), time.Duration(cacheTTL)*time.Second)
+		}
+	}
+	return nil
+}
+
+func applyProfileDefaults(cmd *cobra.Command, profile client.Profile) error {
+	outputFlag := cmd.Flags().Lookup("output")
+	if profile.Output != "" && outputFlag != nil && !outputFlag.Changed {
+		err := cmd.Flags().Set("output", profile.Output)
+		if err != nil {
+			return err
+		}
+	}
+	if profile.Datacenter == "" {
+		return nil
+	}
+	var err error
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		if _, ok := flag.Annotations[metadata.ProfileDatacenterAnnotation]; ok && !flag.Changed && err == nil {
+			err = flag.Value.Set(profile.Datacenter)
+		}
+	})
+	return err
+}
+
+// The flag names of the shorthands the command args run can have, like p for password
+func flagShorthands(topCommand *cobra.Command, args []string) map[string]string {
+	shorthands := map[string]string{}
+	cmd, _, err := topCommand.Find(args)
+	if err != nil {
+		return shorthands
+	}
+	addShorthand := func(flag *pflag.Flag) {
+		if flag.Shorthand != "" {
+			shorthands[flag.Shorthand] = flag.Name
+		}
+	}
+	cmd.LocalFlags().VisitAll(addShorthand)
+	cmd.InheritedFlags().VisitAll(addShorthand)
+	return shorthands
+}
+
+// True for --output=JSON, or --query without --output which implies it. Like --profile this is needed before (or without) cobra parsing the flags.
+func jsonOutputRequested(args []string) bool {
+	output := ""
+	query := false
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if arg == "--output" && i+1 < len(args) {
+			output = args[i+1]
+		}
+		if strings.HasPrefix(arg, "--output=") {
+			output = strings.TrimPrefix(arg, "--output=")
+		}
+		if arg == "--query" || strings.HasPrefix(arg, "--query=") {
+			query = true
+		}
+	}
+	return strings.EqualFold(output, "JSON") || (output == "" && query)
+}
+
+// Finds the value of --profile in args, cobra hasn't parsed them yet when the session is made
+func profileFlagValue(args []string) string {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if arg == "--profile" && i+1 < len(args) {
+			return args[i+1]
+		}
+		if strings.HasPrefix(arg, "--profile=") {
+			return strings.TrimPrefix(arg, "--profile=")
+		}
+	}
+	return ""
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 )
 
 type FakeAccountManager struct {
@@ -195,12 +196,11 @@ type FakeAccountManager struct {
 		result1 []datatypes.Billing_Invoice_Item
 		result2 error
 	}
-	GetInvoicesStub        func(int, bool, bool) ([]datatypes.Billing_Invoice, error)
+	GetInvoicesStub        func(bool, metadata.Paging) ([]datatypes.Billing_Invoice, error)
 	getInvoicesMutex       sync.RWMutex
 	getInvoicesArgsForCall []struct {
-		arg1 int
-		arg2 bool
-		arg3 bool
+		arg1 bool
+		arg2 metadata.Paging
 	}
 	getInvoicesReturns struct {
 		result1 []datatypes.Billing_Invoice
@@ -1174,20 +1174,19 @@ func (fake *FakeAccountManager) GetInvoiceDetailReturnsOnCall(i int, result1 []d
 	}{result1, result2}
 }
 
-func (fake *FakeAccountManager) GetInvoices(arg1 int, arg2 bool, arg3 bool) ([]datatypes.Billing_Invoice, error) {
+func (fake *FakeAccountManager) GetInvoices(arg1 bool, arg2 metadata.Paging) ([]datatypes.Billing_Invoice, error) {
 	fake.getInvoicesMutex.Lock()
 	ret, specificReturn := fake.getInvoicesReturnsOnCall[len(fake.getInvoicesArgsForCall)]
 	fake.getInvoicesArgsForCall = append(fake.getInvoicesArgsForCall, struct {
-		arg1 int
-		arg2 bool
-		arg3 bool
-	}{arg1, arg2, arg3})
+		arg1 bool
+		arg2 metadata.Paging
+	}{arg1, arg2})
 	stub := fake.GetInvoicesStub
 	fakeReturns := fake.getInvoicesReturns
-	fake.recordInvocation("GetInvoices", []interface{}{arg1, arg2, arg3})
+	fake.recordInvocation("GetInvoices", []interface{}{arg1, arg2})
 	fake.getInvoicesMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -1201,17 +1200,17 @@ func (fake *FakeAccountManager) GetInvoicesCallCount() int {
 	return len(fake.getInvoicesArgsForCall)
 }
 
-func (fake *FakeAccountManager) GetInvoicesCalls(stub func(int, bool, bool) ([]datatypes.Billing_Invoice, error)) {
+func (fake *FakeAccountManager) GetInvoicesCalls(stub func(bool, metadata.Paging) ([]datatypes.Billing_Invoice, error)) {
 	fake.getInvoicesMutex.Lock()
 	defer fake.getInvoicesMutex.Unlock()
 	fake.GetInvoicesStub = stub
 }
 
-func (fake *FakeAccountManager) GetInvoicesArgsForCall(i int) (int, bool, bool) {
+func (fake *FakeAccountManager) GetInvoicesArgsForCall(i int) (bool, metadata.Paging) {
 	fake.getInvoicesMutex.RLock()
 	defer fake.getInvoicesMutex.RUnlock()
 	argsForCall := fake.getInvoicesArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeAccountManager) GetInvoicesReturns(result1 []datatypes.Billing_Invoice, result2 error) {
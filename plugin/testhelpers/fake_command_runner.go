@@ -1,7 +1,10 @@
 package testhelpers
 
 import (
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
 	"github.com/spf13/cobra"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
 )
 
 func RunCobraCommand(cmd *cobra.Command, args ...string) error {
@@ -21,3 +24,20 @@ func RunCobraCommand(cmd *cobra.Command, args ...string) error {
 	err := cmd.Execute()
 	return err
 }
+
+// Runs a new command from newCommand once in each of the i18n.SUPPORTED_LOCALES and returns the output of each locale.
+// Used to make sure JSON output doesn't change with the locale. The default locale is set again afterwards.
+func RunCobraCommandInAllLocales(newCommand func() (*cobra.Command, *terminal.FakeUI), args ...string) (map[string]string, error) {
+	defer i18n.SetLocalizer(i18n.InitWithLocale(i18n.DEFAULT_LOCALE))
+	outputs := map[string]string{}
+	for _, locale := range i18n.SUPPORTED_LOCALES {
+		i18n.SetLocalizer(i18n.InitWithLocale(locale))
+		cmd, fakeUI := newCommand()
+		err := RunCobraCommand(cmd, args...)
+		if err != nil {
+			return outputs, err
+		}
+		outputs[locale] = fakeUI.Outputs()
+	}
+	return outputs, nil
+}
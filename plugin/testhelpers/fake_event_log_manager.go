@@ -6,6 +6,7 @@ import (
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 )
 
 type FakeEventLogManager struct {
@@ -21,12 +22,12 @@ type FakeEventLogManager struct {
 		result1 []string
 		result2 error
 	}
-	GetEventLogsStub        func(string, string, int) ([]datatypes.Event_Log, error)
+	GetEventLogsStub        func(string, string, metadata.Paging) ([]datatypes.Event_Log, error)
 	getEventLogsMutex       sync.RWMutex
 	getEventLogsArgsForCall []struct {
 		arg1 string
 		arg2 string
-		arg3 int
+		arg3 metadata.Paging
 	}
 	getEventLogsReturns struct {
 		result1 []datatypes.Event_Log
@@ -96,13 +97,13 @@ func (fake *FakeEventLogManager) GetEventLogTypesReturnsOnCall(i int, result1 []
 	}{result1, result2}
 }
 
-func (fake *FakeEventLogManager) GetEventLogs(arg1 string, arg2 string, arg3 int) ([]datatypes.Event_Log, error) {
+func (fake *FakeEventLogManager) GetEventLogs(arg1 string, arg2 string, arg3 metadata.Paging) ([]datatypes.Event_Log, error) {
 	fake.getEventLogsMutex.Lock()
 	ret, specificReturn := fake.getEventLogsReturnsOnCall[len(fake.getEventLogsArgsForCall)]
 	fake.getEventLogsArgsForCall = append(fake.getEventLogsArgsForCall, struct {
 		arg1 string
 		arg2 string
-		arg3 int
+		arg3 metadata.Paging
 	}{arg1, arg2, arg3})
 	stub := fake.GetEventLogsStub
 	fakeReturns := fake.getEventLogsReturns
@@ -123,13 +124,13 @@ func (fake *FakeEventLogManager) GetEventLogsCallCount() int {
 	return len(fake.getEventLogsArgsForCall)
 }
 
-func (fake *FakeEventLogManager) GetEventLogsCalls(stub func(string, string, int) ([]datatypes.Event_Log, error)) {
+func (fake *FakeEventLogManager) GetEventLogsCalls(stub func(string, string, metadata.Paging) ([]datatypes.Event_Log, error)) {
 	fake.getEventLogsMutex.Lock()
 	defer fake.getEventLogsMutex.Unlock()
 	fake.GetEventLogsStub = stub
 }
 
-func (fake *FakeEventLogManager) GetEventLogsArgsForCall(i int) (string, string, int) {
+func (fake *FakeEventLogManager) GetEventLogsArgsForCall(i int) (string, string, metadata.Paging) {
 	fake.getEventLogsMutex.RLock()
 	defer fake.getEventLogsMutex.RUnlock()
 	argsForCall := fake.getEventLogsArgsForCall[i]
@@ -165,10 +166,6 @@ func (fake *FakeEventLogManager) GetEventLogsReturnsOnCall(i int, result1 []data
 func (fake *FakeEventLogManager) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
-	fake.getEventLogTypesMutex.RLock()
-	defer fake.getEventLogTypesMutex.RUnlock()
-	fake.getEventLogsMutex.RLock()
-	defer fake.getEventLogsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -7,6 +7,7 @@ import (
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 )
 
 type FakeHardwareServerManager struct {
@@ -389,7 +390,7 @@ type FakeHardwareServerManager struct {
 		result1 []datatypes.User_Customer_Notification_Hardware
 		result2 error
 	}
-	ListHardwareStub        func([]string, int, int, string, string, string, int, string, string, string, int, string) ([]datatypes.Hardware_Server, error)
+	ListHardwareStub        func([]string, int, int, string, string, string, int, string, string, string, int, string, metadata.Paging) ([]datatypes.Hardware_Server, error)
 	listHardwareMutex       sync.RWMutex
 	listHardwareArgsForCall []struct {
 		arg1  []string
@@ -404,6 +405,7 @@ type FakeHardwareServerManager struct {
 		arg10 string
 		arg11 int
 		arg12 string
+		arg13 metadata.Paging
 	}
 	listHardwareReturns struct {
 		result1 []datatypes.Hardware_Server
@@ -2335,7 +2337,7 @@ func (fake *FakeHardwareServerManager) GetUserCustomerNotificationsByHardwareIdR
 	}{result1, result2}
 }
 
-func (fake *FakeHardwareServerManager) ListHardware(arg1 []string, arg2 int, arg3 int, arg4 string, arg5 string, arg6 string, arg7 int, arg8 string, arg9 string, arg10 string, arg11 int, arg12 string) ([]datatypes.Hardware_Server, error) {
+func (fake *FakeHardwareServerManager) ListHardware(arg1 []string, arg2 int, arg3 int, arg4 string, arg5 string, arg6 string, arg7 int, arg8 string, arg9 string, arg10 string, arg11 int, arg12 string, arg13 metadata.Paging) ([]datatypes.Hardware_Server, error) {
 	var arg1Copy []string
 	if arg1 != nil {
 		arg1Copy = make([]string, len(arg1))
@@ -2356,13 +2358,14 @@ func (fake *FakeHardwareServerManager) ListHardware(arg1 []string, arg2 int, arg
 		arg10 string
 		arg11 int
 		arg12 string
-	}{arg1Copy, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12})
+		arg13 metadata.Paging
+	}{arg1Copy, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12, arg13})
 	stub := fake.ListHardwareStub
 	fakeReturns := fake.listHardwareReturns
-	fake.recordInvocation("ListHardware", []interface{}{arg1Copy, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12})
+	fake.recordInvocation("ListHardware", []interface{}{arg1Copy, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12, arg13})
 	fake.listHardwareMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12, arg13)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2376,17 +2379,17 @@ func (fake *FakeHardwareServerManager) ListHardwareCallCount() int {
 	return len(fake.listHardwareArgsForCall)
 }
 
-func (fake *FakeHardwareServerManager) ListHardwareCalls(stub func([]string, int, int, string, string, string, int, string, string, string, int, string) ([]datatypes.Hardware_Server, error)) {
+func (fake *FakeHardwareServerManager) ListHardwareCalls(stub func([]string, int, int, string, string, string, int, string, string, string, int, string, metadata.Paging) ([]datatypes.Hardware_Server, error)) {
 	fake.listHardwareMutex.Lock()
 	defer fake.listHardwareMutex.Unlock()
 	fake.ListHardwareStub = stub
 }
 
-func (fake *FakeHardwareServerManager) ListHardwareArgsForCall(i int) ([]string, int, int, string, string, string, int, string, string, string, int, string) {
+func (fake *FakeHardwareServerManager) ListHardwareArgsForCall(i int) ([]string, int, int, string, string, string, int, string, string, string, int, string, metadata.Paging) {
 	fake.listHardwareMutex.RLock()
 	defer fake.listHardwareMutex.RUnlock()
 	argsForCall := fake.listHardwareArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8, argsForCall.arg9, argsForCall.arg10, argsForCall.arg11, argsForCall.arg12
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8, argsForCall.arg9, argsForCall.arg10, argsForCall.arg11, argsForCall.arg12, argsForCall.arg13
 }
 
 func (fake *FakeHardwareServerManager) ListHardwareReturns(result1 []datatypes.Hardware_Server, result2 error) {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 )
 
 type FakeImageManager struct {
@@ -117,11 +118,12 @@ type FakeImageManager struct {
 		result1 datatypes.Virtual_Guest_Block_Device_Template_Group
 		result2 error
 	}
-	ListPrivateImagesStub        func(string, string) ([]datatypes.Virtual_Guest_Block_Device_Template_Group, error)
+	ListPrivateImagesStub        func(string, string, metadata.Paging) ([]datatypes.Virtual_Guest_Block_Device_Template_Group, error)
 	listPrivateImagesMutex       sync.RWMutex
 	listPrivateImagesArgsForCall []struct {
 		arg1 string
 		arg2 string
+		arg3 metadata.Paging
 	}
 	listPrivateImagesReturns struct {
 		result1 []datatypes.Virtual_Guest_Block_Device_Template_Group
@@ -131,11 +133,12 @@ type FakeImageManager struct {
 		result1 []datatypes.Virtual_Guest_Block_Device_Template_Group
 		result2 error
 	}
-	ListPublicImagesStub        func(string, string) ([]datatypes.Virtual_Guest_Block_Device_Template_Group, error)
+	ListPublicImagesStub        func(string, string, metadata.Paging) ([]datatypes.Virtual_Guest_Block_Device_Template_Group, error)
 	listPublicImagesMutex       sync.RWMutex
 	listPublicImagesArgsForCall []struct {
 		arg1 string
 		arg2 string
+		arg3 metadata.Paging
 	}
 	listPublicImagesReturns struct {
 		result1 []datatypes.Virtual_Guest_Block_Device_Template_Group
@@ -702,19 +705,20 @@ func (fake *FakeImageManager) ImportImageReturnsOnCall(i int, result1 datatypes.
 	}{result1, result2}
 }
 
-func (fake *FakeImageManager) ListPrivateImages(arg1 string, arg2 string) ([]datatypes.Virtual_Guest_Block_Device_Template_Group, error) {
+func (fake *FakeImageManager) ListPrivateImages(arg1 string, arg2 string, arg3 metadata.Paging) ([]datatypes.Virtual_Guest_Block_Device_Template_Group, error) {
 	fake.listPrivateImagesMutex.Lock()
 	ret, specificReturn := fake.listPrivateImagesReturnsOnCall[len(fake.listPrivateImagesArgsForCall)]
 	fake.listPrivateImagesArgsForCall = append(fake.listPrivateImagesArgsForCall, struct {
 		arg1 string
 		arg2 string
-	}{arg1, arg2})
+		arg3 metadata.Paging
+	}{arg1, arg2, arg3})
 	stub := fake.ListPrivateImagesStub
 	fakeReturns := fake.listPrivateImagesReturns
-	fake.recordInvocation("ListPrivateImages", []interface{}{arg1, arg2})
+	fake.recordInvocation("ListPrivateImages", []interface{}{arg1, arg2, arg3})
 	fake.listPrivateImagesMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -728,17 +732,17 @@ func (fake *FakeImageManager) ListPrivateImagesCallCount() int {
 	return len(fake.listPrivateImagesArgsForCall)
 }
 
-func (fake *FakeImageManager) ListPrivateImagesCalls(stub func(string, string) ([]datatypes.Virtual_Guest_Block_Device_Template_Group, error)) {
+func (fake *FakeImageManager) ListPrivateImagesCalls(stub func(string, string, metadata.Paging) ([]datatypes.Virtual_Guest_Block_Device_Template_Group, error)) {
 	fake.listPrivateImagesMutex.Lock()
 	defer fake.listPrivateImagesMutex.Unlock()
 	fake.ListPrivateImagesStub = stub
 }
 
-func (fake *FakeImageManager) ListPrivateImagesArgsForCall(i int) (string, string) {
+func (fake *FakeImageManager) ListPrivateImagesArgsForCall(i int) (string, string, metadata.Paging) {
 	fake.listPrivateImagesMutex.RLock()
 	defer fake.listPrivateImagesMutex.RUnlock()
 	argsForCall := fake.listPrivateImagesArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeImageManager) ListPrivateImagesReturns(result1 []datatypes.Virtual_Guest_Block_Device_Template_Group, result2 error) {
@@ -767,19 +771,20 @@ func (fake *FakeImageManager) ListPrivateImagesReturnsOnCall(i int, result1 []da
 	}{result1, result2}
 }
 
-func (fake *FakeImageManager) ListPublicImages(arg1 string, arg2 string) ([]datatypes.Virtual_Guest_Block_Device_Template_Group, error) {
+func (fake *FakeImageManager) ListPublicImages(arg1 string, arg2 string, arg3 metadata.Paging) ([]datatypes.Virtual_Guest_Block_Device_Template_Group, error) {
 	fake.listPublicImagesMutex.Lock()
 	ret, specificReturn := fake.listPublicImagesReturnsOnCall[len(fake.listPublicImagesArgsForCall)]
 	fake.listPublicImagesArgsForCall = append(fake.listPublicImagesArgsForCall, struct {
 		arg1 string
 		arg2 string
-	}{arg1, arg2})
+		arg3 metadata.Paging
+	}{arg1, arg2, arg3})
 	stub := fake.ListPublicImagesStub
 	fakeReturns := fake.listPublicImagesReturns
-	fake.recordInvocation("ListPublicImages", []interface{}{arg1, arg2})
+	fake.recordInvocation("ListPublicImages", []interface{}{arg1, arg2, arg3})
 	fake.listPublicImagesMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -793,17 +798,17 @@ func (fake *FakeImageManager) ListPublicImagesCallCount() int {
 	return len(fake.listPublicImagesArgsForCall)
 }
 
-func (fake *FakeImageManager) ListPublicImagesCalls(stub func(string, string) ([]datatypes.Virtual_Guest_Block_Device_Template_Group, error)) {
+func (fake *FakeImageManager) ListPublicImagesCalls(stub func(string, string, metadata.Paging) ([]datatypes.Virtual_Guest_Block_Device_Template_Group, error)) {
 	fake.listPublicImagesMutex.Lock()
 	defer fake.listPublicImagesMutex.Unlock()
 	fake.ListPublicImagesStub = stub
 }
 
-func (fake *FakeImageManager) ListPublicImagesArgsForCall(i int) (string, string) {
+func (fake *FakeImageManager) ListPublicImagesArgsForCall(i int) (string, string, metadata.Paging) {
 	fake.listPublicImagesMutex.RLock()
 	defer fake.listPublicImagesMutex.RUnlock()
 	argsForCall := fake.listPublicImagesArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeImageManager) ListPublicImagesReturns(result1 []datatypes.Virtual_Guest_Block_Device_Template_Group, result2 error) {
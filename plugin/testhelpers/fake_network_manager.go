@@ -6,6 +6,7 @@ import (
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 )
 
 type FakeNetworkManager struct {
@@ -475,7 +476,7 @@ type FakeNetworkManager struct {
 		result1 []datatypes.Network_SecurityGroup
 		result2 error
 	}
-	ListSubnetsStub        func(string, string, int, string, string, int, string) ([]datatypes.Network_Subnet, error)
+	ListSubnetsStub        func(string, string, int, string, string, int, string, metadata.Paging) ([]datatypes.Network_Subnet, error)
 	listSubnetsMutex       sync.RWMutex
 	listSubnetsArgsForCall []struct {
 		arg1 string
@@ -485,6 +486,7 @@ type FakeNetworkManager struct {
 		arg5 string
 		arg6 int
 		arg7 string
+		arg8 metadata.Paging
 	}
 	listSubnetsReturns struct {
 		result1 []datatypes.Network_Subnet
@@ -494,7 +496,7 @@ type FakeNetworkManager struct {
 		result1 []datatypes.Network_Subnet
 		result2 error
 	}
-	ListVlansStub        func(string, int, string, int, string) ([]datatypes.Network_Vlan, error)
+	ListVlansStub        func(string, int, string, int, string, metadata.Paging) ([]datatypes.Network_Vlan, error)
 	listVlansMutex       sync.RWMutex
 	listVlansArgsForCall []struct {
 		arg1 string
@@ -502,6 +504,7 @@ type FakeNetworkManager struct {
 		arg3 string
 		arg4 int
 		arg5 string
+		arg6 metadata.Paging
 	}
 	listVlansReturns struct {
 		result1 []datatypes.Network_Vlan
@@ -2832,7 +2835,7 @@ func (fake *FakeNetworkManager) ListSecurityGroupsReturnsOnCall(i int, result1 [
 	}{result1, result2}
 }
 
-func (fake *FakeNetworkManager) ListSubnets(arg1 string, arg2 string, arg3 int, arg4 string, arg5 string, arg6 int, arg7 string) ([]datatypes.Network_Subnet, error) {
+func (fake *FakeNetworkManager) ListSubnets(arg1 string, arg2 string, arg3 int, arg4 string, arg5 string, arg6 int, arg7 string, arg8 metadata.Paging) ([]datatypes.Network_Subnet, error) {
 	fake.listSubnetsMutex.Lock()
 	ret, specificReturn := fake.listSubnetsReturnsOnCall[len(fake.listSubnetsArgsForCall)]
 	fake.listSubnetsArgsForCall = append(fake.listSubnetsArgsForCall, struct {
@@ -2843,13 +2846,14 @@ func (fake *FakeNetworkManager) ListSubnets(arg1 string, arg2 string, arg3 int,
 		arg5 string
 		arg6 int
 		arg7 string
-	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+		arg8 metadata.Paging
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8})
 	stub := fake.ListSubnetsStub
 	fakeReturns := fake.listSubnetsReturns
-	fake.recordInvocation("ListSubnets", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	fake.recordInvocation("ListSubnets", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8})
 	fake.listSubnetsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2863,17 +2867,17 @@ func (fake *FakeNetworkManager) ListSubnetsCallCount() int {
 	return len(fake.listSubnetsArgsForCall)
 }
 
-func (fake *FakeNetworkManager) ListSubnetsCalls(stub func(string, string, int, string, string, int, string) ([]datatypes.Network_Subnet, error)) {
+func (fake *FakeNetworkManager) ListSubnetsCalls(stub func(string, string, int, string, string, int, string, metadata.Paging) ([]datatypes.Network_Subnet, error)) {
 	fake.listSubnetsMutex.Lock()
 	defer fake.listSubnetsMutex.Unlock()
 	fake.ListSubnetsStub = stub
 }
 
-func (fake *FakeNetworkManager) ListSubnetsArgsForCall(i int) (string, string, int, string, string, int, string) {
+func (fake *FakeNetworkManager) ListSubnetsArgsForCall(i int) (string, string, int, string, string, int, string, metadata.Paging) {
 	fake.listSubnetsMutex.RLock()
 	defer fake.listSubnetsMutex.RUnlock()
 	argsForCall := fake.listSubnetsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8
 }
 
 func (fake *FakeNetworkManager) ListSubnetsReturns(result1 []datatypes.Network_Subnet, result2 error) {
@@ -2902,7 +2906,7 @@ func (fake *FakeNetworkManager) ListSubnetsReturnsOnCall(i int, result1 []dataty
 	}{result1, result2}
 }
 
-func (fake *FakeNetworkManager) ListVlans(arg1 string, arg2 int, arg3 string, arg4 int, arg5 string) ([]datatypes.Network_Vlan, error) {
+func (fake *FakeNetworkManager) ListVlans(arg1 string, arg2 int, arg3 string, arg4 int, arg5 string, arg6 metadata.Paging) ([]datatypes.Network_Vlan, error) {
 	fake.listVlansMutex.Lock()
 	ret, specificReturn := fake.listVlansReturnsOnCall[len(fake.listVlansArgsForCall)]
 	fake.listVlansArgsForCall = append(fake.listVlansArgsForCall, struct {
@@ -2911,13 +2915,14 @@ func (fake *FakeNetworkManager) ListVlans(arg1 string, arg2 int, arg3 string, ar
 		arg3 string
 		arg4 int
 		arg5 string
-	}{arg1, arg2, arg3, arg4, arg5})
+		arg6 metadata.Paging
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
 	stub := fake.ListVlansStub
 	fakeReturns := fake.listVlansReturns
-	fake.recordInvocation("ListVlans", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("ListVlans", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
 	fake.listVlansMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2931,17 +2936,17 @@ func (fake *FakeNetworkManager) ListVlansCallCount() int {
 	return len(fake.listVlansArgsForCall)
 }
 
-func (fake *FakeNetworkManager) ListVlansCalls(stub func(string, int, string, int, string) ([]datatypes.Network_Vlan, error)) {
+func (fake *FakeNetworkManager) ListVlansCalls(stub func(string, int, string, int, string, metadata.Paging) ([]datatypes.Network_Vlan, error)) {
 	fake.listVlansMutex.Lock()
 	defer fake.listVlansMutex.Unlock()
 	fake.ListVlansStub = stub
 }
 
-func (fake *FakeNetworkManager) ListVlansArgsForCall(i int) (string, int, string, int, string) {
+func (fake *FakeNetworkManager) ListVlansArgsForCall(i int) (string, int, string, int, string, metadata.Paging) {
 	fake.listVlansMutex.RLock()
 	defer fake.listVlansMutex.RUnlock()
 	argsForCall := fake.listVlansArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
 }
 
 func (fake *FakeNetworkManager) ListVlansReturns(result1 []datatypes.Network_Vlan, result2 error) {
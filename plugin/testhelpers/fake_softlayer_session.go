@@ -208,12 +208,7 @@ func GetSessionHandler(sess *session.Session) *FakeTransportHandler {
 func readJsonTestFixtures(service string, method string, fileNames []string, identifier int) ([]byte, error) {
 	wd, _ := os.Getwd()
 	var fixture, workingPath string
-	scope := ".."
-
-	// The second check is for windows
-	if strings.Contains(wd, "plugin/commands") || strings.Contains(wd, "plugin\\commands") {
-		scope += "/.."
-	}
+	scope := testFixturesScope(wd)
 	// fmt.Printf("WD: %v, Scope: %v", wd, scope)
 	baseFixture := filepath.Join(wd, scope, "testfixtures", service+"/"+method+".json")
 	// fmt.Printf("BASE FIXTURE: %v\n", baseFixture)
@@ -259,3 +254,24 @@ func readJsonTestFixtures(service string, method string, fileNames []string, ide
 	files := utils.StringSliceToString(fileNames)
 	return nil, errors.New("Fixture for " + apiCall + " failed to load, looked in these files: " + files)
 }
+
+// Relative path from wd to the plugin directory
+func testFixturesScope(wd string) string {
+	scope := ".."
+	// The second check is for windows
+	if strings.Contains(wd, "plugin/commands") || strings.Contains(wd, "plugin\\commands") {
+		scope += "/.."
+	}
+	return scope
+}
+
+// Golden files hold the expected output of a command, and are placed in plugin/testfixtures/golden/name.json
+func ReadGoldenFile(name string) string {
+	wd, _ := os.Getwd()
+	golden := filepath.Join(wd, testFixturesScope(wd), "testfixtures", "golden", name+".json")
+	contents, err := ioutil.ReadFile(golden) // #nosec
+	if err != nil {
+		fmt.Printf("Unable to read golden file %v: %v\n", golden, err)
+	}
+	return string(contents)
+}
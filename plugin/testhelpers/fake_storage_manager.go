@@ -6,6 +6,7 @@ import (
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 )
 
 type FakeStorageManager struct {
@@ -439,7 +440,7 @@ type FakeStorageManager struct {
 		result1 []datatypes.Product_Item
 		result2 error
 	}
-	ListVolumesStub        func(string, string, string, string, string, int, string) ([]datatypes.Network_Storage, error)
+	ListVolumesStub        func(string, string, string, string, string, int, string, metadata.Paging) ([]datatypes.Network_Storage, error)
 	listVolumesMutex       sync.RWMutex
 	listVolumesArgsForCall []struct {
 		arg1 string
@@ -449,6 +450,7 @@ type FakeStorageManager struct {
 		arg5 string
 		arg6 int
 		arg7 string
+		arg8 metadata.Paging
 	}
 	listVolumesReturns struct {
 		result1 []datatypes.Network_Storage
@@ -2730,7 +2732,7 @@ func (fake *FakeStorageManager) ListItemsReturnsOnCall(i int, result1 []datatype
 	}{result1, result2}
 }
 
-func (fake *FakeStorageManager) ListVolumes(arg1 string, arg2 string, arg3 string, arg4 string, arg5 string, arg6 int, arg7 string) ([]datatypes.Network_Storage, error) {
+func (fake *FakeStorageManager) ListVolumes(arg1 string, arg2 string, arg3 string, arg4 string, arg5 string, arg6 int, arg7 string, arg8 metadata.Paging) ([]datatypes.Network_Storage, error) {
 	fake.listVolumesMutex.Lock()
 	ret, specificReturn := fake.listVolumesReturnsOnCall[len(fake.listVolumesArgsForCall)]
 	fake.listVolumesArgsForCall = append(fake.listVolumesArgsForCall, struct {
@@ -2741,13 +2743,14 @@ func (fake *FakeStorageManager) ListVolumes(arg1 string, arg2 string, arg3 strin
 		arg5 string
 		arg6 int
 		arg7 string
-	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+		arg8 metadata.Paging
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8})
 	stub := fake.ListVolumesStub
 	fakeReturns := fake.listVolumesReturns
-	fake.recordInvocation("ListVolumes", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	fake.recordInvocation("ListVolumes", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8})
 	fake.listVolumesMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2761,17 +2764,17 @@ func (fake *FakeStorageManager) ListVolumesCallCount() int {
 	return len(fake.listVolumesArgsForCall)
 }
 
-func (fake *FakeStorageManager) ListVolumesCalls(stub func(string, string, string, string, string, int, string) ([]datatypes.Network_Storage, error)) {
+func (fake *FakeStorageManager) ListVolumesCalls(stub func(string, string, string, string, string, int, string, metadata.Paging) ([]datatypes.Network_Storage, error)) {
 	fake.listVolumesMutex.Lock()
 	defer fake.listVolumesMutex.Unlock()
 	fake.ListVolumesStub = stub
 }
 
-func (fake *FakeStorageManager) ListVolumesArgsForCall(i int) (string, string, string, string, string, int, string) {
+func (fake *FakeStorageManager) ListVolumesArgsForCall(i int) (string, string, string, string, string, int, string, metadata.Paging) {
 	fake.listVolumesMutex.RLock()
 	defer fake.listVolumesMutex.RUnlock()
 	argsForCall := fake.listVolumesArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8
 }
 
 func (fake *FakeStorageManager) ListVolumesReturns(result1 []datatypes.Network_Storage, result2 error) {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 )
 
 type FakeTicketManager struct {
@@ -110,9 +111,10 @@ type FakeTicketManager struct {
 		result1 datatypes.Ticket
 		result2 error
 	}
-	ListCloseTicketsStub        func() ([]datatypes.Ticket, error)
+	ListCloseTicketsStub        func(metadata.Paging) ([]datatypes.Ticket, error)
 	listCloseTicketsMutex       sync.RWMutex
 	listCloseTicketsArgsForCall []struct {
+		arg1 metadata.Paging
 	}
 	listCloseTicketsReturns struct {
 		result1 []datatypes.Ticket
@@ -122,9 +124,10 @@ type FakeTicketManager struct {
 		result1 []datatypes.Ticket
 		result2 error
 	}
-	ListOpenTicketsStub        func() ([]datatypes.Ticket, error)
+	ListOpenTicketsStub        func(metadata.Paging) ([]datatypes.Ticket, error)
 	listOpenTicketsMutex       sync.RWMutex
 	listOpenTicketsArgsForCall []struct {
+		arg1 metadata.Paging
 	}
 	listOpenTicketsReturns struct {
 		result1 []datatypes.Ticket
@@ -667,17 +670,18 @@ func (fake *FakeTicketManager) GetTicketReturnsOnCall(i int, result1 datatypes.T
 	}{result1, result2}
 }
 
-func (fake *FakeTicketManager) ListCloseTickets() ([]datatypes.Ticket, error) {
+func (fake *FakeTicketManager) ListCloseTickets(arg1 metadata.Paging) ([]datatypes.Ticket, error) {
 	fake.listCloseTicketsMutex.Lock()
 	ret, specificReturn := fake.listCloseTicketsReturnsOnCall[len(fake.listCloseTicketsArgsForCall)]
 	fake.listCloseTicketsArgsForCall = append(fake.listCloseTicketsArgsForCall, struct {
-	}{})
+		arg1 metadata.Paging
+	}{arg1})
 	stub := fake.ListCloseTicketsStub
 	fakeReturns := fake.listCloseTicketsReturns
-	fake.recordInvocation("ListCloseTickets", []interface{}{})
+	fake.recordInvocation("ListCloseTickets", []interface{}{arg1})
 	fake.listCloseTicketsMutex.Unlock()
 	if stub != nil {
-		return stub()
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -691,12 +695,19 @@ func (fake *FakeTicketManager) ListCloseTicketsCallCount() int {
 	return len(fake.listCloseTicketsArgsForCall)
 }
 
-func (fake *FakeTicketManager) ListCloseTicketsCalls(stub func() ([]datatypes.Ticket, error)) {
+func (fake *FakeTicketManager) ListCloseTicketsCalls(stub func(metadata.Paging) ([]datatypes.Ticket, error)) {
 	fake.listCloseTicketsMutex.Lock()
 	defer fake.listCloseTicketsMutex.Unlock()
 	fake.ListCloseTicketsStub = stub
 }
 
+func (fake *FakeTicketManager) ListCloseTicketsArgsForCall(i int) metadata.Paging {
+	fake.listCloseTicketsMutex.RLock()
+	defer fake.listCloseTicketsMutex.RUnlock()
+	argsForCall := fake.listCloseTicketsArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeTicketManager) ListCloseTicketsReturns(result1 []datatypes.Ticket, result2 error) {
 	fake.listCloseTicketsMutex.Lock()
 	defer fake.listCloseTicketsMutex.Unlock()
@@ -723,17 +734,18 @@ func (fake *FakeTicketManager) ListCloseTicketsReturnsOnCall(i int, result1 []da
 	}{result1, result2}
 }
 
-func (fake *FakeTicketManager) ListOpenTickets() ([]datatypes.Ticket, error) {
+func (fake *FakeTicketManager) ListOpenTickets(arg1 metadata.Paging) ([]datatypes.Ticket, error) {
 	fake.listOpenTicketsMutex.Lock()
 	ret, specificReturn := fake.listOpenTicketsReturnsOnCall[len(fake.listOpenTicketsArgsForCall)]
 	fake.listOpenTicketsArgsForCall = append(fake.listOpenTicketsArgsForCall, struct {
-	}{})
+		arg1 metadata.Paging
+	}{arg1})
 	stub := fake.ListOpenTicketsStub
 	fakeReturns := fake.listOpenTicketsReturns
-	fake.recordInvocation("ListOpenTickets", []interface{}{})
+	fake.recordInvocation("ListOpenTickets", []interface{}{arg1})
 	fake.listOpenTicketsMutex.Unlock()
 	if stub != nil {
-		return stub()
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -747,12 +759,19 @@ func (fake *FakeTicketManager) ListOpenTicketsCallCount() int {
 	return len(fake.listOpenTicketsArgsForCall)
 }
 
-func (fake *FakeTicketManager) ListOpenTicketsCalls(stub func() ([]datatypes.Ticket, error)) {
+func (fake *FakeTicketManager) ListOpenTicketsCalls(stub func(metadata.Paging) ([]datatypes.Ticket, error)) {
 	fake.listOpenTicketsMutex.Lock()
 	defer fake.listOpenTicketsMutex.Unlock()
 	fake.ListOpenTicketsStub = stub
 }
 
+func (fake *FakeTicketManager) ListOpenTicketsArgsForCall(i int) metadata.Paging {
+	fake.listOpenTicketsMutex.RLock()
+	defer fake.listOpenTicketsMutex.RUnlock()
+	argsForCall := fake.listOpenTicketsArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeTicketManager) ListOpenTicketsReturns(result1 []datatypes.Ticket, result2 error) {
 	fake.listOpenTicketsMutex.Lock()
 	defer fake.listOpenTicketsMutex.Unlock()
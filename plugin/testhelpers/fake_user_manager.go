@@ -7,6 +7,7 @@ import (
 
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 )
 
 type FakeUserManager struct {
@@ -372,10 +373,11 @@ type FakeUserManager struct {
 		result1 []datatypes.Virtual_Guest
 		result2 error
 	}
-	ListUsersStub        func(string) ([]datatypes.User_Customer, error)
+	ListUsersStub        func(string, metadata.Paging) ([]datatypes.User_Customer, error)
 	listUsersMutex       sync.RWMutex
 	listUsersArgsForCall []struct {
 		arg1 string
+		arg2 metadata.Paging
 	}
 	listUsersReturns struct {
 		result1 []datatypes.User_Customer
@@ -2225,18 +2227,19 @@ func (fake *FakeUserManager) GetVirtualGuestsReturnsOnCall(i int, result1 []data
 	}{result1, result2}
 }
 
-func (fake *FakeUserManager) ListUsers(arg1 string) ([]datatypes.User_Customer, error) {
+func (fake *FakeUserManager) ListUsers(arg1 string, arg2 metadata.Paging) ([]datatypes.User_Customer, error) {
 	fake.listUsersMutex.Lock()
 	ret, specificReturn := fake.listUsersReturnsOnCall[len(fake.listUsersArgsForCall)]
 	fake.listUsersArgsForCall = append(fake.listUsersArgsForCall, struct {
 		arg1 string
-	}{arg1})
+		arg2 metadata.Paging
+	}{arg1, arg2})
 	stub := fake.ListUsersStub
 	fakeReturns := fake.listUsersReturns
-	fake.recordInvocation("ListUsers", []interface{}{arg1})
+	fake.recordInvocation("ListUsers", []interface{}{arg1, arg2})
 	fake.listUsersMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2250,17 +2253,17 @@ func (fake *FakeUserManager) ListUsersCallCount() int {
 	return len(fake.listUsersArgsForCall)
 }
 
-func (fake *FakeUserManager) ListUsersCalls(stub func(string) ([]datatypes.User_Customer, error)) {
+func (fake *FakeUserManager) ListUsersCalls(stub func(string, metadata.Paging) ([]datatypes.User_Customer, error)) {
 	fake.listUsersMutex.Lock()
 	defer fake.listUsersMutex.Unlock()
 	fake.ListUsersStub = stub
 }
 
-func (fake *FakeUserManager) ListUsersArgsForCall(i int) string {
+func (fake *FakeUserManager) ListUsersArgsForCall(i int) (string, metadata.Paging) {
 	fake.listUsersMutex.RLock()
 	defer fake.listUsersMutex.RUnlock()
 	argsForCall := fake.listUsersArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeUserManager) ListUsersReturns(result1 []datatypes.User_Customer, result2 error) {
@@ -8,6 +8,7 @@ import (
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/filter"
 	"github.ibm.com/SoftLayer/softlayer-cli/plugin/managers"
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/metadata"
 )
 
 type FakeVirtualServerManager struct {
@@ -169,6 +170,19 @@ type FakeVirtualServerManager struct {
 		result1 []bool
 		result2 []string
 	}
+	ExportInstanceTemplateStub        func(int) (datatypes.Virtual_Guest, error)
+	exportInstanceTemplateMutex       sync.RWMutex
+	exportInstanceTemplateArgsForCall []struct {
+		arg1 int
+	}
+	exportInstanceTemplateReturns struct {
+		result1 datatypes.Virtual_Guest
+		result2 error
+	}
+	exportInstanceTemplateReturnsOnCall map[int]struct {
+		result1 datatypes.Virtual_Guest
+		result2 error
+	}
 	GenerateInstanceCapacityCreationTemplateStub        func(*datatypes.Container_Product_Order_Virtual_ReservedCapacity, map[string]interface{}) (interface{}, error)
 	generateInstanceCapacityCreationTemplateMutex       sync.RWMutex
 	generateInstanceCapacityCreationTemplateArgsForCall []struct {
@@ -483,7 +497,7 @@ type FakeVirtualServerManager struct {
 		result2 string
 		result3 error
 	}
-	ListInstancesStub        func(bool, bool, string, string, string, string, string, string, int, int, int, int, []string, string) ([]datatypes.Virtual_Guest, error)
+	ListInstancesStub        func(bool, bool, string, string, string, string, string, string, int, int, int, int, []string, string, metadata.Paging) ([]datatypes.Virtual_Guest, error)
 	listInstancesMutex       sync.RWMutex
 	listInstancesArgsForCall []struct {
 		arg1  bool
@@ -500,6 +514,7 @@ type FakeVirtualServerManager struct {
 		arg12 int
 		arg13 []string
 		arg14 string
+		arg15 metadata.Paging
 	}
 	listInstancesReturns struct {
 		result1 []datatypes.Virtual_Guest
@@ -1446,6 +1461,70 @@ func (fake *FakeVirtualServerManager) EditInstanceReturnsOnCall(i int, result1 [
 	}{result1, result2}
 }
 
+func (fake *FakeVirtualServerManager) ExportInstanceTemplate(arg1 int) (datatypes.Virtual_Guest, error) {
+	fake.exportInstanceTemplateMutex.Lock()
+	ret, specificReturn := fake.exportInstanceTemplateReturnsOnCall[len(fake.exportInstanceTemplateArgsForCall)]
+	fake.exportInstanceTemplateArgsForCall = append(fake.exportInstanceTemplateArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.ExportInstanceTemplateStub
+	fakeReturns := fake.exportInstanceTemplateReturns
+	fake.recordInvocation("ExportInstanceTemplate", []interface{}{arg1})
+	fake.exportInstanceTemplateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeVirtualServerManager) ExportInstanceTemplateCallCount() int {
+	fake.exportInstanceTemplateMutex.RLock()
+	defer fake.exportInstanceTemplateMutex.RUnlock()
+	return len(fake.exportInstanceTemplateArgsForCall)
+}
+
+func (fake *FakeVirtualServerManager) ExportInstanceTemplateCalls(stub func(int) (datatypes.Virtual_Guest, error)) {
+	fake.exportInstanceTemplateMutex.Lock()
+	defer fake.exportInstanceTemplateMutex.Unlock()
+	fake.ExportInstanceTemplateStub = stub
+}
+
+func (fake *FakeVirtualServerManager) ExportInstanceTemplateArgsForCall(i int) int {
+	fake.exportInstanceTemplateMutex.RLock()
+	defer fake.exportInstanceTemplateMutex.RUnlock()
+	argsForCall := fake.exportInstanceTemplateArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeVirtualServerManager) ExportInstanceTemplateReturns(result1 datatypes.Virtual_Guest, result2 error) {
+	fake.exportInstanceTemplateMutex.Lock()
+	defer fake.exportInstanceTemplateMutex.Unlock()
+	fake.ExportInstanceTemplateStub = nil
+	fake.exportInstanceTemplateReturns = struct {
+		result1 datatypes.Virtual_Guest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVirtualServerManager) ExportInstanceTemplateReturnsOnCall(i int, result1 datatypes.Virtual_Guest, result2 error) {
+	fake.exportInstanceTemplateMutex.Lock()
+	defer fake.exportInstanceTemplateMutex.Unlock()
+	fake.ExportInstanceTemplateStub = nil
+	if fake.exportInstanceTemplateReturnsOnCall == nil {
+		fake.exportInstanceTemplateReturnsOnCall = make(map[int]struct {
+			result1 datatypes.Virtual_Guest
+			result2 error
+		})
+	}
+	fake.exportInstanceTemplateReturnsOnCall[i] = struct {
+		result1 datatypes.Virtual_Guest
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeVirtualServerManager) GenerateInstanceCapacityCreationTemplate(arg1 *datatypes.Container_Product_Order_Virtual_ReservedCapacity, arg2 map[string]interface{}) (interface{}, error) {
 	fake.generateInstanceCapacityCreationTemplateMutex.Lock()
 	ret, specificReturn := fake.generateInstanceCapacityCreationTemplateReturnsOnCall[len(fake.generateInstanceCapacityCreationTemplateArgsForCall)]
@@ -2913,7 +2992,7 @@ func (fake *FakeVirtualServerManager) InstanceIsReadyReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
-func (fake *FakeVirtualServerManager) ListInstances(arg1 bool, arg2 bool, arg3 string, arg4 string, arg5 string, arg6 string, arg7 string, arg8 string, arg9 int, arg10 int, arg11 int, arg12 int, arg13 []string, arg14 string) ([]datatypes.Virtual_Guest, error) {
+func (fake *FakeVirtualServerManager) ListInstances(arg1 bool, arg2 bool, arg3 string, arg4 string, arg5 string, arg6 string, arg7 string, arg8 string, arg9 int, arg10 int, arg11 int, arg12 int, arg13 []string, arg14 string, arg15 metadata.Paging) ([]datatypes.Virtual_Guest, error) {
 	var arg13Copy []string
 	if arg13 != nil {
 		arg13Copy = make([]string, len(arg13))
@@ -2936,13 +3015,14 @@ func (fake *FakeVirtualServerManager) ListInstances(arg1 bool, arg2 bool, arg3 s
 		arg12 int
 		arg13 []string
 		arg14 string
-	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12, arg13Copy, arg14})
+		arg15 metadata.Paging
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12, arg13Copy, arg14, arg15})
 	stub := fake.ListInstancesStub
 	fakeReturns := fake.listInstancesReturns
-	fake.recordInvocation("ListInstances", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12, arg13Copy, arg14})
+	fake.recordInvocation("ListInstances", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12, arg13Copy, arg14, arg15})
 	fake.listInstancesMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12, arg13, arg14)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12, arg13, arg14, arg15)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2956,17 +3036,17 @@ func (fake *FakeVirtualServerManager) ListInstancesCallCount() int {
 	return len(fake.listInstancesArgsForCall)
 }
 
-func (fake *FakeVirtualServerManager) ListInstancesCalls(stub func(bool, bool, string, string, string, string, string, string, int, int, int, int, []string, string) ([]datatypes.Virtual_Guest, error)) {
+func (fake *FakeVirtualServerManager) ListInstancesCalls(stub func(bool, bool, string, string, string, string, string, string, int, int, int, int, []string, string, metadata.Paging) ([]datatypes.Virtual_Guest, error)) {
 	fake.listInstancesMutex.Lock()
 	defer fake.listInstancesMutex.Unlock()
 	fake.ListInstancesStub = stub
 }
 
-func (fake *FakeVirtualServerManager) ListInstancesArgsForCall(i int) (bool, bool, string, string, string, string, string, string, int, int, int, int, []string, string) {
+func (fake *FakeVirtualServerManager) ListInstancesArgsForCall(i int) (bool, bool, string, string, string, string, string, string, int, int, int, int, []string, string, metadata.Paging) {
 	fake.listInstancesMutex.RLock()
 	defer fake.listInstancesMutex.RUnlock()
 	argsForCall := fake.listInstancesArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8, argsForCall.arg9, argsForCall.arg10, argsForCall.arg11, argsForCall.arg12, argsForCall.arg13, argsForCall.arg14
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8, argsForCall.arg9, argsForCall.arg10, argsForCall.arg11, argsForCall.arg12, argsForCall.arg13, argsForCall.arg14, argsForCall.arg15
 }
 
 func (fake *FakeVirtualServerManager) ListInstancesReturns(result1 []datatypes.Virtual_Guest, result2 error) {
@@ -1,13 +1,114 @@
 package utils
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
+	"reflect"
+	"text/template"
 
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
+	"github.com/jmespath/go-jmespath"
+	"gopkg.in/yaml.v3"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/errors"
+	. "github.ibm.com/SoftLayer/softlayer-cli/plugin/i18n"
+)
+
+const (
+	StructuredJSON     = "JSON"
+	StructuredYAML     = "YAML"
+	StructuredTemplate = "TEMPLATE"
 )
 
+// How structured (--output=JSON|YAML|template=...) output should actually be rendered.
+// Commands only check for JSON output, then hand their data to PrintPrettyJSON or PrintTable, which look here.
+type StructuredOutputSettings struct {
+	Format   string
+	Template *template.Template
+	// JMESPath expression from --query, applied to the data before it gets printed
+	Query *jmespath.JMESPath
+}
+
+// True when the data can just be printed as JSON, without any --query or other output format.
+func (s *StructuredOutputSettings) IsPlainJSON() bool {
+	return s.Format == StructuredJSON && s.Query == nil
+}
+
+var StructuredOutput = &StructuredOutputSettings{Format: StructuredJSON}
+
+// Sets the format used by PrintPrettyJSON and PrintTable. templateText is only used for StructuredTemplate
+func SetStructuredOutput(format string, templateText string) error {
+	StructuredOutput.Format = format
+	StructuredOutput.Template = nil
+	if format == StructuredTemplate {
+		tmpl, err := template.New("output").Parse(templateText)
+		if err != nil {
+			subs := map[string]interface{}{"ERROR": err.Error()}
+			return errors.NewInvalidUsageError(T("Invalid output template: {{.ERROR}}", subs))
+		}
+		StructuredOutput.Template = tmpl
+	}
+	return nil
+}
+
+// Sets the JMESPath expression used to filter structured output, an empty query removes it.
+func SetStructuredQuery(query string) error {
+	StructuredOutput.Query = nil
+	if query == "" {
+		return nil
+	}
+	compiled, err := jmespath.Compile(query)
+	if err != nil {
+		subs := map[string]interface{}{"ERROR": err.Error()}
+		return errors.NewInvalidUsageError(T("Invalid query: {{.ERROR}}", subs))
+	}
+	StructuredOutput.Query = compiled
+	return nil
+}
+
+// Puts the structured output settings back to plain JSON
+func ResetStructuredOutput() {
+	StructuredOutput.Format = StructuredJSON
+	StructuredOutput.Template = nil
+	StructuredOutput.Query = nil
+}
+
+// Runs the --query expression against data. Queries work on the JSON representation of data, so
+// the result is made of the generic map[string]interface{} and []interface{} types.
+func applyQuery(data interface{}) (interface{}, error) {
+	if StructuredOutput.Query == nil {
+		return data, nil
+	}
+	jsonBytes, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var generic interface{}
+	err = json.Unmarshal(jsonBytes, &generic)
+	if err != nil {
+		return nil, err
+	}
+	result, err := StructuredOutput.Query.Search(generic)
+	if err != nil {
+		subs := map[string]interface{}{"ERROR": err.Error()}
+		return nil, errors.New(T("Failed to run query: {{.ERROR}}", subs))
+	}
+	return result, nil
+}
+
 func PrintPrettyJSON(ui terminal.UI, data interface{}) error {
+	data, err := applyQuery(data)
+	if err != nil {
+		return err
+	}
+	switch StructuredOutput.Format {
+	case StructuredYAML:
+		return PrintYAML(ui, data)
+	case StructuredTemplate:
+		return PrintTemplate(ui, data)
+	}
 	jsonBytes, err := prettyJSON(data)
 	if err != nil {
 		return err
@@ -20,12 +121,10 @@ func PrintPrettyJSON(ui terminal.UI, data interface{}) error {
 
 func PrintPrettyJSONList(ui terminal.UI, dataList interface{}) error {
 	for _, data := range dataList.([]interface{}) {
-		jsonBytes, err := prettyJSON(data)
+		err := PrintPrettyJSON(ui, data)
 		if err != nil {
 			return err
 		}
-
-		fmt.Fprintf(ui.Writer(), "%s\n", jsonBytes)
 	}
 	return nil
 }
@@ -38,3 +137,159 @@ func prettyJSON(data interface{}) (string, error) {
 
 	return string(jsonBytes), nil
 }
+
+// Prints data as YAML. The data goes through encoding/json first so the keys match the JSON output.
+func PrintYAML(ui terminal.UI, data interface{}) error {
+	jsonBytes, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	// JSON is valid YAML, decoding into a yaml.Node keeps the field order from the JSON.
+	var node yaml.Node
+	err = yaml.Unmarshal(jsonBytes, &node)
+	if err != nil {
+		return err
+	}
+	clearYAMLStyle(&node)
+	var out bytes.Buffer
+	encoder := yaml.NewEncoder(&out)
+	encoder.SetIndent(2)
+	err = encoder.Encode(&node)
+	if err != nil {
+		return err
+	}
+	err = encoder.Close()
+	if err != nil {
+		return err
+	}
+	_, err = out.WriteTo(ui.Writer())
+	return err
+}
+
+// The JSON decoder marks everything as flow style, which would just print JSON again.
+func clearYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		clearYAMLStyle(child)
+	}
+}
+
+// Executes the --output=template=... Go template against data.
+// Lists have the template run once for each item, every result is printed on its own line.
+func PrintTemplate(ui terminal.UI, data interface{}) error {
+	tmpl := StructuredOutput.Template
+	if tmpl == nil {
+		return errors.NewInvalidUsageError(T("An output template is required, use --output='template=<TEMPLATE>'"))
+	}
+	items := []interface{}{data}
+	value := reflect.ValueOf(data)
+	if value.Kind() == reflect.Slice || value.Kind() == reflect.Array {
+		items = make([]interface{}, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			items[i] = value.Index(i).Interface()
+		}
+	}
+	for _, item := range items {
+		var out bytes.Buffer
+		err := tmpl.Execute(&out, item)
+		if err != nil {
+			subs := map[string]interface{}{"ERROR": err.Error()}
+			return errors.New(T("Failed to execute output template: {{.ERROR}}", subs))
+		}
+		fmt.Fprintf(ui.Writer(), "%s\n", out.String())
+	}
+	return nil
+}
+
+// Prints a terminal.Table in the current structured output format, each row becomes an object keyed by the headers.
+// With a title the rows are put under it, so the output stays a single document.
+func printStructuredTable(ui terminal.UI, table terminal.Table, title string) error {
+	structured, ok := table.(*StructuredTable)
+	if !ok {
+		return errors.New(T("This table can't be printed as {{.FORMAT}}.", map[string]interface{}{"FORMAT": StructuredOutput.Format}))
+	}
+	headers, rows := structured.Headers, structured.Rows
+	if StructuredOutput.Format == StructuredTemplate || StructuredOutput.Query != nil {
+		items := make([]map[string]string, len(rows))
+		for i, row := range rows {
+			items[i] = map[string]string{}
+			for x, cell := range row {
+				items[i][tableColumnName(headers, x)] = cell
+			}
+		}
+		if title != "" {
+			return PrintPrettyJSON(ui, map[string]interface{}{title: items})
+		}
+		return PrintPrettyJSON(ui, items)
+	}
+	// yaml.Node so the columns stay in order
+	list := &yaml.Node{Kind: yaml.SequenceNode}
+	for _, row := range rows {
+		item := &yaml.Node{Kind: yaml.MappingNode}
+		for x, cell := range row {
+			item.Content = append(item.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Value: tableColumnName(headers, x)},
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: cell},
+			)
+		}
+		list.Content = append(list.Content, item)
+	}
+	document := list
+	if title != "" {
+		document = &yaml.Node{Kind: yaml.MappingNode, Content: []*yaml.Node{
+			{Kind: yaml.ScalarNode, Tag: "!!str", Value: title},
+			list,
+		}}
+	}
+	var out bytes.Buffer
+	encoder := yaml.NewEncoder(&out)
+	encoder.SetIndent(2)
+	err := encoder.Encode(document)
+	if err != nil {
+		return err
+	}
+	_, err = out.WriteTo(ui.Writer())
+	return err
+}
+
+// Same naming rules terminal.PrintableTable.PrintJson() uses for columns
+func tableColumnName(headers []string, column int) string {
+	if column >= len(headers) || headers[column] == "" {
+		return fmt.Sprintf("column_%d", column+1)
+	}
+	return headers[column]
+}
+
+// A terminal.Table that remembers its headers and rows, the sdk's terminal.PrintableTable keeps them private.
+// PrintTable needs them to print a table as YAML, a template or the result of --query.
+type StructuredTable struct {
+	terminal.Table
+	Headers []string
+	Rows    [][]string
+}
+
+// Same as terminal.NewTable, but the table can be printed in every structured output format
+func NewTable(w io.Writer, headers []string) *StructuredTable {
+	return &StructuredTable{Table: terminal.NewTable(w, headers), Headers: headers}
+}
+
+func (t *StructuredTable) Add(row ...string) {
+	t.Rows = append(t.Rows, append([]string{}, row...))
+	t.Table.Add(row...)
+}
+
+// A terminal.UI whose tables are StructuredTables
+type StructuredUI struct {
+	terminal.UI
+}
+
+func NewStructuredUI(ui terminal.UI) terminal.UI {
+	if _, ok := ui.(*StructuredUI); ok {
+		return ui
+	}
+	return &StructuredUI{UI: ui}
+}
+
+func (ui *StructuredUI) Table(headers []string) terminal.Table {
+	return &StructuredTable{Table: ui.UI.Table(headers), Headers: headers}
+}
@@ -0,0 +1,142 @@
+package utils_test
+
+import (
+	"bytes"
+
+	bxterminal "github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/terminal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/sl"
+
+	"github.ibm.com/SoftLayer/softlayer-cli/plugin/utils"
+)
+
+var _ = Describe("Structured Output Tests", func() {
+	var (
+		fakeUI *terminal.FakeUI
+		guests []datatypes.Virtual_Guest
+	)
+	BeforeEach(func() {
+		fakeUI = terminal.NewFakeUI()
+		guests = []datatypes.Virtual_Guest{
+			datatypes.Virtual_Guest{Id: sl.Int(111), Hostname: sl.String("web1"), Domain: sl.String("test.com")},
+			datatypes.Virtual_Guest{Id: sl.Int(222), Hostname: sl.String("123")},
+		}
+	})
+	AfterEach(func() {
+		utils.ResetStructuredOutput()
+	})
+	Describe("PrintPrettyJSON", func() {
+		It("Prints JSON by default", func() {
+			err := utils.PrintPrettyJSON(fakeUI, guests[0])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(ContainSubstring(`"hostname": "web1"`))
+		})
+		It("Prints YAML", func() {
+			Expect(utils.SetStructuredOutput(utils.StructuredYAML, "")).To(Succeed())
+			err := utils.PrintPrettyJSON(fakeUI, guests)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(Equal(`- domain: test.com
+  hostname: web1
+  id: 111
+- hostname: "123"
+  id: 222
+`))
+		})
+		It("Prints a template for each item", func() {
+			Expect(utils.SetStructuredOutput(utils.StructuredTemplate, "{{.Id}} {{.Hostname}}")).To(Succeed())
+			err := utils.PrintPrettyJSON(fakeUI, guests)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(Equal("111 web1\n222 123\n"))
+		})
+		It("Runs --query before printing", func() {
+			Expect(utils.SetStructuredQuery("[].hostname")).To(Succeed())
+			Expect(utils.SetStructuredOutput(utils.StructuredYAML, "")).To(Succeed())
+			err := utils.PrintPrettyJSON(fakeUI, guests)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeUI.Outputs()).To(Equal("- web1\n- \"123\"\n"))
+		})
+		It("Errors on a bad query", func() {
+			err := utils.SetStructuredQuery("[?id==")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Invalid query"))
+		})
+		It("Errors on a bad template", func() {
+			err := utils.SetStructuredOutput(utils.StructuredTemplate, "{{.Id")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Invalid output template"))
+		})
+		It("Errors when the template fails", func() {
+			Expect(utils.SetStructuredOutput(utils.StructuredTemplate, "{{.NotAField}}")).To(Succeed())
+			err := utils.PrintPrettyJSON(fakeUI, guests)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Failed to execute output template"))
+		})
+	})
+	Describe("PrintTable", func() {
+		var table bxterminal.Table
+		BeforeEach(func() {
+			table = utils.NewStructuredUI(fakeUI).Table([]string{"ID", "Primary IP", ""})
+			table.Add("111", "10.0.0.1", "extra")
+			table.Add("222", "-", "")
+		})
+		It("Prints YAML", func() {
+			Expect(utils.SetStructuredOutput(utils.StructuredYAML, "")).To(Succeed())
+			Expect(utils.PrintTable(fakeUI, table, "JSON")).To(Succeed())
+			Expect(fakeUI.Outputs()).To(Equal(`- ID: "111"
+  Primary IP: 10.0.0.1
+  column_3: extra
+- ID: "222"
+  Primary IP: '-'
+  column_3: ""
+`))
+		})
+		It("Prints a template", func() {
+			Expect(utils.SetStructuredOutput(utils.StructuredTemplate, `{{.ID}}={{index . "Primary IP"}}`)).To(Succeed())
+			Expect(utils.PrintTable(fakeUI, table, "JSON")).To(Succeed())
+			Expect(fakeUI.Outputs()).To(Equal("111=10.0.0.1\n222=-\n"))
+		})
+		It("Runs --query against the rows", func() {
+			Expect(utils.SetStructuredQuery("[?ID=='222'] | [0].\"Primary IP\"")).To(Succeed())
+			Expect(utils.PrintTable(fakeUI, table, "JSON")).To(Succeed())
+			Expect(fakeUI.Outputs()).To(Equal("\"-\"\n"))
+		})
+		It("Keeps multi line cells in one row", func() {
+			table.Add("333", "10.0.0.3\n10.0.0.4", "")
+			Expect(utils.SetStructuredQuery("[2].\"Primary IP\"")).To(Succeed())
+			Expect(utils.PrintTable(fakeUI, table, "JSON")).To(Succeed())
+			Expect(fakeUI.Outputs()).To(Equal("\"10.0.0.3\\n10.0.0.4\"\n"))
+		})
+		It("Returns an error for a table that doesn't keep its rows", func() {
+			Expect(utils.SetStructuredOutput(utils.StructuredYAML, "")).To(Succeed())
+			err := utils.PrintTable(fakeUI, fakeUI.Table([]string{"ID"}), "JSON")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("This table can't be printed as YAML."))
+		})
+		It("Prints a normal table", func() {
+			Expect(utils.SetStructuredOutput(utils.StructuredYAML, "")).To(Succeed())
+			Expect(utils.PrintTable(fakeUI, table, "")).To(Succeed())
+			Expect(fakeUI.Outputs()).To(ContainSubstring("Primary IP"))
+			Expect(fakeUI.Outputs()).To(ContainSubstring("10.0.0.1"))
+		})
+		It("Prints a table with a title as one YAML document", func() {
+			Expect(utils.SetStructuredOutput(utils.StructuredYAML, "")).To(Succeed())
+			Expect(utils.PrintTableWithTitle(fakeUI, table, new(bytes.Buffer), "Servers", "JSON")).To(Succeed())
+			Expect(fakeUI.Outputs()).To(Equal(`Servers:
+  - ID: "111"
+    Primary IP: 10.0.0.1
+    column_3: extra
+  - ID: "222"
+    Primary IP: '-'
+    column_3: ""
+`))
+		})
+		It("Runs --query against the rows under the title", func() {
+			Expect(utils.SetStructuredQuery("Servers[1].ID")).To(Succeed())
+			Expect(utils.PrintTableWithTitle(fakeUI, table, new(bytes.Buffer), "Servers", "JSON")).To(Succeed())
+			Expect(fakeUI.Outputs()).To(Equal("\"222\"\n"))
+		})
+	})
+})
@@ -49,30 +49,6 @@ func ResolveImageId(session *session.Session, id int) (string, error) {
 	return *image.GlobalIdentifier, nil
 }
 
-func ResolveVlanId(identifier string) (int, error) {
-	id, err := strconv.Atoi(identifier)
-	if err != nil {
-		return 0, err
-	}
-	return id, nil
-}
-
-func ResolveSubnetId(identifier string) (int, error) {
-	id, err := strconv.Atoi(identifier)
-	if err != nil {
-		return 0, err
-	}
-	return id, nil
-}
-
-func ResolveGloablIPId(identifier string) (int, error) {
-	id, err := strconv.Atoi(identifier)
-	if err != nil {
-		return 0, err
-	}
-	return id, nil
-}
-
 func StringSliceToString(slice []string) string {
 	if len(slice) == 0 {
 		return EMPTY_STRING
@@ -373,50 +349,44 @@ func WordInList(wordList []string, key string) bool {
 	return false
 }
 
-func PrintTableWithTitle(ui terminal.UI, table terminal.Table, bufEvent *bytes.Buffer, title string, outputFormat string) string {
+func PrintTableWithTitle(ui terminal.UI, table terminal.Table, bufEvent *bytes.Buffer, title string, outputFormat string) error {
+	if outputFormat == "JSON" && !StructuredOutput.IsPlainJSON() {
+		return printStructuredTable(ui, table, T(title))
+	}
 	tableTitle := ui.Table([]string{T(title)})
 	if outputFormat == "JSON" {
 		table.PrintJson()
 		tableTitle.Add(bufEvent.String())
 		tableTitle.PrintJson()
-		return ""
+		return nil
 	}
 	if outputFormat == "CSV" {
 		err := table.PrintCsv()
 		if err != nil {
-			fmt.Println("Error:", err)
-			return ""
+			return err
 		}
 		tableTitle.Add(bufEvent.String())
-		err = tableTitle.PrintCsv()
-		if err != nil {
-			fmt.Println("Error:", err)
-			return ""
-		}
-		return ""
+		return tableTitle.PrintCsv()
 	}
 	table.Print()
 	tableTitle.Add(bufEvent.String())
 	tableTitle.Print()
-	return ""
-
+	return nil
 }
 
-func PrintTable(ui terminal.UI, table terminal.Table, outputFormat string) {
+func PrintTable(ui terminal.UI, table terminal.Table, outputFormat string) error {
+	if outputFormat == "JSON" && !StructuredOutput.IsPlainJSON() {
+		return printStructuredTable(ui, table, "")
+	}
 	if outputFormat == "JSON" {
 		table.PrintJson()
-		return
+		return nil
 	}
 	if outputFormat == "CSV" {
-		err := table.PrintCsv()
-		if err != nil {
-			fmt.Println("Error:", err)
-
-		}
-		return
+		return table.PrintCsv()
 	}
 	table.Print()
-	return
+	return nil
 }
 
 func ShortenString(ugly_string string) string {